@@ -24,6 +24,10 @@ type ClientBuilder struct {
 	AuthConfig *auth.Credentials
 	Features   features.UserFeatures
 
+	// AdditionalSubscriptionIDs are the IDs of the Subscriptions, other than SubscriptionID, which resources can
+	// be managed within
+	AdditionalSubscriptionIDs []string
+
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
 	SkipProviderRegistration    bool
@@ -164,6 +168,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
+	client.subscriptions = newSubscriptionClients(o, builder.AdditionalSubscriptionIDs)
 
 	if features.EnhancedValidationEnabled() {
 		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
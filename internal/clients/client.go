@@ -147,6 +147,7 @@ import (
 	vmware "github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/client"
 	voiceServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/voiceservices/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Client struct {
@@ -158,6 +159,12 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// RetryPolicy defines how operations failing with a transient error are retried
+	RetryPolicy pluginsdk.RetryPolicy
+
+	// subscriptions contains the Clients for the additional Subscriptions, see ForSubscription
+	subscriptions *subscriptionClients
+
 	AadB2c                *aadb2c_v2021_04_01_preview.Client
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisservices_v2017_08_01.Client
@@ -205,6 +212,7 @@ type Client struct {
 	Elastic               *elastic.Client
 	ElasticSan            *elasticsan.Client
 	EventGrid             *eventgrid_v2022_06_15.Client
+	EventGridNamespaces   *eventgrid.NamespacesClient
 	Eventhub              *eventhub.Client
 	FluidRelay            *fluidrelay_2022_05_26.Client
 	Frontdoor             *frontdoor.Client
@@ -436,6 +444,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.EventGrid, err = eventgrid.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for EventGrid: %+v", err)
 	}
+	if client.EventGridNamespaces, err = eventgrid.NewNamespacesClient(o); err != nil {
+		return fmt.Errorf("building clients for EventGrid Namespaces: %+v", err)
+	}
 	if client.Eventhub, err = eventhub.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Eventhub: %+v", err)
 	}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+)
+
+// subscriptionClients contains the Clients for the additional Subscriptions which can be used by resources, which are
+// built on first use and share the Authorizers (and so the token cache) of the Client for the Provider Subscription
+type subscriptionClients struct {
+	// the lock only guards the `clients` map - each Client is built whilst holding the lock for that Subscription, so
+	// that building the Client for one Subscription doesn't block resources using another Subscription
+	sync.Mutex
+
+	options *common.ClientOptions
+
+	// allowed contains the lower-cased IDs of the additional Subscriptions which can be used
+	allowed map[string]struct{}
+
+	// clients contains the Clients for each Subscription, keyed by the lower-cased Subscription ID
+	clients map[string]*subscriptionClient
+}
+
+// subscriptionClient contains the Client for a single additional Subscription, which is nil until it's been built
+type subscriptionClient struct {
+	sync.Mutex
+
+	client *Client
+}
+
+func newSubscriptionClients(o *common.ClientOptions, additionalSubscriptionIds []string) *subscriptionClients {
+	allowed := make(map[string]struct{})
+	for _, v := range additionalSubscriptionIds {
+		allowed[strings.ToLower(v)] = struct{}{}
+	}
+
+	return &subscriptionClients{
+		options: o,
+		allowed: allowed,
+		clients: make(map[string]*subscriptionClient),
+	}
+}
+
+// IsSubscriptionAllowed returns whether resources can be managed within the specified Subscription - which is either
+// the Subscription configured in the Provider block or one of the `additional_subscription_ids`
+func (client *Client) IsSubscriptionAllowed(subscriptionId string) bool {
+	if strings.EqualFold(subscriptionId, client.Account.SubscriptionId) {
+		return true
+	}
+	if client.subscriptions == nil {
+		return false
+	}
+
+	_, ok := client.subscriptions.allowed[strings.ToLower(subscriptionId)]
+	return ok
+}
+
+// ForSubscription returns a Client scoped to the specified Subscription, which must either be the Subscription
+// configured in the Provider block or one of the `additional_subscription_ids`. The Client for an additional
+// Subscription is built (and the required Resource Providers registered) the first time it's used.
+func (client *Client) ForSubscription(ctx context.Context, subscriptionId string) (*Client, error) {
+	if strings.EqualFold(subscriptionId, client.Account.SubscriptionId) {
+		return client, nil
+	}
+	if !client.IsSubscriptionAllowed(subscriptionId) {
+		return nil, fmt.Errorf("the Subscription %q must be either the Subscription configured in the Provider block (%q) or one of the `additional_subscription_ids`", subscriptionId, client.Account.SubscriptionId)
+	}
+
+	subscriptions := client.subscriptions
+	key := strings.ToLower(subscriptionId)
+
+	subscriptions.Lock()
+	entry, ok := subscriptions.clients[key]
+	if !ok {
+		entry = &subscriptionClient{}
+		subscriptions.clients[key] = entry
+	}
+	subscriptions.Unlock()
+
+	entry.Lock()
+	defer entry.Unlock()
+
+	if entry.client != nil {
+		return entry.client, nil
+	}
+
+	log.Printf("[DEBUG] Building the Client for the Subscription %q..", subscriptionId)
+	account := *client.Account
+	account.SubscriptionId = subscriptionId
+
+	o := *subscriptions.options
+	o.SubscriptionId = subscriptionId
+
+	stopCtx := client.StopContext
+	if stopCtx == nil {
+		stopCtx = ctx
+	}
+
+	scoped := &Client{
+		Account: &account,
+	}
+	if err := scoped.Build(stopCtx, &o); err != nil {
+		return nil, fmt.Errorf("building the Client for the Subscription %q: %+v", subscriptionId, err)
+	}
+	scoped.RetryPolicy = client.RetryPolicy
+	scoped.subscriptions = subscriptions
+
+	if !o.SkipProviderReg {
+		ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
+		defer cancel()
+
+		id := commonids.NewSubscriptionID(subscriptionId)
+		if err := resourceproviders.EnsureRegisteredInSubscription(ctx2, scoped.Resource.ResourceProvidersClient, id, resourceproviders.Required()); err != nil {
+			return nil, fmt.Errorf("registering the required Resource Providers in %s: %+v", id, err)
+		}
+	}
+
+	entry.client = scoped
+	return scoped, nil
+}
@@ -20,6 +20,11 @@ func Default() UserFeatures {
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
 		},
+		DeletionProtection: DeletionProtectionFeatures{
+			AllowDeletionOfProtectedResources: false,
+			ResourceTypes:                     []string{},
+			Tags:                              map[string]string{},
+		},
 		KeyVault: KeyVaultFeatures{
 			PurgeSoftDeleteOnDestroy:         true,
 			PurgeSoftDeletedKeysOnDestroy:    true,
@@ -53,6 +58,9 @@ func Default() UserFeatures {
 			RollInstancesWhenRequired: true,
 			ScaleToZeroOnDelete:       true,
 		},
+		Storage: StorageFeatures{
+			DataPlaneAvailable: true,
+		},
 		Subscription: SubscriptionFeatures{
 			PreventCancellationOnDestroy: false,
 		},
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package features
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ProtectionReason returns the reason why a resource of the specified type with the specified tags
+// is protected from deletion - or an empty string if the resource isn't protected
+func (f DeletionProtectionFeatures) ProtectionReason(resourceType string, tags map[string]interface{}) string {
+	for _, v := range f.ResourceTypes {
+		if v == resourceType {
+			return fmt.Sprintf("the Resource Type %q is protected", resourceType)
+		}
+	}
+
+	keys := make([]string, 0)
+	for k := range f.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, ok := tags[key]
+		if !ok {
+			continue
+		}
+
+		if v, ok := value.(string); ok && v == f.Tags[key] {
+			return fmt.Sprintf("the tag %q has the protected value %q", key, v)
+		}
+	}
+
+	return ""
+}
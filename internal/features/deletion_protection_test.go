@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package features
+
+import "testing"
+
+func TestDeletionProtectionReason(t *testing.T) {
+	protection := DeletionProtectionFeatures{
+		ResourceTypes: []string{
+			"azurerm_key_vault",
+			"azurerm_storage_account",
+		},
+		Tags: map[string]string{
+			"protect":     "true",
+			"environment": "production",
+		},
+	}
+
+	testData := []struct {
+		Name         string
+		ResourceType string
+		Tags         map[string]interface{}
+		Expected     string
+	}{
+		{
+			Name:         "Protected Resource Type",
+			ResourceType: "azurerm_key_vault",
+			Expected:     `the Resource Type "azurerm_key_vault" is protected`,
+		},
+		{
+			Name:         "Unprotected Resource Type",
+			ResourceType: "azurerm_resource_group",
+			Expected:     "",
+		},
+		{
+			Name:         "Protected Tag",
+			ResourceType: "azurerm_resource_group",
+			Tags: map[string]interface{}{
+				"protect": "true",
+			},
+			Expected: `the tag "protect" has the protected value "true"`,
+		},
+		{
+			Name:         "Protected Tag With A Different Value",
+			ResourceType: "azurerm_resource_group",
+			Tags: map[string]interface{}{
+				"protect":     "false",
+				"environment": "development",
+			},
+			Expected: "",
+		},
+		{
+			Name:         "Multiple Protected Tags",
+			ResourceType: "azurerm_resource_group",
+			Tags: map[string]interface{}{
+				"protect":     "true",
+				"environment": "production",
+			},
+			Expected: `the tag "environment" has the protected value "production"`,
+		},
+		{
+			Name:         "Tag Keys Are Case Sensitive",
+			ResourceType: "azurerm_resource_group",
+			Tags: map[string]interface{}{
+				"Protect": "true",
+			},
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := protection.ProtectionReason(v.ResourceType, v.Tags); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
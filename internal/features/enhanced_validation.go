@@ -24,3 +24,17 @@ func EnhancedValidationEnabled() bool {
 
 	return strings.EqualFold(value, "true")
 }
+
+// PlanTimeValidationEnabled returns whether or not the feature for Plan Time Validation is
+// enabled.
+//
+// This functionality calls out to the Compute API to cache the Resource SKUs and Usages for
+// each Azure Location used by a Virtual Machine or Virtual Machine Scale Set - and then uses
+// that to confirm during the plan that the size is offered in the Location/Zone(s) and that
+// there's sufficient vCPU quota available.
+//
+// This is disabled by default and can be enabled by setting the Environment Variable
+// `ARM_PROVIDER_PLAN_TIME_VALIDATION` to `true`.
+func PlanTimeValidationEnabled() bool {
+	return strings.EqualFold(os.Getenv("ARM_PROVIDER_PLAN_TIME_VALIDATION"), "true")
+}
@@ -8,6 +8,7 @@ type UserFeatures struct {
 	AppConfiguration       AppConfigurationFeatures
 	ApplicationInsights    ApplicationInsightFeatures
 	CognitiveAccount       CognitiveAccountFeatures
+	DeletionProtection     DeletionProtectionFeatures
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
 	KeyVault               KeyVaultFeatures
@@ -15,6 +16,7 @@ type UserFeatures struct {
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
 	ResourceGroup          ResourceGroupFeatures
 	ManagedDisk            ManagedDiskFeatures
+	Storage                StorageFeatures
 	Subscription           SubscriptionFeatures
 }
 
@@ -76,6 +78,16 @@ type AppConfigurationFeatures struct {
 	RecoverSoftDeleted       bool
 }
 
+type StorageFeatures struct {
+	DataPlaneAvailable bool
+}
+
 type SubscriptionFeatures struct {
 	PreventCancellationOnDestroy bool
 }
+
+type DeletionProtectionFeatures struct {
+	AllowDeletionOfProtectedResources bool
+	ResourceTypes                     []string
+	Tags                              map[string]string
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
@@ -88,6 +89,38 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"deletion_protection": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"allow_deletion_of_protected_resources": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"resource_types": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"tags": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
 		"key_vault": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -270,6 +303,21 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"storage": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"data_plane_available": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
 		"subscription": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -365,6 +413,30 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["deletion_protection"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			deletionProtectionRaw := items[0].(map[string]interface{})
+			if v, ok := deletionProtectionRaw["allow_deletion_of_protected_resources"]; ok {
+				featuresMap.DeletionProtection.AllowDeletionOfProtectedResources = v.(bool)
+			}
+			if v, ok := deletionProtectionRaw["resource_types"]; ok && v != nil {
+				resourceTypes := make([]string, 0)
+				for _, item := range v.(*pluginsdk.Set).List() {
+					resourceTypes = append(resourceTypes, item.(string))
+				}
+				featuresMap.DeletionProtection.ResourceTypes = resourceTypes
+			}
+			if v, ok := deletionProtectionRaw["tags"]; ok && v != nil {
+				tags := make(map[string]string)
+				for key, value := range v.(map[string]interface{}) {
+					tags[key] = value.(string)
+				}
+				featuresMap.DeletionProtection.Tags = tags
+			}
+		}
+	}
+
 	if raw, ok := val["key_vault"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 && items[0] != nil {
@@ -471,6 +543,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["storage"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			storageRaw := items[0].(map[string]interface{})
+			if v, ok := storageRaw["data_plane_available"]; ok {
+				featuresMap.Storage.DataPlaneAvailable = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["subscription"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 func TestExpandFeatures(t *testing.T) {
@@ -35,6 +36,11 @@ func TestExpandFeatures(t *testing.T) {
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				DeletionProtection: features.DeletionProtectionFeatures{
+					AllowDeletionOfProtectedResources: false,
+					ResourceTypes:                     []string{},
+					Tags:                              map[string]string{},
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
 					PurgeSoftDeletedKeysOnDestroy:    true,
@@ -68,6 +74,9 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
 				Subscription: features.SubscriptionFeatures{
 					PreventCancellationOnDestroy: false,
 				},
@@ -99,6 +108,15 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": true,
 						},
 					},
+					"deletion_protection": []interface{}{
+						map[string]interface{}{
+							"allow_deletion_of_protected_resources": true,
+							"resource_types":                        pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"azurerm_key_vault"}),
+							"tags": map[string]interface{}{
+								"protect": "true",
+							},
+						},
+					},
 					"key_vault": []interface{}{
 						map[string]interface{}{
 							"purge_soft_deleted_certificates_on_destroy":              true,
@@ -127,6 +145,11 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_deletion_if_contains_resources": true,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": true,
+						},
+					},
 					"subscription": []interface{}{
 						map[string]interface{}{
 							"prevent_cancellation_on_destroy": true,
@@ -168,6 +191,13 @@ func TestExpandFeatures(t *testing.T) {
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				DeletionProtection: features.DeletionProtectionFeatures{
+					AllowDeletionOfProtectedResources: true,
+					ResourceTypes:                     []string{"azurerm_key_vault"},
+					Tags: map[string]string{
+						"protect": "true",
+					},
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
 					PurgeSoftDeletedKeysOnDestroy:    true,
@@ -188,6 +218,9 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
 				Subscription: features.SubscriptionFeatures{
 					PreventCancellationOnDestroy: true,
 				},
@@ -232,6 +265,13 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": false,
 						},
 					},
+					"deletion_protection": []interface{}{
+						map[string]interface{}{
+							"allow_deletion_of_protected_resources": false,
+							"resource_types":                        pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+							"tags":                                  map[string]interface{}{},
+						},
+					},
 					"key_vault": []interface{}{
 						map[string]interface{}{
 							"purge_soft_deleted_certificates_on_destroy":              false,
@@ -260,6 +300,11 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_deletion_if_contains_resources": false,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": false,
+						},
+					},
 					"subscription": []interface{}{
 						map[string]interface{}{
 							"prevent_cancellation_on_destroy": false,
@@ -301,6 +346,11 @@ func TestExpandFeatures(t *testing.T) {
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				DeletionProtection: features.DeletionProtectionFeatures{
+					AllowDeletionOfProtectedResources: false,
+					ResourceTypes:                     []string{},
+					Tags:                              map[string]string{},
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   false,
 					PurgeSoftDeletedKeysOnDestroy:    false,
@@ -321,6 +371,9 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: false,
+				},
 				Subscription: features.SubscriptionFeatures{
 					PreventCancellationOnDestroy: false,
 				},
@@ -1216,6 +1269,54 @@ func TestExpandFeaturesManagedDisk(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesStorage(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
+			},
+		},
+		{
+			Name: "Data Plane Unavailable",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Storage, testCase.Expected.Storage) {
+			t.Fatalf("Expected %+v but got %+v", result.Storage, testCase.Expected.Storage)
+		}
+	}
+}
+
 func TestExpandFeaturesSubscription(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -1263,3 +1364,82 @@ func TestExpandFeaturesSubscription(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesDeletionProtection(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"deletion_protection": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				DeletionProtection: features.DeletionProtectionFeatures{
+					AllowDeletionOfProtectedResources: false,
+					ResourceTypes:                     []string{},
+					Tags:                              map[string]string{},
+				},
+			},
+		},
+		{
+			Name: "Protected Resource Types and Tags",
+			Input: []interface{}{
+				map[string]interface{}{
+					"deletion_protection": []interface{}{
+						map[string]interface{}{
+							"allow_deletion_of_protected_resources": false,
+							"resource_types":                        pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"azurerm_key_vault"}),
+							"tags": map[string]interface{}{
+								"protect": "true",
+							},
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				DeletionProtection: features.DeletionProtectionFeatures{
+					AllowDeletionOfProtectedResources: false,
+					ResourceTypes:                     []string{"azurerm_key_vault"},
+					Tags: map[string]string{
+						"protect": "true",
+					},
+				},
+			},
+		},
+		{
+			Name: "Allow Deletion Of Protected Resources",
+			Input: []interface{}{
+				map[string]interface{}{
+					"deletion_protection": []interface{}{
+						map[string]interface{}{
+							"allow_deletion_of_protected_resources": true,
+							"resource_types":                        pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"azurerm_key_vault"}),
+							"tags":                                  map[string]interface{}{},
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				DeletionProtection: features.DeletionProtectionFeatures{
+					AllowDeletionOfProtectedResources: true,
+					ResourceTypes:                     []string{"azurerm_key_vault"},
+					Tags:                              map[string]string{},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.DeletionProtection, testCase.Expected.DeletionProtection) {
+			t.Fatalf("Expected %+v but got %+v", result.DeletionProtection, testCase.Expected.DeletionProtection)
+		}
+	}
+}
@@ -125,7 +125,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Errorf("creating Wrapper for Data Source %q: %+v", key, err))
 			}
 
-			dataSources[key] = dataSource
+			dataSources[key] = withSubscriptionOverrideForDataSource(key, dataSource)
 		}
 
 		debugLog("[DEBUG] Registering Resources for %q..", service.Name())
@@ -140,7 +140,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			if err != nil {
 				panic(fmt.Errorf("creating Wrapper for Resource %q: %+v", key, err))
 			}
-			resources[key] = resource
+			resources[key] = WithSubscriptionOverride(key, resource)
 		}
 	}
 
@@ -152,7 +152,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Data Source exists for %q", k))
 			}
 
-			dataSources[k] = v
+			dataSources[k] = withSubscriptionOverrideForDataSource(k, v)
 		}
 
 		debugLog("[DEBUG] Registering Resources for %q..", service.Name())
@@ -161,7 +161,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
-			resources[k] = v
+			resources[k] = WithSubscriptionOverride(k, sdk.WithRetryPolicy(sdk.WithDeletionProtection(k, v)))
 		}
 	}
 
@@ -174,6 +174,16 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The Subscription ID which should be used.",
 			},
 
+			"additional_subscription_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+				Description: "A list of other Subscription IDs, within which resources can be managed by specifying the `subscription_id` argument.",
+			},
+
 			"client_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -346,6 +356,10 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"resource_timeouts": schemaResourceTimeouts(),
+
+			"retry": schemaRetry(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -466,7 +480,24 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
 	skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 
+	retryPolicy, err := expandRetryPolicy(d.Get("retry").([]interface{}))
+	if err != nil {
+		return nil, diag.Errorf("expanding `retry`: %+v", err)
+	}
+
+	if err := applyResourceTimeouts(p.ResourcesMap, d.Get("resource_timeouts").([]interface{})); err != nil {
+		return nil, diag.Errorf("expanding `resource_timeouts`: %+v", err)
+	}
+
+	var additionalSubscriptionIds []string
+	if v, ok := d.Get("additional_subscription_ids").([]interface{}); ok && len(v) > 0 {
+		additionalSubscriptionIds = *utils.ExpandStringSlice(v)
+	} else if v := os.Getenv("ARM_ADDITIONAL_SUBSCRIPTION_IDS"); v != "" {
+		additionalSubscriptionIds = strings.Split(v, ";")
+	}
+
 	clientBuilder := clients.ClientBuilder{
+		AdditionalSubscriptionIDs:   additionalSubscriptionIds,
 		AuthConfig:                  authConfig,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
@@ -495,6 +526,7 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	}
 
 	client.StopContext = stopCtx
+	client.RetryPolicy = *retryPolicy
 
 	if !skipProviderRegistration {
 		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
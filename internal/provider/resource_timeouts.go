@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaResourceTimeouts() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Description: "Default timeouts which should be used for all resources of the specified Resource Type, which can be overridden using a `timeouts` block within the resource.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"create": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTimeoutDuration,
+				},
+
+				"read": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTimeoutDuration,
+				},
+
+				"update": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTimeoutDuration,
+				},
+
+				"delete": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTimeoutDuration,
+				},
+			},
+		},
+	}
+}
+
+func schemaRetry() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "How operations which fail with a transient error should be retried.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_attempts": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      3,
+					ValidateFunc: validation.IntBetween(1, 10),
+				},
+
+				"delay": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "10s",
+					ValidateFunc: validateTimeoutDuration,
+				},
+
+				"max_delay": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "5m",
+					ValidateFunc: validateTimeoutDuration,
+				},
+
+				"retryable_errors": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsValidRegExp,
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateTimeoutDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a valid duration (e.g. `30s`, `10m` or `2h`) but got %q: %+v", k, v, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("expected %q to be a positive duration but got %q", k, v))
+	}
+
+	return
+}
+
+// expandRetryPolicy returns the Retry Policy defined in the Provider block - when the `retry` block isn't specified
+// a policy with a single attempt is returned, meaning that operations aren't retried.
+func expandRetryPolicy(input []interface{}) (*pluginsdk.RetryPolicy, error) {
+	policy := pluginsdk.RetryPolicy{
+		MaxAttempts: 1,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &policy, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	policy.MaxAttempts = raw["max_attempts"].(int)
+
+	delay, err := time.ParseDuration(raw["delay"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `delay`: %+v", err)
+	}
+	policy.Delay = delay
+
+	maxDelay, err := time.ParseDuration(raw["max_delay"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `max_delay`: %+v", err)
+	}
+	if maxDelay < delay {
+		return nil, fmt.Errorf("`max_delay` (%s) must be greater than or equal to `delay` (%s)", maxDelay, delay)
+	}
+	policy.MaxDelay = maxDelay
+
+	patterns := make([]string, 0)
+	for _, v := range raw["retryable_errors"].([]interface{}) {
+		patterns = append(patterns, v.(string))
+	}
+	if len(patterns) == 0 {
+		patterns = pluginsdk.DefaultRetryableErrors
+	}
+
+	for _, v := range patterns {
+		expr, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the retryable error %q: %+v", v, err)
+		}
+		policy.RetryableErrors = append(policy.RetryableErrors, expr)
+	}
+
+	return &policy, nil
+}
+
+// applyResourceTimeouts overrides the default timeouts for the Resource Types specified in the `resource_timeouts`
+// blocks within the Provider block. Since these defaults are used when a resource doesn't define a `timeouts` block
+// any timeouts defined on a resource continue to take precedence.
+func applyResourceTimeouts(resources map[string]*schema.Resource, input []interface{}) error {
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+
+		resourceType := raw["resource_type"].(string)
+		resource, ok := resources[resourceType]
+		if !ok || resource == nil {
+			return fmt.Errorf("the Resource Type %q specified in the `resource_timeouts` block is not supported by this provider", resourceType)
+		}
+
+		if resource.Timeouts == nil {
+			resource.Timeouts = &schema.ResourceTimeout{}
+		}
+
+		for _, field := range []string{"create", "read", "update", "delete"} {
+			v := raw[field].(string)
+			if v == "" {
+				continue
+			}
+
+			duration, err := time.ParseDuration(v)
+			if err != nil {
+				return fmt.Errorf("parsing `%s` for the Resource Type %q: %+v", field, resourceType, err)
+			}
+
+			switch field {
+			case "create":
+				resource.Timeouts.Create = &duration
+			case "read":
+				resource.Timeouts.Read = &duration
+			case "update":
+				if resource.Update == nil && resource.UpdateContext == nil && resource.UpdateWithoutTimeout == nil { // nolint: staticcheck
+					return fmt.Errorf("the Resource Type %q specified in the `resource_timeouts` block doesn't support updates", resourceType)
+				}
+				resource.Timeouts.Update = &duration
+			case "delete":
+				resource.Timeouts.Delete = &duration
+			}
+		}
+	}
+
+	return nil
+}
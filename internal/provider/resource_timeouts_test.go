@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestExpandRetryPolicy(t *testing.T) {
+	testData := []struct {
+		Name                    string
+		Input                   []interface{}
+		ExpectedMaxAttempts     int
+		ExpectedDelay           time.Duration
+		ExpectedMaxDelay        time.Duration
+		ExpectedRetryableErrors int
+		ExpectError             bool
+	}{
+		{
+			Name:                "Empty Block",
+			Input:               []interface{}{},
+			ExpectedMaxAttempts: 1,
+		},
+		{
+			Name: "Default Retryable Errors",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":     3,
+					"delay":            "10s",
+					"max_delay":        "5m",
+					"retryable_errors": []interface{}{},
+				},
+			},
+			ExpectedMaxAttempts:     3,
+			ExpectedDelay:           10 * time.Second,
+			ExpectedMaxDelay:        5 * time.Minute,
+			ExpectedRetryableErrors: len(pluginsdk.DefaultRetryableErrors),
+		},
+		{
+			Name: "Custom Retryable Errors",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts": 5,
+					"delay":        "1s",
+					"max_delay":    "30s",
+					"retryable_errors": []interface{}{
+						"Conflict",
+					},
+				},
+			},
+			ExpectedMaxAttempts:     5,
+			ExpectedDelay:           time.Second,
+			ExpectedMaxDelay:        30 * time.Second,
+			ExpectedRetryableErrors: 1,
+		},
+		{
+			Name: "Max Delay Less Than Delay",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":     3,
+					"delay":            "1m",
+					"max_delay":        "30s",
+					"retryable_errors": []interface{}{},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := expandRetryPolicy(v.Input)
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if actual.MaxAttempts != v.ExpectedMaxAttempts {
+			t.Fatalf("expected `max_attempts` to be %d but got %d", v.ExpectedMaxAttempts, actual.MaxAttempts)
+		}
+		if actual.Delay != v.ExpectedDelay {
+			t.Fatalf("expected `delay` to be %s but got %s", v.ExpectedDelay, actual.Delay)
+		}
+		if actual.MaxDelay != v.ExpectedMaxDelay {
+			t.Fatalf("expected `max_delay` to be %s but got %s", v.ExpectedMaxDelay, actual.MaxDelay)
+		}
+		if len(actual.RetryableErrors) != v.ExpectedRetryableErrors {
+			t.Fatalf("expected %d retryable errors but got %d", v.ExpectedRetryableErrors, len(actual.RetryableErrors))
+		}
+	}
+}
+
+func TestApplyResourceTimeouts(t *testing.T) {
+	defaultCreate := 30 * time.Minute
+	newResources := func() map[string]*schema.Resource {
+		return map[string]*schema.Resource{
+			"azurerm_example": {
+				Timeouts: &schema.ResourceTimeout{
+					Create: &defaultCreate,
+				},
+			},
+		}
+	}
+
+	testData := []struct {
+		Name           string
+		Input          []interface{}
+		ExpectedCreate time.Duration
+		ExpectedDelete *time.Duration
+		ExpectError    bool
+	}{
+		{
+			Name:           "No Overrides",
+			Input:          []interface{}{},
+			ExpectedCreate: defaultCreate,
+		},
+		{
+			Name: "Overridden Timeouts",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_example",
+					"create":        "2h",
+					"read":          "",
+					"update":        "",
+					"delete":        "45m",
+				},
+			},
+			ExpectedCreate: 2 * time.Hour,
+			ExpectedDelete: pointer.To(45 * time.Minute),
+		},
+		{
+			Name: "Unknown Resource Type",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_unknown",
+					"create":        "2h",
+					"read":          "",
+					"update":        "",
+					"delete":        "",
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Update Timeout For A Resource Without Updates",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_example",
+					"create":        "",
+					"read":          "",
+					"update":        "1h",
+					"delete":        "",
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resources := newResources()
+		err := applyResourceTimeouts(resources, v.Input)
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		timeouts := resources["azurerm_example"].Timeouts
+		if *timeouts.Create != v.ExpectedCreate {
+			t.Fatalf("expected the `create` timeout to be %s but got %s", v.ExpectedCreate, *timeouts.Create)
+		}
+
+		if v.ExpectedDelete == nil && timeouts.Delete != nil {
+			t.Fatalf("expected no `delete` timeout but got %s", *timeouts.Delete)
+		}
+		if v.ExpectedDelete != nil && (timeouts.Delete == nil || *timeouts.Delete != *v.ExpectedDelete) {
+			t.Fatalf("expected the `delete` timeout to be %s but got %v", *v.ExpectedDelete, timeouts.Delete)
+		}
+	}
+}
@@ -167,7 +167,9 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		disks.Registration{},
 		domainservices.Registration{},
 		elasticsan.Registration{},
+		eventgrid.Registration{},
 		eventhub.Registration{},
+		firewall.Registration{},
 		fluidrelay.Registration{},
 		graphservices.Registration{},
 		storagecache.Registration{},
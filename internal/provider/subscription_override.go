@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// subscriptionOverrideDataSources are the Data Sources which support the `subscription_id` argument, allowing these
+// to be retrieved from one of the `additional_subscription_ids`
+var subscriptionOverrideDataSources = map[string]struct{}{
+	"azurerm_resource_group":  {},
+	"azurerm_subnet":          {},
+	"azurerm_virtual_network": {},
+}
+
+// subscriptionOverrideResources are the Resources which support the `subscription_id` argument, allowing these to be
+// managed within one of the `additional_subscription_ids`. Resources are only added once they've been confirmed to
+// only call APIs within their own Subscription, and the `subscription_id` argument has been documented.
+var subscriptionOverrideResources = map[string]struct{}{
+	"azurerm_resource_group":          {},
+	"azurerm_subnet":                  {},
+	"azurerm_virtual_network":         {},
+	"azurerm_virtual_network_peering": {},
+}
+
+func withSubscriptionOverrideForDataSource(key string, dataSource *schema.Resource) *schema.Resource {
+	if _, ok := subscriptionOverrideDataSources[key]; !ok {
+		return dataSource
+	}
+	return sdk.WithSubscriptionOverrideForDataSource(dataSource)
+}
+
+// WithSubscriptionOverride adds the `subscription_id` argument to the Resource when it supports this, which is also
+// used by the documentation tooling so that the schema matches the registered Resource.
+func WithSubscriptionOverride(key string, resource *schema.Resource) *schema.Resource {
+	if _, ok := subscriptionOverrideResources[key]; !ok {
+		return resource
+	}
+	return sdk.WithSubscriptionOverride(resource)
+}
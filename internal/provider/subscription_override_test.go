@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestSubscriptionOverrideResourcesExist(t *testing.T) {
+	provider := TestAzureProvider()
+
+	for name := range subscriptionOverrideDataSources {
+		dataSource, ok := provider.DataSourcesMap[name]
+		if !ok {
+			t.Fatalf("the Data Source %q supports the `subscription_id` override but isn't registered", name)
+		}
+		if v, ok := dataSource.Schema["subscription_id"]; !ok || !v.Optional {
+			t.Fatalf("the Data Source %q should have an Optional `subscription_id` argument", name)
+		}
+	}
+
+	for name := range subscriptionOverrideResources {
+		resource, ok := provider.ResourcesMap[name]
+		if !ok {
+			t.Fatalf("the Resource %q supports the `subscription_id` override but isn't registered", name)
+		}
+		if v, ok := resource.Schema["subscription_id"]; !ok || !v.Optional || !v.ForceNew {
+			t.Fatalf("the Resource %q should have an Optional and ForceNew `subscription_id` argument", name)
+		}
+	}
+
+	if _, ok := provider.ResourcesMap["azurerm_storage_account"].Schema["subscription_id"]; ok {
+		t.Fatalf("expected the `subscription_id` argument to only be added to Resources which opt-in to it")
+	}
+}
@@ -42,6 +42,39 @@ func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, su
 	return nil
 }
 
+// EnsureRegisteredInSubscription ensures the required Resource Providers are registered within the specified
+// Subscription - unlike EnsureRegistered this doesn't use the cache, which contains the Resource Providers for the
+// Subscription configured in the Provider block
+func EnsureRegisteredInSubscription(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, requiredRPs map[string]struct{}) error {
+	resp, err := client.ListComplete(ctx, subscriptionId, providers.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing Resource Providers for %s: %+v", subscriptionId, err)
+	}
+
+	registered := make(map[string]struct{})
+	unregistered := make(map[string]struct{})
+	for _, provider := range resp.Items {
+		if provider.Namespace == nil {
+			continue
+		}
+
+		if provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "registered") {
+			registered[*provider.Namespace] = struct{}{}
+		} else {
+			unregistered[*provider.Namespace] = struct{}{}
+		}
+	}
+
+	providersToRegister := requiringRegistration(requiredRPs, registered, unregistered)
+	if len(*providersToRegister) == 0 {
+		log.Printf("[DEBUG] All required Resource Providers are registered in %s", subscriptionId)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Registering %d Resource Providers in %s", len(*providersToRegister), subscriptionId)
+	return registerForSubscription(ctx, client, subscriptionId, *providersToRegister)
+}
+
 // registerForSubscription registers the specified Resource Providers in the current Subscription
 func registerForSubscription(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, providersToRegister []string) error {
 	var err error
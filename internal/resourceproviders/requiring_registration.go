@@ -14,20 +14,24 @@ func DetermineWhichRequiredResourceProvidersRequireRegistration(requiredResource
 		return nil, fmt.Errorf("internal-error: the registered/unregistered Resource Provider cache isn't populated")
 	}
 
-	requiringRegistration := make([]string, 0)
+	return requiringRegistration(requiredResourceProviders, *registeredResourceProviders, *unregisteredResourceProviders), nil
+}
+
+func requiringRegistration(requiredResourceProviders, registeredResourceProviders, unregisteredResourceProviders map[string]struct{}) *[]string {
+	out := make([]string, 0)
 	for providerName := range requiredResourceProviders {
-		if _, isRegistered := (*registeredResourceProviders)[providerName]; isRegistered {
+		if _, isRegistered := registeredResourceProviders[providerName]; isRegistered {
 			continue
 		}
 
-		if _, isUnregistered := (*unregisteredResourceProviders)[providerName]; !isUnregistered {
+		if _, isUnregistered := unregisteredResourceProviders[providerName]; !isUnregistered {
 			// some RPs may not exist in some non-public clouds, so we'll log a warning here instead of raising an error
 			log.Printf("[WARN] The required Resource Provider %q wasn't returned from the Azure API", providerName)
 			continue
 		}
 
-		requiringRegistration = append(requiringRegistration, providerName)
+		out = append(out, providerName)
 	}
 
-	return &requiringRegistration, nil
+	return &out
 }
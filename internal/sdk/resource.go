@@ -156,6 +156,53 @@ type ResourceWithCustomizeDiff interface {
 	CustomizeDiff() ResourceFunc
 }
 
+// ResourceWithList is an optional interface
+//
+// Resources implementing this interface can enumerate the existing instances of this
+// resource within a scope, which allows generating `import` blocks for existing infrastructure.
+type ResourceWithList interface {
+	Resource
+
+	// List returns a ResourceListFunc which lists the existing instances of this resource
+	List() ResourceListFunc
+}
+
+// ResourceListRunFunc is the function which lists the existing instances of a resource
+// ctx provides a Context instance with the timeout for the List operation
+// client is a reference to the Azure Providers Client
+// scope is the Subscription (and optionally Resource Group) to list the instances within
+type ResourceListRunFunc func(ctx context.Context, client *clients.Client, scope ListScope) ([]ListedResource, error)
+
+type ResourceListFunc struct {
+	// Func is the function which should be called to list the instances of this Resource
+	Func ResourceListRunFunc
+
+	// Timeout is the default timeout used when listing the instances of this Resource
+	Timeout time.Duration
+}
+
+// ListScope defines the scope which the instances of a Resource should be listed within
+type ListScope struct {
+	// SubscriptionId is the ID of the Subscription to list the instances within
+	SubscriptionId string
+
+	// ResourceGroupName optionally limits the instances to those within this Resource Group
+	ResourceGroupName string
+}
+
+// ListedResource is an existing instance of a Resource
+type ListedResource struct {
+	// ID is the Resource ID used to import this instance
+	ID string
+
+	// Name is the name of this instance, used to build the label for the generated configuration
+	Name string
+
+	// Arguments is a map of the top-level argument names to their values, used to build the
+	// skeleton configuration for this instance
+	Arguments map[string]interface{}
+}
+
 // ResourceRunFunc is the function which can be run
 // ctx provides a Context instance with the user-provided timeout
 // metadata is a reference to an object containing the Client, ResourceData and a Logger
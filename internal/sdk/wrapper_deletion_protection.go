@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// checkDeletionProtection returns an error when the `deletion_protection` block within the Provider `features`
+// block prevents this resource from being deleted - either by its Resource Type or by the tags assigned to it.
+func checkDeletionProtection(resourceType string, resourceSchema map[string]*schema.Schema, d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	protection := client.Features.DeletionProtection
+	if protection.AllowDeletionOfProtectedResources {
+		return nil
+	}
+
+	tags := make(map[string]interface{})
+	if v, ok := resourceSchema["tags"]; ok && v.Type == schema.TypeMap {
+		if raw, ok := d.Get("tags").(map[string]interface{}); ok {
+			tags = raw
+		}
+	}
+
+	if reason := protection.ProtectionReason(resourceType, tags); reason != "" {
+		return fmt.Errorf("the %s %q is protected from deletion since %s - to delete this resource set `allow_deletion_of_protected_resources` to `true` within the `deletion_protection` block in the Provider `features` block", resourceType, d.Id(), reason)
+	}
+
+	return nil
+}
+
+// WithDeletionProtection wraps the Delete functions of an Untyped Resource so that the resource
+// can't be deleted when it's protected by the `deletion_protection` feature.
+func WithDeletionProtection(resourceType string, resource *schema.Resource) *schema.Resource {
+	if resource == nil {
+		return resource
+	}
+
+	if resource.Delete != nil { // nolint: staticcheck
+		deleteFunc := resource.Delete                                            // nolint: staticcheck
+		resource.Delete = func(d *schema.ResourceData, meta interface{}) error { // nolint: staticcheck
+			if err := checkDeletionProtection(resourceType, resource.Schema, d, meta); err != nil {
+				return err
+			}
+			return deleteFunc(d, meta)
+		}
+	}
+
+	if resource.DeleteContext != nil {
+		deleteFunc := resource.DeleteContext
+		resource.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := checkDeletionProtection(resourceType, resource.Schema, d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return deleteFunc(ctx, d, meta)
+		}
+	}
+
+	if resource.DeleteWithoutTimeout != nil {
+		deleteFunc := resource.DeleteWithoutTimeout
+		resource.DeleteWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := checkDeletionProtection(resourceType, resource.Schema, d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return deleteFunc(ctx, d, meta)
+		}
+	}
+
+	return resource
+}
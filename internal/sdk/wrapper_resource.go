@@ -65,9 +65,15 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		// looks like these could be reused, easiest if they're not
 		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
-			return rw.resource.Read().Func(ctx, metaData)
+			return retryRead(ctx, meta, func() error {
+				return rw.resource.Read().Func(ctx, metaData)
+			})
 		}),
 		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			if err := checkDeletionProtection(rw.resource.ResourceType(), *resourceSchema, d, meta); err != nil {
+				return err
+			}
+
 			metaData := runArgs(d, meta, rw.logger)
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
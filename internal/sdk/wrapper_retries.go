@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// retryRead runs the Read function f using the Retry Policy defined in the Provider block.
+//
+// NOTE: only Read operations are retried, since Create, Update and Delete functions aren't idempotent - retrying
+// one of these in its entirety could (for example) orphan a resource which was created before a later call failed.
+func retryRead(ctx context.Context, meta interface{}, f func() error) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.RetryPolicy.MaxAttempts <= 1 {
+		return f()
+	}
+
+	return pluginsdk.RetryWithPolicy(ctx, client.RetryPolicy, func() *pluginsdk.RetryError {
+		if err := f(); err != nil {
+			return pluginsdk.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+func retryReadDiagnostics(ctx context.Context, meta interface{}, f func() diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	_ = retryRead(ctx, meta, func() error {
+		diags = f()
+		if !diags.HasError() {
+			return nil
+		}
+
+		errs := make([]string, 0)
+		for _, v := range diags {
+			if v.Severity == diag.Error {
+				errs = append(errs, v.Summary)
+			}
+		}
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	})
+	return diags
+}
+
+func stopContext(meta interface{}) context.Context {
+	if client, ok := meta.(*clients.Client); ok && client != nil && client.StopContext != nil {
+		return client.StopContext
+	}
+	return context.Background()
+}
+
+// WithRetryPolicy wraps the Read functions of an Untyped Resource so that reads failing with a
+// transient error are retried using the Retry Policy defined in the Provider block.
+func WithRetryPolicy(resource *schema.Resource) *schema.Resource {
+	if resource == nil {
+		return resource
+	}
+
+	// nolint: staticcheck
+	if read := resource.Read; read != nil {
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error { // nolint: staticcheck
+			return retryRead(stopContext(meta), meta, func() error {
+				return read(d, meta)
+			})
+		}
+	}
+
+	wrapContext := func(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if in == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return retryReadDiagnostics(ctx, meta, func() diag.Diagnostics {
+				return in(ctx, d, meta)
+			})
+		}
+	}
+
+	resource.ReadContext = wrapContext(resource.ReadContext)
+	resource.ReadWithoutTimeout = wrapContext(resource.ReadWithoutTimeout)
+
+	return resource
+}
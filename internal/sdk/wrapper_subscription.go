@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// subscriptionIdArgument is the name of the argument added to Resources and Data Sources, which allows
+// overriding the Subscription configured in the Provider block
+const subscriptionIdArgument = "subscription_id"
+
+var subscriptionIdFromResourceIdRegex = regexp.MustCompile(`(?i)^/subscriptions/([^/]+)`)
+
+// subscriptionIdFromResourceId returns the Subscription ID from a Resource Manager ID, or an empty string when
+// the ID isn't scoped to a Subscription (for example a Data Plane URI)
+func subscriptionIdFromResourceId(input string) string {
+	if match := subscriptionIdFromResourceIdRegex.FindStringSubmatch(input); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// subscriptionScopedMeta returns the Client scoped to the Subscription specified in the `subscription_id`
+// argument, or the Client for the Provider Subscription when this isn't set.
+func subscriptionScopedMeta(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil {
+		return meta, nil
+	}
+
+	subscriptionId, _ := d.Get(subscriptionIdArgument).(string)
+	if subscriptionId == "" {
+		return meta, nil
+	}
+
+	// the Subscription for an existing resource is taken from its Resource ID, which can be outside of the allowed
+	// Subscriptions (e.g. when the Subscription in the Provider block has changed) - which continues to be managed
+	// using the Provider Client until it's replaced
+	if !client.IsSubscriptionAllowed(subscriptionId) && strings.EqualFold(subscriptionId, subscriptionIdFromResourceId(d.Id())) {
+		return meta, nil
+	}
+
+	scoped, err := client.ForSubscription(ctx, subscriptionId)
+	if err != nil {
+		return nil, fmt.Errorf("`subscription_id`: %+v", err)
+	}
+	return scoped, nil
+}
+
+// importSubscriptionScopedMeta sets the `subscription_id` argument from the Resource ID being imported when this
+// is one of the `additional_subscription_ids`, and then returns the Client scoped to that Subscription.
+func importSubscriptionScopedMeta(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		subscriptionId := subscriptionIdFromResourceId(d.Id())
+		if subscriptionId != "" && !strings.EqualFold(subscriptionId, client.Account.SubscriptionId) && client.IsSubscriptionAllowed(subscriptionId) {
+			if err := d.Set(subscriptionIdArgument, subscriptionId); err != nil {
+				return nil, fmt.Errorf("setting `subscription_id`: %+v", err)
+			}
+		}
+	}
+
+	return subscriptionScopedMeta(ctx, d, meta)
+}
+
+// setSubscriptionId sets the `subscription_id` argument to the Subscription the resource exists within, which is
+// taken from the Resource ID (rather than the Client) so that a change to the Subscription is detected
+func setSubscriptionId(d *schema.ResourceData, meta interface{}) {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || d.Id() == "" {
+		return
+	}
+
+	subscriptionId := subscriptionIdFromResourceId(d.Id())
+	if subscriptionId == "" {
+		subscriptionId = client.Account.SubscriptionId
+	}
+	_ = d.Set(subscriptionIdArgument, subscriptionId)
+}
+
+// subscriptionIdCustomizeDiff replaces an existing resource when the `subscription_id` argument isn't specified and
+// the resource exists within a Subscription other than the Subscription configured in the Provider block - since
+// the argument is Computed, removing it (or changing the Provider Subscription) wouldn't otherwise show a diff
+func subscriptionIdCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.Account == nil || d.Id() == "" {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.GetAttr(subscriptionIdArgument).IsNull() {
+		return nil
+	}
+
+	existing, _ := d.Get(subscriptionIdArgument).(string)
+	if existing == "" || strings.EqualFold(existing, client.Account.SubscriptionId) {
+		return nil
+	}
+
+	return d.SetNew(subscriptionIdArgument, client.Account.SubscriptionId)
+}
+
+func subscriptionIdSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: validation.IsUUID,
+		Description:  "The ID of the Subscription this should be managed within, which must be either the Subscription configured in the Provider block or one of the `additional_subscription_ids`.",
+	}
+}
+
+// nolint: staticcheck
+func wrapLegacyWithSubscription(in func(d *schema.ResourceData, meta interface{}) error, setState bool) func(d *schema.ResourceData, meta interface{}) error {
+	if in == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		scoped, err := subscriptionScopedMeta(stopContext(meta), d, meta)
+		if err != nil {
+			return err
+		}
+		if err := in(d, scoped); err != nil {
+			return err
+		}
+		if setState {
+			setSubscriptionId(d, scoped)
+		}
+		return nil
+	}
+}
+
+func wrapContextWithSubscription(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics, setState bool) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if in == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		scoped, err := subscriptionScopedMeta(ctx, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags := in(ctx, d, scoped)
+		if setState && !diags.HasError() {
+			setSubscriptionId(d, scoped)
+		}
+		return diags
+	}
+}
+
+// WithSubscriptionOverride adds an optional `subscription_id` argument to a Resource, which allows managing the
+// Resource within one of the `additional_subscription_ids` using the Client scoped to that Subscription. This is
+// opt-in since the Resource must only call APIs within its own Subscription, and the argument must be documented.
+// Resources which already define a `subscription_id` argument are returned unchanged.
+func WithSubscriptionOverride(resource *schema.Resource) *schema.Resource {
+	if resource == nil || resource.Schema == nil {
+		return resource
+	}
+	if _, exists := resource.Schema[subscriptionIdArgument]; exists {
+		return resource
+	}
+
+	resource.Schema[subscriptionIdArgument] = subscriptionIdSchema(true)
+
+	resource.Create = wrapLegacyWithSubscription(resource.Create, true)  // nolint: staticcheck
+	resource.Read = wrapLegacyWithSubscription(resource.Read, true)      // nolint: staticcheck
+	resource.Update = wrapLegacyWithSubscription(resource.Update, true)  // nolint: staticcheck
+	resource.Delete = wrapLegacyWithSubscription(resource.Delete, false) // nolint: staticcheck
+
+	resource.CreateContext = wrapContextWithSubscription(resource.CreateContext, true)
+	resource.ReadContext = wrapContextWithSubscription(resource.ReadContext, true)
+	resource.UpdateContext = wrapContextWithSubscription(resource.UpdateContext, true)
+	resource.DeleteContext = wrapContextWithSubscription(resource.DeleteContext, false)
+
+	resource.CreateWithoutTimeout = wrapContextWithSubscription(resource.CreateWithoutTimeout, true)
+	resource.ReadWithoutTimeout = wrapContextWithSubscription(resource.ReadWithoutTimeout, true)
+	resource.UpdateWithoutTimeout = wrapContextWithSubscription(resource.UpdateWithoutTimeout, true)
+	resource.DeleteWithoutTimeout = wrapContextWithSubscription(resource.DeleteWithoutTimeout, false)
+
+	if customizeDiff := resource.CustomizeDiff; customizeDiff != nil {
+		resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := subscriptionIdCustomizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+			return customizeDiff(ctx, d, meta)
+		}
+	} else {
+		resource.CustomizeDiff = subscriptionIdCustomizeDiff
+	}
+
+	if importer := resource.Importer; importer != nil {
+		if importer.State != nil { // nolint: staticcheck
+			stateFunc := importer.State                                                                       // nolint: staticcheck
+			importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { // nolint: staticcheck
+				scoped, err := importSubscriptionScopedMeta(stopContext(meta), d, meta)
+				if err != nil {
+					return nil, err
+				}
+				return stateFunc(d, scoped)
+			}
+		}
+
+		if importer.StateContext != nil {
+			stateFunc := importer.StateContext
+			importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				scoped, err := importSubscriptionScopedMeta(ctx, d, meta)
+				if err != nil {
+					return nil, err
+				}
+				return stateFunc(ctx, d, scoped)
+			}
+		}
+	}
+
+	return resource
+}
+
+// WithSubscriptionOverrideForDataSource adds an optional `subscription_id` argument to a Data Source, which allows
+// retrieving the Data Source from one of the `additional_subscription_ids`. Data Sources which already define a
+// `subscription_id` argument are returned unchanged.
+func WithSubscriptionOverrideForDataSource(dataSource *schema.Resource) *schema.Resource {
+	if dataSource == nil || dataSource.Schema == nil {
+		return dataSource
+	}
+	if _, exists := dataSource.Schema[subscriptionIdArgument]; exists {
+		return dataSource
+	}
+
+	dataSource.Schema[subscriptionIdArgument] = subscriptionIdSchema(false)
+
+	dataSource.Read = wrapLegacyWithSubscription(dataSource.Read, true) // nolint: staticcheck
+	dataSource.ReadContext = wrapContextWithSubscription(dataSource.ReadContext, true)
+	dataSource.ReadWithoutTimeout = wrapContextWithSubscription(dataSource.ReadWithoutTimeout, true)
+
+	return dataSource
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func TestSubscriptionIdFromResourceId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+			Expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			Input:    "/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111",
+			Expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/example",
+			Expected: "",
+		},
+		{
+			Input:    "https://example.vault.azure.net/secrets/example",
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := subscriptionIdFromResourceId(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestWithSubscriptionOverrideExistingArgument(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+
+	WithSubscriptionOverride(resource)
+	if !resource.Schema["subscription_id"].Required {
+		t.Fatalf("expected the existing `subscription_id` argument to be unchanged")
+	}
+}
+
+func TestWithSubscriptionOverride(t *testing.T) {
+	const providerSubscriptionId = "11111111-1111-1111-1111-111111111111"
+
+	testData := []struct {
+		Name           string
+		SubscriptionId string
+		ID             string
+		ExpectError    bool
+	}{
+		{
+			Name: "no override",
+			ID:   "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+		},
+		{
+			Name:           "override using the Provider Subscription",
+			SubscriptionId: providerSubscriptionId,
+			ID:             "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+		},
+		{
+			Name:           "override using a Subscription which isn't allowed",
+			SubscriptionId: "22222222-2222-2222-2222-222222222222",
+			ExpectError:    true,
+		},
+		{
+			Name: "imported from a Subscription which isn't allowed",
+			ID:   "/subscriptions/22222222-2222-2222-2222-222222222222/resourceGroups/example",
+		},
+		{
+			// e.g. the Subscription configured in the Provider block has changed
+			Name:           "existing resource within a Subscription which isn't allowed",
+			SubscriptionId: "22222222-2222-2222-2222-222222222222",
+			ID:             "/subscriptions/22222222-2222-2222-2222-222222222222/resourceGroups/example",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		var used *clients.Client
+		resource := WithSubscriptionOverride(&schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+			CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return nil
+			},
+			ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				used = meta.(*clients.Client)
+				return nil
+			},
+			DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return nil
+			},
+		})
+		if err := resource.InternalValidate(nil, true); err != nil {
+			t.Fatalf("validating the Resource: %+v", err)
+		}
+
+		d := resource.TestResourceData()
+		d.SetId(v.ID)
+		if v.SubscriptionId != "" {
+			if err := d.Set("subscription_id", v.SubscriptionId); err != nil {
+				t.Fatalf("setting `subscription_id`: %+v", err)
+			}
+		}
+
+		client := &clients.Client{
+			Account: &clients.ResourceManagerAccount{
+				SubscriptionId: providerSubscriptionId,
+			},
+		}
+		diags := resource.ReadContext(context.TODO(), d, client)
+		if v.ExpectError {
+			if !diags.HasError() {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+
+		if diags.HasError() {
+			t.Fatalf("expected no error but got: %+v", diags)
+		}
+		if used != client {
+			t.Fatalf("expected the Provider Client to be used")
+		}
+		expected := subscriptionIdFromResourceId(v.ID)
+		if actual := d.Get("subscription_id").(string); actual != expected {
+			t.Fatalf("expected `subscription_id` to be %q but got %q", expected, actual)
+		}
+	}
+}
+
+func TestWithSubscriptionOverrideCustomizeDiff(t *testing.T) {
+	const providerSubscriptionId = "11111111-1111-1111-1111-111111111111"
+
+	testData := []struct {
+		Name                 string
+		ConfigSubscriptionId *string
+		StateSubscriptionId  string
+		ExpectReplace        bool
+	}{
+		{
+			Name:                "not specified within the Provider Subscription",
+			StateSubscriptionId: providerSubscriptionId,
+		},
+		{
+			Name:                 "specified within another Subscription",
+			ConfigSubscriptionId: pointer.To("22222222-2222-2222-2222-222222222222"),
+			StateSubscriptionId:  "22222222-2222-2222-2222-222222222222",
+		},
+		{
+			// e.g. the argument has been removed, or the Provider Subscription has changed
+			Name:                "not specified within another Subscription",
+			StateSubscriptionId: "22222222-2222-2222-2222-222222222222",
+			ExpectReplace:       true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resource := WithSubscriptionOverride(&schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		})
+
+		config := map[string]interface{}{
+			"name": "example",
+		}
+		rawConfig := map[string]cty.Value{
+			"id":              cty.NullVal(cty.String),
+			"name":            cty.StringVal("example"),
+			"subscription_id": cty.NullVal(cty.String),
+		}
+		if v.ConfigSubscriptionId != nil {
+			config["subscription_id"] = *v.ConfigSubscriptionId
+			rawConfig["subscription_id"] = cty.StringVal(*v.ConfigSubscriptionId)
+		}
+
+		state := &terraform.InstanceState{
+			ID: fmt.Sprintf("/subscriptions/%s/resourceGroups/example", v.StateSubscriptionId),
+			Attributes: map[string]string{
+				"id":              fmt.Sprintf("/subscriptions/%s/resourceGroups/example", v.StateSubscriptionId),
+				"name":            "example",
+				"subscription_id": v.StateSubscriptionId,
+			},
+			RawConfig: cty.ObjectVal(rawConfig),
+		}
+
+		client := &clients.Client{
+			Account: &clients.ResourceManagerAccount{
+				SubscriptionId: providerSubscriptionId,
+			},
+		}
+		diff, err := resource.SimpleDiff(context.TODO(), state, terraform.NewResourceConfigRaw(config), client)
+		if err != nil {
+			t.Fatalf("diffing the Resource: %+v", err)
+		}
+
+		replace := diff != nil && diff.RequiresNew()
+		if replace != v.ExpectReplace {
+			t.Fatalf("expected replace to be %t but got %t", v.ExpectReplace, replace)
+		}
+	}
+}
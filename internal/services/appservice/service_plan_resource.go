@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/appserviceplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/migration"
@@ -32,6 +33,8 @@ var _ sdk.ResourceWithUpdate = ServicePlanResource{}
 
 var _ sdk.ResourceWithStateMigration = ServicePlanResource{}
 
+var _ sdk.ResourceWithList = ServicePlanResource{}
+
 type OSType string
 
 const (
@@ -252,13 +255,7 @@ func (r ServicePlanResource) Read() sdk.ResourceFunc {
 
 				// props read
 				if props := model.Properties; props != nil {
-					state.OSType = OSTypeWindows
-					if props.HyperV != nil && *props.HyperV {
-						state.OSType = OSTypeWindowsContainer
-					}
-					if props.Reserved != nil && *props.Reserved {
-						state.OSType = OSTypeLinux
-					}
+					state.OSType = servicePlanOSType(*props)
 
 					if ase := props.HostingEnvironmentProfile; ase != nil && ase.Id != nil {
 						state.AppServiceEnvironmentId = *ase.Id
@@ -368,6 +365,70 @@ func isServicePlanSupportScaleOut(plan string) bool {
 	return support
 }
 
+func (r ServicePlanResource) List() sdk.ResourceListFunc {
+	return sdk.ResourceListFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, client *clients.Client, scope sdk.ListScope) ([]sdk.ListedResource, error) {
+			servicePlanClient := client.AppService.ServicePlanClient
+
+			var items []appserviceplans.AppServicePlan
+			if scope.ResourceGroupName != "" {
+				resourceGroupId := commonids.NewResourceGroupID(scope.SubscriptionId, scope.ResourceGroupName)
+				resp, err := servicePlanClient.ListByResourceGroupComplete(ctx, resourceGroupId)
+				if err != nil {
+					return nil, fmt.Errorf("listing Service Plans within %s: %+v", resourceGroupId, err)
+				}
+				items = resp.Items
+			} else {
+				subscriptionId := commonids.NewSubscriptionID(scope.SubscriptionId)
+				resp, err := servicePlanClient.ListComplete(ctx, subscriptionId, appserviceplans.DefaultListOperationOptions())
+				if err != nil {
+					return nil, fmt.Errorf("listing Service Plans within %s: %+v", subscriptionId, err)
+				}
+				items = resp.Items
+			}
+
+			output := make([]sdk.ListedResource, 0)
+			for _, item := range items {
+				id, err := commonids.ParseAppServicePlanIDInsensitively(pointer.From(item.Id))
+				if err != nil {
+					return nil, err
+				}
+
+				arguments := map[string]interface{}{
+					"name":                id.ServerFarmName,
+					"resource_group_name": id.ResourceGroupName,
+					"location":            location.Normalize(item.Location),
+				}
+				if item.Sku != nil && item.Sku.Name != nil {
+					arguments["sku_name"] = *item.Sku.Name
+				}
+				if item.Properties != nil {
+					arguments["os_type"] = string(servicePlanOSType(*item.Properties))
+				}
+
+				output = append(output, sdk.ListedResource{
+					ID:        id.ID(),
+					Name:      id.ServerFarmName,
+					Arguments: arguments,
+				})
+			}
+
+			return output, nil
+		},
+	}
+}
+
+func servicePlanOSType(input appserviceplans.AppServicePlanProperties) OSType {
+	if input.Reserved != nil && *input.Reserved {
+		return OSTypeLinux
+	}
+	if input.HyperV != nil && *input.HyperV {
+		return OSTypeWindowsContainer
+	}
+	return OSTypeWindows
+}
+
 func (r ServicePlanResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
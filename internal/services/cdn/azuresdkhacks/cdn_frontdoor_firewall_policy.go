@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/frontdoor/mgmt/2020-11-01/frontdoor" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// NOTE: this workaround client exists since the JavaScript Challenge, Log Scrubbing and Rate Limit grouping
+// settings are only available in newer API versions than the one supported by the (frozen) Track1 SDK, and
+// the vendored version of `go-azure-sdk` doesn't contain a newer version of the `frontdoor` API. The payload
+// is built from the Track1 model and the additional fields are merged into it prior to sending - any fields
+// returned by the newer API which aren't known to the Track1 model are carried over from the existing policy
+// so that these aren't reset. This client should only be used when one of these settings is in use.
+const firewallPolicyAPIVersion = "2024-02-01"
+
+const (
+	ActionTypeJSChallenge frontdoor.ActionType = "JSChallenge"
+)
+
+type CdnFrontDoorFirewallPoliciesWorkaroundClient struct {
+	sdkClient *frontdoor.PoliciesClient
+}
+
+func NewCdnFrontDoorFirewallPoliciesWorkaroundClient(client *frontdoor.PoliciesClient) CdnFrontDoorFirewallPoliciesWorkaroundClient {
+	return CdnFrontDoorFirewallPoliciesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// FirewallPolicyExtensions contains the settings which aren't exposed by the Track1 SDK
+type FirewallPolicyExtensions struct {
+	// JavascriptChallengeExpirationInMinutes - the lifetime of the JavaScript Challenge cookie
+	JavascriptChallengeExpirationInMinutes *int32
+	// LogScrubbing - the rules used to scrub sensitive fields from the Web Application Firewall logs
+	LogScrubbing *PolicySettingsLogScrubbing
+	// CustomRuleGroupBy - the variables used to group requests for rate limiting, keyed by the name of the Custom Rule
+	CustomRuleGroupBy map[string][]GroupByVariable
+
+	// unknownProperties and unknownPolicySettings contain the fields returned by the API which aren't known to
+	// either the Track1 model or the extensions above, which are sent back as-is when updating the policy
+	unknownProperties     map[string]json.RawMessage
+	unknownPolicySettings map[string]json.RawMessage
+}
+
+// knownFirewallPolicyProperties are the fields within `properties` which are either exposed by the Track1 model
+// (including the read-only fields) or are managed by the extensions
+var knownFirewallPolicyProperties = map[string]struct{}{
+	"customRules":           {},
+	"frontendEndpointLinks": {},
+	"managedRules":          {},
+	"policySettings":        {},
+	"provisioningState":     {},
+	"resourceState":         {},
+	"routingRuleLinks":      {},
+	"securityPolicyLinks":   {},
+}
+
+// knownFirewallPolicySettings are the fields within `properties.policySettings` which are either exposed by the
+// Track1 model or are managed by the extensions
+var knownFirewallPolicySettings = map[string]struct{}{
+	"customBlockResponseBody":                {},
+	"customBlockResponseStatusCode":          {},
+	"enabledState":                           {},
+	"javascriptChallengeExpirationInMinutes": {},
+	"logScrubbing":                           {},
+	"mode":                                   {},
+	"redirectUrl":                            {},
+	"requestBodyCheck":                       {},
+}
+
+type PolicySettingsLogScrubbing struct {
+	ScrubbingRules *[]WebApplicationFirewallScrubbingRules `json:"scrubbingRules,omitempty"`
+	State          string                                  `json:"state,omitempty"`
+}
+
+type WebApplicationFirewallScrubbingRules struct {
+	MatchVariable         string  `json:"matchVariable"`
+	Selector              *string `json:"selector,omitempty"`
+	SelectorMatchOperator string  `json:"selectorMatchOperator"`
+	State                 string  `json:"state,omitempty"`
+}
+
+type GroupByVariable struct {
+	VariableName string `json:"variableName"`
+}
+
+func (c CdnFrontDoorFirewallPoliciesWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, policyName string, parameters frontdoor.WebApplicationFirewallPolicy, extensions FirewallPolicyExtensions) (result frontdoor.PoliciesCreateOrUpdateFuture, err error) {
+	payload, err := MergeFirewallPolicyExtensions(parameters, extensions)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "CreateOrUpdate", nil, "Failure building request payload")
+		return
+	}
+
+	req, err := c.preparer(ctx, resourceGroupName, policyName, autorest.AsPut(), autorest.WithJSON(payload))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "CreateOrUpdate", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+func (c CdnFrontDoorFirewallPoliciesWorkaroundClient) Get(ctx context.Context, resourceGroupName string, policyName string) (result frontdoor.WebApplicationFirewallPolicy, extensions FirewallPolicyExtensions, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, policyName, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	// the body is consumed by the Track1 responder, so it's buffered to allow the extensions to be parsed too
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "Get", resp, "Failure reading response")
+		return
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	result, err = c.sdkClient.GetResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "Get", resp, "Failure responding to request")
+		return
+	}
+
+	extensions, err = ParseFirewallPolicyExtensions(body)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "frontdoor.PoliciesClient", "Get", resp, "Failure parsing response")
+	}
+
+	return
+}
+
+func (c CdnFrontDoorFirewallPoliciesWorkaroundClient) preparer(ctx context.Context, resourceGroupName string, policyName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"policyName":        autorest.Encode("path", policyName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": firewallPolicyAPIVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/FrontDoorWebApplicationFirewallPolicies/{policyName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// MergeFirewallPolicyExtensions returns the JSON payload for the Track1 model with the extensions merged into it
+func MergeFirewallPolicyExtensions(input frontdoor.WebApplicationFirewallPolicy, extensions FirewallPolicyExtensions) (map[string]interface{}, error) {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling policy: %+v", err)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("unmarshaling policy: %+v", err)
+	}
+
+	properties, ok := payload["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		payload["properties"] = properties
+	}
+
+	policySettings, ok := properties["policySettings"].(map[string]interface{})
+	if !ok {
+		policySettings = make(map[string]interface{})
+		properties["policySettings"] = policySettings
+	}
+
+	for k, v := range extensions.unknownProperties {
+		if _, ok := properties[k]; !ok {
+			properties[k] = v
+		}
+	}
+
+	for k, v := range extensions.unknownPolicySettings {
+		if _, ok := policySettings[k]; !ok {
+			policySettings[k] = v
+		}
+	}
+
+	if extensions.JavascriptChallengeExpirationInMinutes != nil {
+		policySettings["javascriptChallengeExpirationInMinutes"] = *extensions.JavascriptChallengeExpirationInMinutes
+	}
+
+	if extensions.LogScrubbing != nil {
+		policySettings["logScrubbing"] = extensions.LogScrubbing
+	}
+
+	if customRules, ok := properties["customRules"].(map[string]interface{}); ok {
+		if rules, ok := customRules["rules"].([]interface{}); ok {
+			for _, item := range rules {
+				rule, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				name, _ := rule["name"].(string)
+				if groupBy, ok := extensions.CustomRuleGroupBy[name]; ok && len(groupBy) > 0 {
+					rule["groupBy"] = groupBy
+				}
+			}
+		}
+	}
+
+	return payload, nil
+}
+
+// ParseFirewallPolicyExtensions parses the extensions from the JSON representation of a policy
+func ParseFirewallPolicyExtensions(input []byte) (FirewallPolicyExtensions, error) {
+	var response struct {
+		Properties *struct {
+			PolicySettings *struct {
+				JavascriptChallengeExpirationInMinutes *int32                      `json:"javascriptChallengeExpirationInMinutes,omitempty"`
+				LogScrubbing                           *PolicySettingsLogScrubbing `json:"logScrubbing,omitempty"`
+			} `json:"policySettings,omitempty"`
+			CustomRules *struct {
+				Rules *[]struct {
+					Name    *string           `json:"name,omitempty"`
+					GroupBy []GroupByVariable `json:"groupBy,omitempty"`
+				} `json:"rules,omitempty"`
+			} `json:"customRules,omitempty"`
+		} `json:"properties,omitempty"`
+	}
+
+	output := FirewallPolicyExtensions{
+		CustomRuleGroupBy:     make(map[string][]GroupByVariable),
+		unknownProperties:     make(map[string]json.RawMessage),
+		unknownPolicySettings: make(map[string]json.RawMessage),
+	}
+
+	if len(input) == 0 {
+		return output, nil
+	}
+
+	if err := json.Unmarshal(input, &response); err != nil {
+		return output, err
+	}
+
+	var raw struct {
+		Properties map[string]json.RawMessage `json:"properties,omitempty"`
+	}
+	if err := json.Unmarshal(input, &raw); err != nil {
+		return output, err
+	}
+
+	for k, v := range raw.Properties {
+		if _, ok := knownFirewallPolicyProperties[k]; !ok {
+			output.unknownProperties[k] = v
+		}
+	}
+
+	if v, ok := raw.Properties["policySettings"]; ok {
+		var policySettings map[string]json.RawMessage
+		if err := json.Unmarshal(v, &policySettings); err != nil {
+			return output, err
+		}
+
+		for k, v := range policySettings {
+			if _, ok := knownFirewallPolicySettings[k]; !ok {
+				output.unknownPolicySettings[k] = v
+			}
+		}
+	}
+
+	if response.Properties == nil {
+		return output, nil
+	}
+
+	if settings := response.Properties.PolicySettings; settings != nil {
+		output.JavascriptChallengeExpirationInMinutes = settings.JavascriptChallengeExpirationInMinutes
+		output.LogScrubbing = settings.LogScrubbing
+	}
+
+	if customRules := response.Properties.CustomRules; customRules != nil && customRules.Rules != nil {
+		for _, rule := range *customRules.Rules {
+			if rule.Name == nil || len(rule.GroupBy) == 0 {
+				continue
+			}
+			output.CustomRuleGroupBy[*rule.Name] = rule.GroupBy
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/frontdoor/mgmt/2020-11-01/frontdoor" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceCdnFrontDoorFirewallPolicyMigration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceCdnFrontDoorFirewallPolicyMigrationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"frontdoor_firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.FrontDoorFirewallPolicyID,
+			},
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(frontdoor.SkuNameStandardAzureFrontDoor),
+					string(frontdoor.SkuNamePremiumAzureFrontDoor),
+				}, false),
+			},
+
+			"mode": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"redirect_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"request_body_check_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"custom_block_response_status_code": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"custom_block_response_body": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"custom_rule": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"priority": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"rate_limit_duration_in_minutes": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"rate_limit_threshold": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"rate_limit_group_by": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"action": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"match_condition": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"match_variable": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"match_values": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"operator": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"selector": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"negation_condition": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+
+									"transforms": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"managed_rule": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"action": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"exclusion": cdnFrontDoorFirewallPolicyMigrationExclusionSchema(),
+
+						"override": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"rule_group_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"exclusion": cdnFrontDoorFirewallPolicyMigrationExclusionSchema(),
+
+									"rule": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"rule_id": {
+													Type:     pluginsdk.TypeString,
+													Computed: true,
+												},
+
+												"enabled": {
+													Type:     pluginsdk.TypeBool,
+													Computed: true,
+												},
+
+												"exclusion": cdnFrontDoorFirewallPolicyMigrationExclusionSchema(),
+
+												"action": {
+													Type:     pluginsdk.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"warnings": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func cdnFrontDoorFirewallPolicyMigrationExclusionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"match_variable": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"operator": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"selector": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCdnFrontDoorFirewallPolicyMigrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorLegacyFirewallPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorFirewallPolicyID(d.Get("frontdoor_firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	workaroundClient := azuresdkhacks.NewCdnFrontDoorFirewallPoliciesWorkaroundClient(client)
+	resp, extensions, err := workaroundClient.Get(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Sku == nil || resp.Sku.Name != frontdoor.SkuNameClassicAzureFrontDoor {
+		skuName := ""
+		if resp.Sku != nil {
+			skuName = string(resp.Sku.Name)
+		}
+		return fmt.Errorf("%s is not a classic Front Door Firewall Policy, expected the sku %q but got %q", *id, frontdoor.SkuNameClassicAzureFrontDoor, skuName)
+	}
+
+	properties := resp.WebApplicationFirewallPolicyProperties
+	if properties == nil {
+		return fmt.Errorf("retrieving %s: 'properties' was nil", *id)
+	}
+
+	hasManagedRules := properties.ManagedRules != nil && properties.ManagedRules.ManagedRuleSets != nil && len(*properties.ManagedRules.ManagedRuleSets) > 0
+
+	skuName := d.Get("sku_name").(string)
+	if skuName == "" {
+		// managed rules are only available for the Premium sku, so that's needed to retain the same level of protection
+		skuName = string(frontdoor.SkuNameStandardAzureFrontDoor)
+		if hasManagedRules {
+			skuName = string(frontdoor.SkuNamePremiumAzureFrontDoor)
+		}
+	}
+
+	warnings := make([]string, 0)
+
+	d.SetId(id.ID())
+	d.Set("sku_name", skuName)
+
+	if policy := properties.PolicySettings; policy != nil {
+		d.Set("enabled", policy.EnabledState == frontdoor.PolicyEnabledStateEnabled)
+		d.Set("mode", string(policy.Mode))
+		d.Set("request_body_check_enabled", policy.RequestBodyCheck == frontdoor.PolicyRequestBodyCheckEnabled)
+		d.Set("redirect_url", policy.RedirectURL)
+		d.Set("custom_block_response_status_code", policy.CustomBlockResponseStatusCode)
+		d.Set("custom_block_response_body", policy.CustomBlockResponseBody)
+	}
+
+	if err := d.Set("custom_rule", flattenCdnFrontDoorFirewallCustomRules(properties.CustomRules, extensions.CustomRuleGroupBy)); err != nil {
+		return fmt.Errorf("flattening 'custom_rule': %+v", err)
+	}
+
+	managedRules := flattenCdnFrontDoorFirewallManagedRules(properties.ManagedRules)
+	if hasManagedRules && skuName != string(frontdoor.SkuNamePremiumAzureFrontDoor) {
+		warnings = append(warnings, fmt.Sprintf("the classic policy contains %d managed rule set(s) which are only supported with the %q sku and have been omitted", len(managedRules), frontdoor.SkuNamePremiumAzureFrontDoor))
+		managedRules = []interface{}{}
+	}
+	if err := d.Set("managed_rule", managedRules); err != nil {
+		return fmt.Errorf("flattening 'managed_rule': %+v", err)
+	}
+
+	if links := flattenFrontendEndpointLinkSlice(properties.FrontendEndpointLinks); len(links) > 0 {
+		warnings = append(warnings, fmt.Sprintf("the classic policy is associated with %d frontend endpoint(s) - the migrated policy needs to be associated with the equivalent domains using an `azurerm_cdn_frontdoor_security_policy`", len(links)))
+	}
+
+	if err := d.Set("warnings", warnings); err != nil {
+		return fmt.Errorf("setting 'warnings': %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, flattenFrontDoorTags(resp.Tags))
+}
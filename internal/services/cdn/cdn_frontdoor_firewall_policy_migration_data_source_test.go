@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CdnFrontDoorFirewallPolicyMigrationDataSource struct{}
+
+func TestAccCdnFrontDoorFirewallPolicyMigrationDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cdn_frontdoor_firewall_policy_migration", "test")
+	d := CdnFrontDoorFirewallPolicyMigrationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sku_name").HasValue("Premium_AzureFrontDoor"),
+				check.That(data.ResourceName).Key("mode").HasValue("Prevention"),
+				check.That(data.ResourceName).Key("custom_rule.#").HasValue("1"),
+				check.That(data.ResourceName).Key("managed_rule.#").HasValue("1"),
+				check.That("azurerm_cdn_frontdoor_firewall_policy.test").Key("custom_rule.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccCdnFrontDoorFirewallPolicyMigrationDataSource_standardSku(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cdn_frontdoor_firewall_policy_migration", "test")
+	d := CdnFrontDoorFirewallPolicyMigrationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.standardSku(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sku_name").HasValue("Standard_AzureFrontDoor"),
+				check.That(data.ResourceName).Key("managed_rule.#").HasValue("0"),
+				check.That(data.ResourceName).Key("warnings.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (CdnFrontDoorFirewallPolicyMigrationDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cdn-afdx-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                = "accTestClassicWAF%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  enabled             = true
+  mode                = "Prevention"
+
+  custom_rule {
+    name                           = "Rule1"
+    enabled                        = true
+    priority                       = 1
+    rate_limit_duration_in_minutes = 1
+    rate_limit_threshold           = 10
+    type                           = "MatchRule"
+    action                         = "Block"
+
+    match_condition {
+      match_variable     = "RemoteAddr"
+      operator           = "IPMatch"
+      negation_condition = false
+      match_values       = ["192.168.1.0/24"]
+    }
+  }
+
+  managed_rule {
+    type    = "Microsoft_DefaultRuleSet"
+    version = "2.1"
+    action  = "Block"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (d CdnFrontDoorFirewallPolicyMigrationDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cdn_frontdoor_firewall_policy_migration" "test" {
+  frontdoor_firewall_policy_id = azurerm_frontdoor_firewall_policy.test.id
+}
+
+resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
+  name                = "accTestWAF%d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = data.azurerm_cdn_frontdoor_firewall_policy_migration.test.sku_name
+  enabled             = data.azurerm_cdn_frontdoor_firewall_policy_migration.test.enabled
+  mode                = data.azurerm_cdn_frontdoor_firewall_policy_migration.test.mode
+
+  dynamic "custom_rule" {
+    for_each = data.azurerm_cdn_frontdoor_firewall_policy_migration.test.custom_rule
+    content {
+      name                           = custom_rule.value.name
+      enabled                        = custom_rule.value.enabled
+      priority                       = custom_rule.value.priority
+      rate_limit_duration_in_minutes = custom_rule.value.rate_limit_duration_in_minutes
+      rate_limit_threshold           = custom_rule.value.rate_limit_threshold
+      type                           = custom_rule.value.type
+      action                         = custom_rule.value.action
+
+      dynamic "match_condition" {
+        for_each = custom_rule.value.match_condition
+        content {
+          match_variable     = match_condition.value.match_variable
+          operator           = match_condition.value.operator
+          negation_condition = match_condition.value.negation_condition
+          match_values       = match_condition.value.match_values
+        }
+      }
+    }
+  }
+
+  dynamic "managed_rule" {
+    for_each = data.azurerm_cdn_frontdoor_firewall_policy_migration.test.managed_rule
+    content {
+      type    = managed_rule.value.type
+      version = managed_rule.value.version
+      action  = managed_rule.value.action
+    }
+  }
+}
+`, d.template(data), data.RandomInteger)
+}
+
+func (d CdnFrontDoorFirewallPolicyMigrationDataSource) standardSku(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cdn_frontdoor_firewall_policy_migration" "test" {
+  frontdoor_firewall_policy_id = azurerm_frontdoor_firewall_policy.test.id
+  sku_name                     = "Standard_AzureFrontDoor"
+}
+`, d.template(data))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -23,6 +24,23 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+const (
+	cdnFrontDoorFirewallGroupByGeoLocation = "GeoLocation"
+	cdnFrontDoorFirewallGroupByNone        = "None"
+	cdnFrontDoorFirewallGroupBySocketAddr  = "SocketAddr"
+
+	cdnFrontDoorFirewallScrubbingMatchVariableQueryStringArgNames     = "QueryStringArgNames"
+	cdnFrontDoorFirewallScrubbingMatchVariableRequestBodyJSONArgNames = "RequestBodyJsonArgNames"
+	cdnFrontDoorFirewallScrubbingMatchVariableRequestBodyPostArgNames = "RequestBodyPostArgNames"
+	cdnFrontDoorFirewallScrubbingMatchVariableRequestCookieNames      = "RequestCookieNames"
+	cdnFrontDoorFirewallScrubbingMatchVariableRequestHeaderNames      = "RequestHeaderNames"
+	cdnFrontDoorFirewallScrubbingMatchVariableRequestIPAddress        = "RequestIPAddress"
+	cdnFrontDoorFirewallScrubbingMatchVariableRequestURI              = "RequestUri"
+
+	cdnFrontDoorFirewallScrubbingOperatorEquals    = "Equals"
+	cdnFrontDoorFirewallScrubbingOperatorEqualsAny = "EqualsAny"
+)
+
 func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCdnFrontDoorFirewallPolicyCreate,
@@ -107,6 +125,73 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsBase64,
 			},
 
+			"js_challenge_cookie_expiration_in_minutes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(5, 1440),
+			},
+
+			"log_scrubbing": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"scrubbing_rule": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 100,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"match_variable": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											cdnFrontDoorFirewallScrubbingMatchVariableQueryStringArgNames,
+											cdnFrontDoorFirewallScrubbingMatchVariableRequestBodyJSONArgNames,
+											cdnFrontDoorFirewallScrubbingMatchVariableRequestBodyPostArgNames,
+											cdnFrontDoorFirewallScrubbingMatchVariableRequestCookieNames,
+											cdnFrontDoorFirewallScrubbingMatchVariableRequestHeaderNames,
+											cdnFrontDoorFirewallScrubbingMatchVariableRequestIPAddress,
+											cdnFrontDoorFirewallScrubbingMatchVariableRequestURI,
+										}, false),
+									},
+
+									"enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+
+									"operator": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+										Default:  cdnFrontDoorFirewallScrubbingOperatorEquals,
+										ValidateFunc: validation.StringInSlice([]string{
+											cdnFrontDoorFirewallScrubbingOperatorEquals,
+											cdnFrontDoorFirewallScrubbingOperatorEqualsAny,
+										}, false),
+									},
+
+									"selector": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"custom_rule": {
 				Type:     pluginsdk.TypeList,
 				MaxItems: 100,
@@ -160,9 +245,24 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 								string(frontdoor.ActionTypeBlock),
 								string(frontdoor.ActionTypeLog),
 								string(frontdoor.ActionTypeRedirect),
+								string(azuresdkhacks.ActionTypeJSChallenge),
 							}, false),
 						},
 
+						"rate_limit_group_by": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									cdnFrontDoorFirewallGroupByGeoLocation,
+									cdnFrontDoorFirewallGroupByNone,
+									cdnFrontDoorFirewallGroupBySocketAddr,
+								}, false),
+							},
+						},
+
 						"match_condition": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
@@ -427,7 +527,8 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 														string(frontdoor.ActionTypeLog),
 														string(frontdoor.ActionTypeBlock),
 														string(frontdoor.ActionTypeRedirect),
-														"AnomalyScoring", // Only valid with 2.0 and above
+														string(azuresdkhacks.ActionTypeJSChallenge), // Only valid with the Bot Manager rule sets
+														"AnomalyScoring",                            // Only valid with 2.0 and above
 													}, false),
 												},
 											},
@@ -502,6 +603,24 @@ func resourceCdnFrontDoorFirewallPolicyCreate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("the 'managed_rule' field is only supported with the 'Premium_AzureFrontDoor' sku, got %q", sku)
 	}
 
+	if err := validateCdnFrontDoorFirewallPolicyFeatures(d, sku); err != nil {
+		return err
+	}
+
+	logScrubbing, err := expandCdnFrontDoorFirewallLogScrubbing(d.Get("log_scrubbing").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding 'log_scrubbing': %+v", err)
+	}
+
+	extensions := azuresdkhacks.FirewallPolicyExtensions{
+		LogScrubbing:      logScrubbing,
+		CustomRuleGroupBy: expandCdnFrontDoorFirewallCustomRulesGroupBy(customRules),
+	}
+
+	if v, ok := d.GetOk("js_challenge_cookie_expiration_in_minutes"); ok {
+		extensions.JavascriptChallengeExpirationInMinutes = utils.Int32(int32(v.(int)))
+	}
+
 	t := d.Get("tags").(map[string]interface{})
 
 	payload := frontdoor.WebApplicationFirewallPolicy{
@@ -536,7 +655,13 @@ func resourceCdnFrontDoorFirewallPolicyCreate(d *pluginsdk.ResourceData, meta in
 		payload.WebApplicationFirewallPolicyProperties.PolicySettings.CustomBlockResponseStatusCode = utils.Int32(int32(customBlockResponseStatusCode))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName, payload)
+	var future frontdoor.PoliciesCreateOrUpdateFuture
+	if cdnFrontDoorFirewallPolicyRequiresWorkaroundClient(d) {
+		workaroundClient := azuresdkhacks.NewCdnFrontDoorFirewallPoliciesWorkaroundClient(client)
+		future, err = workaroundClient.CreateOrUpdate(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName, payload, extensions)
+	} else {
+		future, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName, payload)
+	}
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -559,7 +684,17 @@ func resourceCdnFrontDoorFirewallPolicyUpdate(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	existing, err := client.Get(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
+	// the newer API version is only used when one of the settings which require it is (or was) configured
+	useWorkaroundClient := cdnFrontDoorFirewallPolicyRequiresWorkaroundClient(d)
+	workaroundClient := azuresdkhacks.NewCdnFrontDoorFirewallPoliciesWorkaroundClient(client)
+
+	var existing frontdoor.WebApplicationFirewallPolicy
+	var extensions azuresdkhacks.FirewallPolicyExtensions
+	if useWorkaroundClient {
+		existing, extensions, err = workaroundClient.Get(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
+	} else {
+		existing, err = client.Get(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
+	}
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
@@ -574,6 +709,32 @@ func resourceCdnFrontDoorFirewallPolicyUpdate(d *pluginsdk.ResourceData, meta in
 
 	props := *existing.WebApplicationFirewallPolicyProperties
 
+	if err := validateCdnFrontDoorFirewallPolicyFeatures(d, string(existing.Sku.Name)); err != nil {
+		return err
+	}
+
+	if d.HasChange("js_challenge_cookie_expiration_in_minutes") {
+		if v := d.Get("js_challenge_cookie_expiration_in_minutes").(int); v > 0 {
+			extensions.JavascriptChallengeExpirationInMinutes = utils.Int32(int32(v))
+		}
+	}
+
+	if d.HasChange("log_scrubbing") {
+		logScrubbing, err := expandCdnFrontDoorFirewallLogScrubbing(d.Get("log_scrubbing").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding 'log_scrubbing': %+v", err)
+		}
+
+		if logScrubbing == nil {
+			// removing the block has to explicitly disable log scrubbing, otherwise the existing rules are retained
+			logScrubbing = &azuresdkhacks.PolicySettingsLogScrubbing{
+				ScrubbingRules: &[]azuresdkhacks.WebApplicationFirewallScrubbingRules{},
+				State:          string(frontdoor.PolicyEnabledStateDisabled),
+			}
+		}
+		extensions.LogScrubbing = logScrubbing
+	}
+
 	if d.HasChanges("custom_block_response_body", "custom_block_response_status_code", "enabled", "mode", "redirect_url", "request_body_check_enabled") {
 		enabled := frontdoor.PolicyEnabledStateDisabled
 		if d.Get("enabled").(bool) {
@@ -604,6 +765,7 @@ func resourceCdnFrontDoorFirewallPolicyUpdate(d *pluginsdk.ResourceData, meta in
 
 	if d.HasChange("custom_rule") {
 		props.CustomRules = expandCdnFrontDoorFirewallCustomRules(d.Get("custom_rule").([]interface{}))
+		extensions.CustomRuleGroupBy = expandCdnFrontDoorFirewallCustomRulesGroupBy(d.Get("custom_rule").([]interface{}))
 	}
 
 	if d.HasChange("managed_rule") {
@@ -627,7 +789,12 @@ func resourceCdnFrontDoorFirewallPolicyUpdate(d *pluginsdk.ResourceData, meta in
 	}
 
 	existing.WebApplicationFirewallPolicyProperties = &props
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName, existing)
+	var future frontdoor.PoliciesCreateOrUpdateFuture
+	if useWorkaroundClient {
+		future, err = workaroundClient.CreateOrUpdate(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName, existing, extensions)
+	} else {
+		future, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName, existing)
+	}
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
@@ -648,7 +815,8 @@ func resourceCdnFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
+	workaroundClient := azuresdkhacks.NewCdnFrontDoorFirewallPoliciesWorkaroundClient(client)
+	resp, extensions, err := workaroundClient.Get(ctx, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[INFO] Cdn Frontdoor Firewall Policy %q does not exist - removing from state", d.Id())
@@ -677,7 +845,7 @@ func resourceCdnFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("custom_block_response_body", policy.CustomBlockResponseBody)
 		}
 
-		if err := d.Set("custom_rule", flattenCdnFrontDoorFirewallCustomRules(properties.CustomRules)); err != nil {
+		if err := d.Set("custom_rule", flattenCdnFrontDoorFirewallCustomRules(properties.CustomRules, extensions.CustomRuleGroupBy)); err != nil {
 			return fmt.Errorf("flattening 'custom_rule': %+v", err)
 		}
 
@@ -690,6 +858,16 @@ func resourceCdnFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	jsChallengeCookieExpiration := 0
+	if v := extensions.JavascriptChallengeExpirationInMinutes; v != nil {
+		jsChallengeCookieExpiration = int(*v)
+	}
+	d.Set("js_challenge_cookie_expiration_in_minutes", jsChallengeCookieExpiration)
+
+	if err := d.Set("log_scrubbing", flattenCdnFrontDoorFirewallLogScrubbing(extensions.LogScrubbing)); err != nil {
+		return fmt.Errorf("flattening 'log_scrubbing': %+v", err)
+	}
+
 	if err := tags.FlattenAndSet(d, flattenFrontDoorTags(resp.Tags)); err != nil {
 		return err
 	}
@@ -949,7 +1127,7 @@ func expandCdnFrontDoorFirewallRuleOverride(input []interface{}, versionRaw stri
 	return &result, nil
 }
 
-func flattenCdnFrontDoorFirewallCustomRules(input *frontdoor.CustomRuleList) []interface{} {
+func flattenCdnFrontDoorFirewallCustomRules(input *frontdoor.CustomRuleList, groupBy map[string][]azuresdkhacks.GroupByVariable) []interface{} {
 	if input == nil || input.Rules == nil {
 		return []interface{}{}
 	}
@@ -991,11 +1169,17 @@ func flattenCdnFrontDoorFirewallCustomRules(input *frontdoor.CustomRuleList) []i
 			ruleType = string(v.RuleType)
 		}
 
+		rateLimitGroupBy := make([]interface{}, 0)
+		for _, variable := range groupBy[name] {
+			rateLimitGroupBy = append(rateLimitGroupBy, variable.VariableName)
+		}
+
 		results = append(results, map[string]interface{}{
 			"action":                         action,
 			"enabled":                        enabled,
 			"match_condition":                flattenCdnFrontDoorFirewallMatchConditions(v.MatchConditions),
 			"rate_limit_duration_in_minutes": rateLimitDurationInMinutes,
+			"rate_limit_group_by":            rateLimitGroupBy,
 			"rate_limit_threshold":           rateLimitThreshold,
 			"priority":                       priority,
 			"name":                           name,
@@ -1156,3 +1340,179 @@ func flattenCdnFrontDoorFirewallRules(input *[]frontdoor.ManagedRuleOverride) []
 
 	return results
 }
+
+func validateCdnFrontDoorFirewallPolicyFeatures(d *pluginsdk.ResourceData, sku string) error {
+	isPremium := sku == string(frontdoor.SkuNamePremiumAzureFrontDoor)
+
+	if v, ok := d.GetRawConfig().AsValueMap()["js_challenge_cookie_expiration_in_minutes"]; ok && !v.IsNull() && !isPremium {
+		return fmt.Errorf("the 'js_challenge_cookie_expiration_in_minutes' field is only supported with the 'Premium_AzureFrontDoor' sku, got %q", sku)
+	}
+
+	for _, item := range d.Get("custom_rule").([]interface{}) {
+		rule := item.(map[string]interface{})
+		name := rule["name"].(string)
+
+		if rule["action"].(string) == string(azuresdkhacks.ActionTypeJSChallenge) && !isPremium {
+			return fmt.Errorf("the %q 'action' of the 'custom_rule' %q is only supported with the 'Premium_AzureFrontDoor' sku, got %q", azuresdkhacks.ActionTypeJSChallenge, name, sku)
+		}
+
+		if len(rule["rate_limit_group_by"].([]interface{})) > 0 && rule["type"].(string) != string(frontdoor.RuleTypeRateLimitRule) {
+			return fmt.Errorf("the 'rate_limit_group_by' field of the 'custom_rule' %q can only be set when the 'type' is %q", name, frontdoor.RuleTypeRateLimitRule)
+		}
+	}
+
+	return nil
+}
+
+// cdnFrontDoorFirewallPolicyRequiresWorkaroundClient returns whether any of the settings which are only available in
+// the newer API version are configured, or were configured prior to this change - other policies continue to be
+// managed using the API version supported by the Track1 SDK.
+func cdnFrontDoorFirewallPolicyRequiresWorkaroundClient(d *pluginsdk.ResourceData) bool {
+	if v, ok := d.GetRawConfig().AsValueMap()["js_challenge_cookie_expiration_in_minutes"]; ok && !v.IsNull() {
+		return true
+	}
+
+	oldLogScrubbing, newLogScrubbing := d.GetChange("log_scrubbing")
+	if len(oldLogScrubbing.([]interface{})) > 0 || len(newLogScrubbing.([]interface{})) > 0 {
+		return true
+	}
+
+	oldCustomRules, newCustomRules := d.GetChange("custom_rule")
+	for _, item := range append(oldCustomRules.([]interface{}), newCustomRules.([]interface{})...) {
+		rule, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if rule["action"].(string) == string(azuresdkhacks.ActionTypeJSChallenge) || len(rule["rate_limit_group_by"].([]interface{})) > 0 {
+			return true
+		}
+	}
+
+	oldManagedRules, newManagedRules := d.GetChange("managed_rule")
+	for _, item := range append(oldManagedRules.([]interface{}), newManagedRules.([]interface{})...) {
+		managedRule, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, o := range managedRule["override"].([]interface{}) {
+			override, ok := o.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, r := range override["rule"].([]interface{}) {
+				if rule, ok := r.(map[string]interface{}); ok && rule["action"].(string) == string(azuresdkhacks.ActionTypeJSChallenge) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func expandCdnFrontDoorFirewallCustomRulesGroupBy(input []interface{}) map[string][]azuresdkhacks.GroupByVariable {
+	output := make(map[string][]azuresdkhacks.GroupByVariable)
+
+	for _, cr := range input {
+		custom := cr.(map[string]interface{})
+
+		variables := make([]azuresdkhacks.GroupByVariable, 0)
+		for _, v := range custom["rate_limit_group_by"].([]interface{}) {
+			variables = append(variables, azuresdkhacks.GroupByVariable{
+				VariableName: v.(string),
+			})
+		}
+
+		if len(variables) > 0 {
+			output[custom["name"].(string)] = variables
+		}
+	}
+
+	return output
+}
+
+func expandCdnFrontDoorFirewallLogScrubbing(input []interface{}) (*azuresdkhacks.PolicySettingsLogScrubbing, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	state := frontdoor.PolicyEnabledStateDisabled
+	if raw["enabled"].(bool) {
+		state = frontdoor.PolicyEnabledStateEnabled
+	}
+
+	rules := make([]azuresdkhacks.WebApplicationFirewallScrubbingRules, 0)
+	for _, v := range raw["scrubbing_rule"].([]interface{}) {
+		rule := v.(map[string]interface{})
+
+		matchVariable := rule["match_variable"].(string)
+		operator := rule["operator"].(string)
+		selector := rule["selector"].(string)
+
+		// NOTE: the API only supports scrubbing the whole IP address or URI, and a selector can't be combined with 'EqualsAny'
+		if (matchVariable == cdnFrontDoorFirewallScrubbingMatchVariableRequestIPAddress || matchVariable == cdnFrontDoorFirewallScrubbingMatchVariableRequestURI) && operator != cdnFrontDoorFirewallScrubbingOperatorEqualsAny {
+			return nil, fmt.Errorf("the 'operator' of a 'scrubbing_rule' must be %q when the 'match_variable' is %q, got %q", cdnFrontDoorFirewallScrubbingOperatorEqualsAny, matchVariable, operator)
+		}
+		if operator == cdnFrontDoorFirewallScrubbingOperatorEqualsAny && selector != "" {
+			return nil, fmt.Errorf("the 'selector' of a 'scrubbing_rule' cannot be set when the 'operator' is %q", cdnFrontDoorFirewallScrubbingOperatorEqualsAny)
+		}
+		if operator == cdnFrontDoorFirewallScrubbingOperatorEquals && selector == "" {
+			return nil, fmt.Errorf("the 'selector' of a 'scrubbing_rule' must be set when the 'operator' is %q", cdnFrontDoorFirewallScrubbingOperatorEquals)
+		}
+
+		ruleState := frontdoor.PolicyEnabledStateDisabled
+		if rule["enabled"].(bool) {
+			ruleState = frontdoor.PolicyEnabledStateEnabled
+		}
+
+		result := azuresdkhacks.WebApplicationFirewallScrubbingRules{
+			MatchVariable:         matchVariable,
+			SelectorMatchOperator: operator,
+			State:                 string(ruleState),
+		}
+
+		if selector != "" {
+			result.Selector = utils.String(selector)
+		}
+
+		rules = append(rules, result)
+	}
+
+	return &azuresdkhacks.PolicySettingsLogScrubbing{
+		ScrubbingRules: &rules,
+		State:          string(state),
+	}, nil
+}
+
+func flattenCdnFrontDoorFirewallLogScrubbing(input *azuresdkhacks.PolicySettingsLogScrubbing) []interface{} {
+	if input == nil || input.ScrubbingRules == nil || len(*input.ScrubbingRules) == 0 {
+		return []interface{}{}
+	}
+
+	rules := make([]interface{}, 0)
+	for _, v := range *input.ScrubbingRules {
+		selector := ""
+		if v.Selector != nil {
+			selector = *v.Selector
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"enabled":        v.State != string(frontdoor.PolicyEnabledStateDisabled),
+			"match_variable": v.MatchVariable,
+			"operator":       v.SelectorMatchOperator,
+			"selector":       selector,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":        input.State == string(frontdoor.PolicyEnabledStateEnabled),
+			"scrubbing_rule": rules,
+		},
+	}
+}
@@ -35,13 +35,14 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_cdn_profile": dataSourceCdnProfile(),
 
 		// FrontDoor
-		"azurerm_cdn_frontdoor_custom_domain":   dataSourceCdnFrontDoorCustomDomain(),
-		"azurerm_cdn_frontdoor_endpoint":        dataSourceCdnFrontDoorEndpoint(),
-		"azurerm_cdn_frontdoor_firewall_policy": dataSourceCdnFrontDoorFirewallPolicy(),
-		"azurerm_cdn_frontdoor_origin_group":    dataSourceCdnFrontDoorOriginGroup(),
-		"azurerm_cdn_frontdoor_profile":         dataSourceCdnFrontDoorProfile(),
-		"azurerm_cdn_frontdoor_rule_set":        dataSourceCdnFrontDoorRuleSet(),
-		"azurerm_cdn_frontdoor_secret":          dataSourceCdnFrontDoorSecret(),
+		"azurerm_cdn_frontdoor_custom_domain":             dataSourceCdnFrontDoorCustomDomain(),
+		"azurerm_cdn_frontdoor_endpoint":                  dataSourceCdnFrontDoorEndpoint(),
+		"azurerm_cdn_frontdoor_firewall_policy":           dataSourceCdnFrontDoorFirewallPolicy(),
+		"azurerm_cdn_frontdoor_firewall_policy_migration": dataSourceCdnFrontDoorFirewallPolicyMigration(),
+		"azurerm_cdn_frontdoor_origin_group":              dataSourceCdnFrontDoorOriginGroup(),
+		"azurerm_cdn_frontdoor_profile":                   dataSourceCdnFrontDoorProfile(),
+		"azurerm_cdn_frontdoor_rule_set":                  dataSourceCdnFrontDoorRuleSet(),
+		"azurerm_cdn_frontdoor_secret":                    dataSourceCdnFrontDoorSecret(),
 	}
 }
 
@@ -58,6 +58,7 @@ type Client struct {
 	SkusClient                       *skus.SkusClient
 	SSHPublicKeysClient              *sshpublickeys.SshPublicKeysClient
 	SnapshotsClient                  *snapshots.SnapshotsClient
+	UsageClient                      *compute.UsageClient
 	VirtualMachinesClient            *virtualmachines.VirtualMachinesClient
 	VirtualMachineRunCommandsClient  *virtualmachineruncommands.VirtualMachineRunCommandsClient
 	VMExtensionImageClient           *compute.VirtualMachineExtensionImagesClient
@@ -245,6 +246,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SkusClient:                       skusClient,
 		SSHPublicKeysClient:              sshPublicKeysClient,
 		SnapshotsClient:                  snapshotsClient,
+		UsageClient:                      &usageClient,
 		VirtualMachinesClient:            virtualMachinesClient,
 		VirtualMachineRunCommandsClient:  virtualMachineRunCommandsClient,
 		VMExtensionImageClient:           &vmExtensionImageClient,
@@ -53,6 +53,11 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(45 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualMachinePlanTimeValidation(virtualMachinePlanTimeValidationFields{
+			SizeField: "size",
+			ZoneField: "zone",
+		})),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2023-03-01/compute"
+)
+
+const (
+	// regionalCoresUsageName is the name of the Usage tracking the Total Regional vCPUs
+	regionalCoresUsageName = "cores"
+
+	// lowPriorityCoresUsageName is the name of the Usage tracking the Total Regional Spot vCPUs
+	lowPriorityCoresUsageName = "lowPriorityCores"
+)
+
+// virtualMachinePlanTimeValidationFields defines the fields used to retrieve the size, zone(s), instance count
+// and priority from either a Virtual Machine or a Virtual Machine Scale Set
+type virtualMachinePlanTimeValidationFields struct {
+	SizeField      string
+	ZoneField      string
+	ZonesField     string
+	InstancesField string
+}
+
+// virtualMachinePlanTimeValidation returns a CustomizeDiffFunc which, when Plan Time Validation is enabled, checks that
+// the Virtual Machine Size is offered in the Location and Zone(s) and that there's sufficient vCPU quota available.
+//
+// The Resource SKUs and Usages are cached per Location, and any errors retrieving these are logged rather than
+// surfaced - since this validation is intended to fail fast and shouldn't block a plan which would otherwise succeed.
+// The quota is checked for each resource individually against the Usages at the start of the run, as such several
+// resources in the same plan can each pass this check whilst together requiring more vCPUs than are available.
+func virtualMachinePlanTimeValidation(fields virtualMachinePlanTimeValidationFields) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+		if !features.PlanTimeValidationEnabled() {
+			return nil
+		}
+
+		size := diff.Get(fields.SizeField).(string)
+		rawLocation := diff.Get("location").(string)
+		if size == "" || rawLocation == "" {
+			// these are unknown until apply, so there's nothing to validate
+			return nil
+		}
+		if diff.Id() != "" && !diff.HasChange(fields.SizeField) && (fields.InstancesField == "" || !diff.HasChange(fields.InstancesField)) {
+			return nil
+		}
+
+		client := meta.(*clients.Client)
+		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+		loc := location.Normalize(rawLocation)
+
+		availableSkus, err := cachedVirtualMachineSkusForLocation(ctx, client.Compute.SkusClient, subscriptionId, loc)
+		if err != nil {
+			log.Printf("[DEBUG] unable to retrieve the Resource SKUs for %q - skipping Plan Time Validation: %+v", loc, err)
+			return nil
+		}
+
+		sku := findVirtualMachineSku(availableSkus, size)
+		if err := validateVirtualMachineSkuAvailability(sku, size, loc, planTimeValidationZones(diff, fields)); err != nil {
+			return err
+		}
+
+		instances := int64(1)
+		oldInstances := int64(1)
+		if fields.InstancesField != "" {
+			oldRaw, newRaw := diff.GetChange(fields.InstancesField)
+			instances = int64(newRaw.(int))
+			oldInstances = int64(oldRaw.(int))
+		}
+
+		var existingSku *skus.ResourceSku
+		if diff.Id() != "" {
+			oldSize, _ := diff.GetChange(fields.SizeField)
+			existingSku = findVirtualMachineSku(availableSkus, oldSize.(string))
+		}
+
+		spot := strings.EqualFold(diff.Get("priority").(string), string(compute.VirtualMachinePriorityTypesSpot))
+		required, err := virtualMachineQuotaRequirements(sku, instances, existingSku, oldInstances, spot)
+		if err != nil {
+			log.Printf("[DEBUG] unable to determine the vCPUs required for %q - skipping quota validation: %+v", size, err)
+			return nil
+		}
+		if len(required) == 0 {
+			return nil
+		}
+
+		usages, err := cachedUsagesForLocation(ctx, client.Compute.UsageClient, loc)
+		if err != nil {
+			log.Printf("[DEBUG] unable to retrieve the Compute Usages for %q - skipping quota validation: %+v", loc, err)
+			return nil
+		}
+
+		return validateVirtualMachineQuota(usages, required, size, loc)
+	}
+}
+
+func planTimeValidationZones(diff *pluginsdk.ResourceDiff, fields virtualMachinePlanTimeValidationFields) []string {
+	output := make([]string, 0)
+	if fields.ZoneField != "" {
+		if v := diff.Get(fields.ZoneField).(string); v != "" {
+			output = append(output, v)
+		}
+	}
+	if fields.ZonesField != "" {
+		for _, v := range diff.Get(fields.ZonesField).(*pluginsdk.Set).List() {
+			if zone, ok := v.(string); ok && zone != "" {
+				output = append(output, zone)
+			}
+		}
+	}
+	return output
+}
+
+// findVirtualMachineSku returns the Virtual Machine SKU with the specified name, or nil if it's not available
+func findVirtualMachineSku(input []skus.ResourceSku, size string) *skus.ResourceSku {
+	for _, item := range input {
+		if item.Name != nil && strings.EqualFold(*item.Name, size) {
+			sku := item
+			return &sku
+		}
+	}
+	return nil
+}
+
+// validateVirtualMachineSkuAvailability confirms that the specified Virtual Machine SKU is offered within the Location
+// and Zones, taking into account any restrictions applied to the Subscription
+func validateVirtualMachineSkuAvailability(sku *skus.ResourceSku, size, loc string, zones []string) error {
+	if sku == nil {
+		return fmt.Errorf("the Virtual Machine Size %q is not offered in the location %q", size, loc)
+	}
+
+	restrictedZones := make(map[string]struct{})
+	if sku.Restrictions != nil {
+		for _, restriction := range *sku.Restrictions {
+			if restriction.Type == nil {
+				continue
+			}
+
+			reason := ""
+			if restriction.ReasonCode != nil {
+				reason = fmt.Sprintf(" (%s)", string(*restriction.ReasonCode))
+			}
+
+			switch *restriction.Type {
+			case skus.ResourceSkuRestrictionsTypeLocation:
+				if restriction.RestrictionInfo != nil && restriction.RestrictionInfo.Locations != nil {
+					for _, v := range *restriction.RestrictionInfo.Locations {
+						if strings.EqualFold(location.Normalize(v), loc) {
+							return fmt.Errorf("the Virtual Machine Size %q is restricted for this Subscription in the location %q%s", size, loc, reason)
+						}
+					}
+				}
+			case skus.ResourceSkuRestrictionsTypeZone:
+				if restriction.RestrictionInfo != nil && restriction.RestrictionInfo.Zones != nil {
+					for _, v := range *restriction.RestrictionInfo.Zones {
+						restrictedZones[v] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	if len(zones) == 0 {
+		return nil
+	}
+
+	availableZones := make(map[string]struct{})
+	if sku.LocationInfo != nil {
+		for _, info := range *sku.LocationInfo {
+			if info.Location == nil || !strings.EqualFold(location.Normalize(*info.Location), loc) || info.Zones == nil {
+				continue
+			}
+			for _, v := range *info.Zones {
+				if _, restricted := restrictedZones[v]; !restricted {
+					availableZones[v] = struct{}{}
+				}
+			}
+		}
+	}
+
+	unavailable := make([]string, 0)
+	for _, zone := range zones {
+		if _, ok := availableZones[zone]; !ok {
+			unavailable = append(unavailable, zone)
+		}
+	}
+	if len(unavailable) > 0 {
+		available := make([]string, 0)
+		for k := range availableZones {
+			available = append(available, k)
+		}
+		sort.Strings(available)
+
+		return fmt.Errorf("the Virtual Machine Size %q is not available in the Zone(s) %q in the location %q - the Zones available for this Subscription are %q", size, strings.Join(unavailable, ", "), loc, strings.Join(available, ", "))
+	}
+
+	return nil
+}
+
+// virtualMachineSkuVCPUs returns the number of vCPUs offered by the specified Virtual Machine SKU
+func virtualMachineSkuVCPUs(sku skus.ResourceSku) (int64, error) {
+	if sku.Capabilities != nil {
+		for _, capability := range *sku.Capabilities {
+			if capability.Name == nil || capability.Value == nil || !strings.EqualFold(*capability.Name, "vCPUs") {
+				continue
+			}
+
+			vCPUs, err := strconv.ParseInt(*capability.Value, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("parsing the `vCPUs` capability %q: %+v", *capability.Value, err)
+			}
+			return vCPUs, nil
+		}
+	}
+
+	return 0, fmt.Errorf("the `vCPUs` capability was not found")
+}
+
+// virtualMachineQuotaRequirements returns the additional number of vCPUs required for each Usage, taking into
+// account the vCPUs already consumed by the existing SKU/instances (if any)
+func virtualMachineQuotaRequirements(sku *skus.ResourceSku, instances int64, existingSku *skus.ResourceSku, existingInstances int64, spot bool) (map[string]int64, error) {
+	vCPUs, err := virtualMachineSkuVCPUs(*sku)
+	if err != nil {
+		return nil, err
+	}
+	required := vCPUs * instances
+
+	existing := int64(0)
+	sameFamily := false
+	if existingSku != nil {
+		existingVCPUs, err := virtualMachineSkuVCPUs(*existingSku)
+		if err != nil {
+			return nil, err
+		}
+		existing = existingVCPUs * existingInstances
+		sameFamily = sku.Family != nil && existingSku.Family != nil && strings.EqualFold(*sku.Family, *existingSku.Family)
+	}
+
+	output := make(map[string]int64)
+	if spot {
+		if v := required - existing; v > 0 {
+			output[lowPriorityCoresUsageName] = v
+		}
+		return output, nil
+	}
+
+	if v := required - existing; v > 0 {
+		output[regionalCoresUsageName] = v
+	}
+
+	if sku.Family != nil && *sku.Family != "" {
+		familyRequired := required
+		if sameFamily {
+			familyRequired = required - existing
+		}
+		if familyRequired > 0 {
+			output[*sku.Family] = familyRequired
+		}
+	}
+
+	return output, nil
+}
+
+// validateVirtualMachineQuota confirms that there's sufficient quota available for each of the required Usages
+func validateVirtualMachineQuota(usages []compute.Usage, required map[string]int64, size, loc string) error {
+	names := make([]string, 0)
+	for k := range required {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, usage := range usages {
+			if usage.Name == nil || usage.Name.Value == nil || !strings.EqualFold(*usage.Name.Value, name) {
+				continue
+			}
+			if usage.Limit == nil {
+				continue
+			}
+
+			current := int64(0)
+			if usage.CurrentValue != nil {
+				current = int64(*usage.CurrentValue)
+			}
+
+			if available := *usage.Limit - current; required[name] > available {
+				description := name
+				if usage.Name.LocalizedValue != nil && *usage.Name.LocalizedValue != "" {
+					description = *usage.Name.LocalizedValue
+				}
+				return fmt.Errorf("insufficient quota for the Virtual Machine Size %q in the location %q: %d vCPUs are required from %q but only %d of %d are available", size, loc, required[name], description, available, *usage.Limit)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2023-03-01/compute"
+)
+
+// cachedVirtualMachineSkus is keyed by Subscription ID and Location and cachedUsages is keyed by Location (since the
+// UsageClient is bound to the Subscription configured for the Provider), both are retrieved lazily the first time
+// that a Location is used by a Virtual Machine/Virtual Machine Scale Set
+var cachedVirtualMachineSkus = map[string][]skus.ResourceSku{}
+var cachedUsages = map[string][]compute.Usage{}
+
+var planTimeValidationCacheLock = &sync.Mutex{}
+
+// cachedVirtualMachineSkusForLocation returns the Virtual Machine SKUs available within the specified Subscription
+// and Location, retrieving these from the Resource SKUs API and caching them the first time they're requested
+func cachedVirtualMachineSkusForLocation(ctx context.Context, client *skus.SkusClient, subscriptionId commonids.SubscriptionId, location string) ([]skus.ResourceSku, error) {
+	planTimeValidationCacheLock.Lock()
+	defer planTimeValidationCacheLock.Unlock()
+
+	key := planTimeValidationCacheKey(subscriptionId, location)
+	if v, ok := cachedVirtualMachineSkus[key]; ok {
+		return v, nil
+	}
+
+	options := skus.ResourceSkusListOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("location eq '%s'", location)),
+	}
+	resp, err := client.ResourceSkusListComplete(ctx, subscriptionId, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource SKUs for %s in %q: %+v", subscriptionId, location, err)
+	}
+
+	items := make([]skus.ResourceSku, 0)
+	for _, item := range resp.Items {
+		if item.ResourceType == nil || !strings.EqualFold(*item.ResourceType, "virtualMachines") {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	cachedVirtualMachineSkus[key] = items
+	return items, nil
+}
+
+// cachedUsagesForLocation returns the Compute Usages/Quotas for the Subscription the client is bound to within the
+// specified Location, retrieving these from the Usages API and caching them the first time they're requested
+func cachedUsagesForLocation(ctx context.Context, client *compute.UsageClient, location string) ([]compute.Usage, error) {
+	planTimeValidationCacheLock.Lock()
+	defer planTimeValidationCacheLock.Unlock()
+
+	key := strings.ToLower(location)
+	if v, ok := cachedUsages[key]; ok {
+		return v, nil
+	}
+
+	items := make([]compute.Usage, 0)
+	iter, err := client.ListComplete(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("listing Compute Usages in %q: %+v", location, err)
+	}
+	for iter.NotDone() {
+		items = append(items, iter.Value())
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Compute Usages in %q: %+v", location, err)
+		}
+	}
+
+	cachedUsages[key] = items
+	return items, nil
+}
+
+func ClearPlanTimeValidationCache() {
+	planTimeValidationCacheLock.Lock()
+	cachedVirtualMachineSkus = map[string][]skus.ResourceSku{}
+	cachedUsages = map[string][]compute.Usage{}
+	planTimeValidationCacheLock.Unlock()
+}
+
+func planTimeValidationCacheKey(subscriptionId commonids.SubscriptionId, location string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", subscriptionId.SubscriptionId, location))
+}
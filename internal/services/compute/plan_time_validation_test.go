@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2023-03-01/compute"
+)
+
+func testPlanTimeValidationSku(name, family, vCPUs string, availableZones []string, restrictions []skus.ResourceSkuRestrictions) skus.ResourceSku {
+	return skus.ResourceSku{
+		Name:         pointer.To(name),
+		Family:       pointer.To(family),
+		ResourceType: pointer.To("virtualMachines"),
+		Capabilities: &[]skus.ResourceSkuCapabilities{
+			{
+				Name:  pointer.To("vCPUs"),
+				Value: pointer.To(vCPUs),
+			},
+		},
+		LocationInfo: &[]skus.ResourceSkuLocationInfo{
+			{
+				Location: pointer.To("WestEurope"),
+				Zones:    pointer.To(zones.Schema(availableZones)),
+			},
+		},
+		Locations:    &[]string{"WestEurope"},
+		Restrictions: &restrictions,
+	}
+}
+
+func TestValidateVirtualMachineSkuAvailability(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Sku         *skus.ResourceSku
+		Zones       []string
+		ExpectError bool
+	}{
+		{
+			Name:        "Size Not Offered",
+			Sku:         nil,
+			ExpectError: true,
+		},
+		{
+			Name:        "Available Without Zones",
+			Sku:         pointer.To(testPlanTimeValidationSku("Standard_D2s_v3", "standardDSv3Family", "2", []string{"1", "2", "3"}, nil)),
+			ExpectError: false,
+		},
+		{
+			Name:        "Available In Zone",
+			Sku:         pointer.To(testPlanTimeValidationSku("Standard_D2s_v3", "standardDSv3Family", "2", []string{"1", "2", "3"}, nil)),
+			Zones:       []string{"2"},
+			ExpectError: false,
+		},
+		{
+			Name:        "Not Offered In Zone",
+			Sku:         pointer.To(testPlanTimeValidationSku("Standard_D2s_v3", "standardDSv3Family", "2", []string{"1", "2"}, nil)),
+			Zones:       []string{"3"},
+			ExpectError: true,
+		},
+		{
+			Name: "Restricted In Zone",
+			Sku: pointer.To(testPlanTimeValidationSku("Standard_D2s_v3", "standardDSv3Family", "2", []string{"1", "2", "3"}, []skus.ResourceSkuRestrictions{
+				{
+					Type:       pointer.To(skus.ResourceSkuRestrictionsTypeZone),
+					ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+					RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+						Zones: pointer.To(zones.Schema{"1"}),
+					},
+				},
+			})),
+			Zones:       []string{"1", "2"},
+			ExpectError: true,
+		},
+		{
+			Name: "Restricted In Location",
+			Sku: pointer.To(testPlanTimeValidationSku("Standard_D2s_v3", "standardDSv3Family", "2", []string{"1", "2", "3"}, []skus.ResourceSkuRestrictions{
+				{
+					Type:       pointer.To(skus.ResourceSkuRestrictionsTypeLocation),
+					ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+					RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+						Locations: &[]string{"WestEurope"},
+					},
+				},
+			})),
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateVirtualMachineSkuAvailability(v.Sku, "Standard_D2s_v3", "westeurope", v.Zones)
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestVirtualMachineQuotaRequirements(t *testing.T) {
+	d2sv3 := testPlanTimeValidationSku("Standard_D2s_v3", "standardDSv3Family", "2", nil, nil)
+	d4sv3 := testPlanTimeValidationSku("Standard_D4s_v3", "standardDSv3Family", "4", nil, nil)
+	e4sv3 := testPlanTimeValidationSku("Standard_E4s_v3", "standardESv3Family", "4", nil, nil)
+
+	testData := []struct {
+		Name              string
+		Sku               skus.ResourceSku
+		Instances         int64
+		ExistingSku       *skus.ResourceSku
+		ExistingInstances int64
+		Spot              bool
+		Expected          map[string]int64
+	}{
+		{
+			Name:      "New Resource",
+			Sku:       d2sv3,
+			Instances: 3,
+			Expected: map[string]int64{
+				"cores":              6,
+				"standardDSv3Family": 6,
+			},
+		},
+		{
+			Name:      "New Spot Resource",
+			Sku:       d2sv3,
+			Instances: 3,
+			Spot:      true,
+			Expected: map[string]int64{
+				"lowPriorityCores": 6,
+			},
+		},
+		{
+			Name:              "Resize Within The Same Family",
+			Sku:               d4sv3,
+			Instances:         1,
+			ExistingSku:       &d2sv3,
+			ExistingInstances: 1,
+			Expected: map[string]int64{
+				"cores":              2,
+				"standardDSv3Family": 2,
+			},
+		},
+		{
+			Name:              "Resize To A Different Family",
+			Sku:               e4sv3,
+			Instances:         1,
+			ExistingSku:       &d4sv3,
+			ExistingInstances: 1,
+			Expected: map[string]int64{
+				"standardESv3Family": 4,
+			},
+		},
+		{
+			Name:              "Scale In",
+			Sku:               d2sv3,
+			Instances:         1,
+			ExistingSku:       &d2sv3,
+			ExistingInstances: 3,
+			Expected:          map[string]int64{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := virtualMachineQuotaRequirements(&v.Sku, v.Instances, v.ExistingSku, v.ExistingInstances, v.Spot)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("expected %d requirements but got %d: %+v", len(v.Expected), len(actual), actual)
+		}
+		for k, expected := range v.Expected {
+			if actual[k] != expected {
+				t.Fatalf("expected %d vCPUs to be required from %q but got %d", expected, k, actual[k])
+			}
+		}
+	}
+}
+
+func TestValidateVirtualMachineQuota(t *testing.T) {
+	usages := []compute.Usage{
+		{
+			Name: &compute.UsageName{
+				Value:          pointer.To("cores"),
+				LocalizedValue: pointer.To("Total Regional vCPUs"),
+			},
+			CurrentValue: pointer.To(int32(90)),
+			Limit:        pointer.To(int64(100)),
+		},
+		{
+			Name: &compute.UsageName{
+				Value:          pointer.To("standardDSv3Family"),
+				LocalizedValue: pointer.To("Standard DSv3 Family vCPUs"),
+			},
+			CurrentValue: pointer.To(int32(4)),
+			Limit:        pointer.To(int64(10)),
+		},
+	}
+
+	testData := []struct {
+		Name        string
+		Required    map[string]int64
+		ExpectError bool
+	}{
+		{
+			Name: "Within Quota",
+			Required: map[string]int64{
+				"cores":              6,
+				"standardDSv3Family": 6,
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Family Quota Exceeded",
+			Required: map[string]int64{
+				"cores":              8,
+				"standardDSv3Family": 8,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Regional Quota Exceeded",
+			Required: map[string]int64{
+				"cores": 12,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Unknown Usage",
+			Required: map[string]int64{
+				"standardESv3Family": 64,
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateVirtualMachineQuota(usages, v.Required, "Standard_D2s_v3", "westeurope")
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
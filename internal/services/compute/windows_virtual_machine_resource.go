@@ -55,6 +55,11 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(45 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualMachinePlanTimeValidation(virtualMachinePlanTimeValidationFields{
+			SizeField: "size",
+			ZoneField: "zone",
+		})),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -51,6 +51,12 @@ func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualMachinePlanTimeValidation(virtualMachinePlanTimeValidationFields{
+			SizeField:      "sku",
+			ZonesField:     "zones",
+			InstancesField: "instances",
+		})),
+
 		// TODO: exposing requireGuestProvisionSignal once it's available
 		// https://github.com/Azure/azure-rest-api-specs/pull/7246
 
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dashboard
+
+import (
+	"strings"
+)
+
+// orderAzureMonitorWorkspaceIntegrations returns the integrations returned by the API in the order they're
+// defined in the configuration, so that only integrations which have been added or removed outside of
+// Terraform show up as a diff. IDs which only differ by casing keep the casing used in the configuration,
+// and any integrations not present in the configuration are appended in the order returned by the API.
+func orderAzureMonitorWorkspaceIntegrations(configured []AzureMonitorWorkspaceIntegrationModel, remote []AzureMonitorWorkspaceIntegrationModel) []AzureMonitorWorkspaceIntegrationModel {
+	if len(remote) == 0 {
+		return remote
+	}
+
+	matched := make([]bool, len(remote))
+	output := make([]AzureMonitorWorkspaceIntegrationModel, 0, len(remote))
+
+	for _, c := range configured {
+		for i, r := range remote {
+			if matched[i] || !strings.EqualFold(c.ResourceId, r.ResourceId) {
+				continue
+			}
+
+			matched[i] = true
+			output = append(output, c)
+			break
+		}
+	}
+
+	for i, r := range remote {
+		if !matched[i] {
+			output = append(output, r)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dashboard
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrderAzureMonitorWorkspaceIntegrations(t *testing.T) {
+	workspace1 := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/rg1/providers/Microsoft.Monitor/accounts/workspace1"
+	workspace2 := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/rg1/providers/Microsoft.Monitor/accounts/workspace2"
+	workspace3 := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/rg1/providers/Microsoft.Monitor/accounts/workspace3"
+
+	testData := []struct {
+		Name       string
+		Configured []AzureMonitorWorkspaceIntegrationModel
+		Remote     []AzureMonitorWorkspaceIntegrationModel
+		Expected   []AzureMonitorWorkspaceIntegrationModel
+	}{
+		{
+			Name:       "None Returned",
+			Configured: []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace1}},
+			Remote:     nil,
+			Expected:   nil,
+		},
+		{
+			Name:       "Reordered By The API",
+			Configured: []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace1}, {ResourceId: workspace2}},
+			Remote:     []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace2}, {ResourceId: workspace1}},
+			Expected:   []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace1}, {ResourceId: workspace2}},
+		},
+		{
+			Name:       "Different Casing",
+			Configured: []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace1}},
+			Remote:     []AzureMonitorWorkspaceIntegrationModel{{ResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/rg1/providers/microsoft.monitor/accounts/workspace1"}},
+			Expected:   []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace1}},
+		},
+		{
+			Name:       "Added Outside Of Terraform",
+			Configured: []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace2}},
+			Remote:     []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace3}, {ResourceId: workspace2}},
+			Expected:   []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace2}, {ResourceId: workspace3}},
+		},
+		{
+			Name:       "Removed Outside Of Terraform",
+			Configured: []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace1}, {ResourceId: workspace2}},
+			Remote:     []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace2}},
+			Expected:   []AzureMonitorWorkspaceIntegrationModel{{ResourceId: workspace2}},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := orderAzureMonitorWorkspaceIntegrations(v.Configured, v.Remote)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -363,6 +363,12 @@ func (r DashboardGrafanaResource) Update() sdk.ResourceFunc {
 
 			if metadata.ResourceData.HasChange("azure_monitor_workspace_integrations") {
 				properties.Properties.GrafanaIntegrations = expandGrafanaIntegrationsModel(model.AzureMonitorWorkspaceIntegrations)
+				if properties.Properties.GrafanaIntegrations == nil {
+					// an empty list has to be sent explicitly to remove all of the existing integrations
+					properties.Properties.GrafanaIntegrations = &grafanaresource.GrafanaIntegrations{
+						AzureMonitorWorkspaceIntegrations: &[]grafanaresource.AzureMonitorWorkspaceIntegration{},
+					}
+				}
 			}
 
 			if metadata.ResourceData.HasChange("public_network_access_enabled") {
@@ -412,6 +418,11 @@ func (r DashboardGrafanaResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: model was nil", id)
 			}
 
+			var config DashboardGrafanaModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
 			state := DashboardGrafanaModel{
 				Name:              id.GrafanaName,
 				ResourceGroupName: id.ResourceGroupName,
@@ -454,7 +465,8 @@ func (r DashboardGrafanaResource) Read() sdk.ResourceFunc {
 				}
 
 				if properties.GrafanaIntegrations != nil {
-					state.AzureMonitorWorkspaceIntegrations = flattenAzureMonitorWorkspaceIntegrationModelArray(properties.GrafanaIntegrations.AzureMonitorWorkspaceIntegrations)
+					integrations := flattenAzureMonitorWorkspaceIntegrationModelArray(properties.GrafanaIntegrations.AzureMonitorWorkspaceIntegrations)
+					state.AzureMonitorWorkspaceIntegrations = orderAzureMonitorWorkspaceIntegrations(config.AzureMonitorWorkspaceIntegrations, integrations)
 				}
 
 				if properties.GrafanaVersion != nil {
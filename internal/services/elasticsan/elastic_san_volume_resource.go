@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/volumes"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ElasticSANVolumeResource{}
+var _ sdk.ResourceWithUpdate = ElasticSANVolumeResource{}
+var _ sdk.ResourceWithCustomizeDiff = ElasticSANVolumeResource{}
+
+type ElasticSANVolumeResource struct{}
+
+func (r ElasticSANVolumeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return volumes.ValidateVolumeID
+}
+
+func (r ElasticSANVolumeResource) ResourceType() string {
+	return "azurerm_elastic_san_volume"
+}
+
+func (r ElasticSANVolumeResource) ModelObject() interface{} {
+	return &ElasticSANVolumeResourceModel{}
+}
+
+type ElasticSANVolumeResourceModel struct {
+	CreateSource  []ElasticSANVolumeCreateSourceModel `tfschema:"create_source"`
+	Name          string                              `tfschema:"name"`
+	SizeInGiB     int64                               `tfschema:"size_in_gib"`
+	TargetIqn     string                              `tfschema:"target_iqn"`
+	TargetPortal  []ElasticSANVolumeTargetPortalModel `tfschema:"target_portal"`
+	VolumeGroupId string                              `tfschema:"volume_group_id"`
+	VolumeId      string                              `tfschema:"volume_id"`
+}
+
+type ElasticSANVolumeCreateSourceModel struct {
+	SourceType string `tfschema:"source_type"`
+	SourceId   string `tfschema:"source_id"`
+}
+
+type ElasticSANVolumeTargetPortalModel struct {
+	Hostname string `tfschema:"hostname"`
+	Port     int64  `tfschema:"port"`
+}
+
+func (r ElasticSANVolumeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ElasticSanVolumeName,
+		},
+
+		"volume_group_id": commonschema.ResourceIDReferenceRequiredForceNew(&volumes.VolumeGroupId{}),
+
+		"size_in_gib": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 65536),
+		},
+
+		"create_source": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							// None is the default when no source is specified, so it's omitted here
+							string(volumes.VolumeCreateOptionDisk),
+							string(volumes.VolumeCreateOptionDiskRestorePoint),
+							string(volumes.VolumeCreateOptionDiskSnapshot),
+							string(volumes.VolumeCreateOptionVolumeSnapshot),
+						}, false),
+					},
+					"source_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_iqn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"target_portal": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"hostname": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"port": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"volume_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Volumes can only be expanded, not shrunk
+			if oldVal, newVal := metadata.ResourceDiff.GetChange("size_in_gib"); newVal.(int) < oldVal.(int) {
+				return fmt.Errorf("new size_in_gib should be greater than the existing one")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.Volumes
+
+			var config ElasticSANVolumeResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			volumeGroupId, err := volumes.ParseVolumeGroupID(config.VolumeGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := volumes.NewVolumeID(volumeGroupId.SubscriptionId, volumeGroupId.ResourceGroupName, volumeGroupId.ElasticSanName, volumeGroupId.VolumeGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := volumes.Volume{
+				Properties: volumes.VolumeProperties{
+					CreationData: ExpandVolumeCreateSource(config.CreateSource),
+					SizeGiB:      config.SizeInGiB,
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.Volumes
+			schema := ElasticSANVolumeResourceModel{}
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			volumeGroupId := volumes.NewVolumeGroupID(id.SubscriptionId, id.ResourceGroupName, id.ElasticSanName, id.VolumeGroupName)
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				schema.Name = id.VolumeName
+				schema.VolumeGroupId = volumeGroupId.ID()
+				schema.SizeInGiB = model.Properties.SizeGiB
+				schema.VolumeId = pointer.From(model.Properties.VolumeId)
+				schema.CreateSource = FlattenVolumeCreateSource(model.Properties.CreationData)
+
+				if target := model.Properties.StorageTarget; target != nil {
+					schema.TargetIqn = pointer.From(target.TargetIqn)
+					schema.TargetPortal = []ElasticSANVolumeTargetPortalModel{
+						{
+							Hostname: pointer.From(target.TargetPortalHostname),
+							Port:     pointer.From(target.TargetPortalPort),
+						},
+					}
+				}
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.Volumes
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ElasticSANVolumeResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := volumes.VolumeUpdate{
+				Properties: &volumes.VolumeUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("size_in_gib") {
+				payload.Properties.SizeGiB = pointer.To(config.SizeInGiB)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticSANVolumeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.Volumes
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// Snapshots of the Volume are managed separately (`azurerm_elastic_san_volume_snapshot`) and
+			// the Volume can't be deleted while it's attached, so neither is forced here
+			options := volumes.DeleteOperationOptions{
+				XMsDeleteSnapshots: pointer.To(volumes.XMsDeleteSnapshotsFalse),
+				XMsForceDelete:     pointer.To(volumes.XMsForceDeleteFalse),
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func ExpandVolumeCreateSource(input []ElasticSANVolumeCreateSourceModel) *volumes.SourceCreationData {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &volumes.SourceCreationData{
+		CreateSource: pointer.To(volumes.VolumeCreateOption(input[0].SourceType)),
+		SourceId:     pointer.To(input[0].SourceId),
+	}
+}
+
+func FlattenVolumeCreateSource(input *volumes.SourceCreationData) []ElasticSANVolumeCreateSourceModel {
+	if input == nil || input.CreateSource == nil || *input.CreateSource == volumes.VolumeCreateOptionNone {
+		return []ElasticSANVolumeCreateSourceModel{}
+	}
+
+	return []ElasticSANVolumeCreateSourceModel{
+		{
+			SourceType: string(pointer.From(input.CreateSource)),
+			SourceId:   pointer.From(input.SourceId),
+		},
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsan_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/volumes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSANVolumeTestResource struct{}
+
+func TestAccElasticSANVolume_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume", "test")
+	r := ElasticSANVolumeTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_iqn").Exists(),
+				check.That(data.ResourceName).Key("target_portal.0.hostname").Exists(),
+				check.That(data.ResourceName).Key("target_portal.0.port").Exists(),
+				check.That(data.ResourceName).Key("volume_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticSANVolume_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume", "test")
+	r := ElasticSANVolumeTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccElasticSANVolume_expandSize(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume", "test")
+	r := ElasticSANVolumeTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("size_in_gib").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.basic(data, 1),
+			ExpectError: regexp.MustCompile("new size_in_gib should be greater than the existing one"),
+		},
+	})
+}
+
+func TestAccElasticSANVolume_createFromVolumeSnapshot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume", "test")
+	r := ElasticSANVolumeTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.createFromVolumeSnapshot(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticSANVolume_createFromManagedDisk(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume", "test")
+	r := ElasticSANVolumeTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.createFromManagedDisk(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ElasticSANVolumeTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := volumes.ParseVolumeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ElasticSan.Volumes.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ElasticSANVolumeTestResource) basic(data acceptance.TestData, sizeInGiB int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume" "test" {
+  name            = "acctestesv-${var.random_string}"
+  volume_group_id = azurerm_elastic_san_volume_group.test.id
+  size_in_gib     = %d
+}
+`, r.template(data), sizeInGiB)
+}
+
+func (r ElasticSANVolumeTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume" "import" {
+  name            = azurerm_elastic_san_volume.test.name
+  volume_group_id = azurerm_elastic_san_volume.test.volume_group_id
+  size_in_gib     = azurerm_elastic_san_volume.test.size_in_gib
+}
+`, r.basic(data, 1))
+}
+
+func (r ElasticSANVolumeTestResource) createFromVolumeSnapshot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume" "source" {
+  name            = "acctestesvs-${var.random_string}"
+  volume_group_id = azurerm_elastic_san_volume_group.test.id
+  size_in_gib     = 1
+}
+
+resource "azurerm_elastic_san_volume_snapshot" "test" {
+  name             = "acctestesvss-${var.random_string}"
+  volume_group_id  = azurerm_elastic_san_volume_group.test.id
+  source_volume_id = azurerm_elastic_san_volume.source.id
+}
+
+resource "azurerm_elastic_san_volume" "test" {
+  name            = "acctestesv-${var.random_string}"
+  volume_group_id = azurerm_elastic_san_volume_group.test.id
+  size_in_gib     = 2
+
+  create_source {
+    source_type = "VolumeSnapshot"
+    source_id   = azurerm_elastic_san_volume_snapshot.test.id
+  }
+}
+`, r.template(data))
+}
+
+func (r ElasticSANVolumeTestResource) createFromManagedDisk(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-${var.random_integer}"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 2
+}
+
+resource "azurerm_elastic_san_volume" "test" {
+  name            = "acctestesv-${var.random_string}"
+  volume_group_id = azurerm_elastic_san_volume_group.test.id
+  size_in_gib     = 2
+
+  create_source {
+    source_type = "Disk"
+    source_id   = azurerm_managed_disk.test.id
+  }
+}
+`, r.template(data))
+}
+
+func (r ElasticSANVolumeTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+variable "primary_location" {
+  default = %q
+}
+variable "random_integer" {
+  default = %d
+}
+variable "random_string" {
+  default = %q
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-esv-${var.random_integer}"
+  location = var.primary_location
+}
+
+resource "azurerm_elastic_san" "test" {
+  name                = "acctestes-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  base_size_in_tib    = 1
+  sku {
+    name = "Premium_LRS"
+  }
+}
+
+resource "azurerm_elastic_san_volume_group" "test" {
+  name           = "acctestesvg-${var.random_string}"
+  elastic_san_id = azurerm_elastic_san.test.id
+}
+`, data.Locations.Primary, data.RandomInteger, data.RandomString)
+}
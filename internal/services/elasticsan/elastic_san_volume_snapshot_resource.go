@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/snapshots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/volumes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.Resource = ElasticSANVolumeSnapshotResource{}
+
+type ElasticSANVolumeSnapshotResource struct{}
+
+func (r ElasticSANVolumeSnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return snapshots.ValidateSnapshotID
+}
+
+func (r ElasticSANVolumeSnapshotResource) ResourceType() string {
+	return "azurerm_elastic_san_volume_snapshot"
+}
+
+func (r ElasticSANVolumeSnapshotResource) ModelObject() interface{} {
+	return &ElasticSANVolumeSnapshotResourceModel{}
+}
+
+type ElasticSANVolumeSnapshotResourceModel struct {
+	Name                  string `tfschema:"name"`
+	SourceVolumeId        string `tfschema:"source_volume_id"`
+	SourceVolumeSizeInGiB int64  `tfschema:"source_volume_size_in_gib"`
+	VolumeGroupId         string `tfschema:"volume_group_id"`
+	VolumeName            string `tfschema:"volume_name"`
+}
+
+func (r ElasticSANVolumeSnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ElasticSanVolumeSnapshotName,
+		},
+
+		"volume_group_id": commonschema.ResourceIDReferenceRequiredForceNew(&snapshots.VolumeGroupId{}),
+
+		"source_volume_id": commonschema.ResourceIDReferenceRequiredForceNew(&volumes.VolumeId{}),
+	}
+}
+
+func (r ElasticSANVolumeSnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"source_volume_size_in_gib": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"volume_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ElasticSANVolumeSnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.Snapshots
+
+			var config ElasticSANVolumeSnapshotResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			volumeGroupId, err := snapshots.ParseVolumeGroupID(config.VolumeGroupId)
+			if err != nil {
+				return err
+			}
+
+			sourceVolumeId, err := volumes.ParseVolumeID(config.SourceVolumeId)
+			if err != nil {
+				return err
+			}
+
+			// a Snapshot can only be taken of a Volume within the same Volume Group
+			sourceVolumeGroupId := snapshots.NewVolumeGroupID(sourceVolumeId.SubscriptionId, sourceVolumeId.ResourceGroupName, sourceVolumeId.ElasticSanName, sourceVolumeId.VolumeGroupName)
+			if !strings.EqualFold(sourceVolumeGroupId.ID(), volumeGroupId.ID()) {
+				return fmt.Errorf("`source_volume_id` must be a Volume within the Volume Group %q", volumeGroupId.ID())
+			}
+
+			id := snapshots.NewSnapshotID(volumeGroupId.SubscriptionId, volumeGroupId.ResourceGroupName, volumeGroupId.ElasticSanName, volumeGroupId.VolumeGroupName, config.Name)
+
+			existing, err := client.VolumeSnapshotsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := snapshots.Snapshot{
+				Properties: snapshots.SnapshotProperties{
+					CreationData: snapshots.SnapshotCreationData{
+						SourceId: config.SourceVolumeId,
+					},
+				},
+			}
+
+			if err := client.VolumeSnapshotsCreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ElasticSANVolumeSnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.Snapshots
+			schema := ElasticSANVolumeSnapshotResourceModel{}
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			volumeGroupId := snapshots.NewVolumeGroupID(id.SubscriptionId, id.ResourceGroupName, id.ElasticSanName, id.VolumeGroupName)
+
+			resp, err := client.VolumeSnapshotsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				schema.Name = id.SnapshotName
+				schema.VolumeGroupId = volumeGroupId.ID()
+				schema.SourceVolumeSizeInGiB = pointer.From(model.Properties.SourceVolumeSizeGiB)
+				schema.VolumeName = pointer.From(model.Properties.VolumeName)
+
+				sourceVolumeId, err := volumes.ParseVolumeIDInsensitively(model.Properties.CreationData.SourceId)
+				if err != nil {
+					return fmt.Errorf("parsing source volume ID: %+v", err)
+				}
+				schema.SourceVolumeId = sourceVolumeId.ID()
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r ElasticSANVolumeSnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.Snapshots
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.VolumeSnapshotsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsan_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/snapshots"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSANVolumeSnapshotTestResource struct{}
+
+func TestAccElasticSANVolumeSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume_snapshot", "test")
+	r := ElasticSANVolumeSnapshotTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_volume_size_in_gib").HasValue("1"),
+				check.That(data.ResourceName).Key("volume_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticSANVolumeSnapshot_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume_snapshot", "test")
+	r := ElasticSANVolumeSnapshotTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r ElasticSANVolumeSnapshotTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := snapshots.ParseSnapshotID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ElasticSan.Snapshots.VolumeSnapshotsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ElasticSANVolumeSnapshotTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume_snapshot" "test" {
+  name             = "acctestesvss-${var.random_string}"
+  volume_group_id  = azurerm_elastic_san_volume_group.test.id
+  source_volume_id = azurerm_elastic_san_volume.test.id
+}
+`, ElasticSANVolumeTestResource{}.basic(data, 1))
+}
+
+func (r ElasticSANVolumeSnapshotTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume_snapshot" "import" {
+  name             = azurerm_elastic_san_volume_snapshot.test.name
+  volume_group_id  = azurerm_elastic_san_volume_snapshot.test.volume_group_id
+  source_volume_id = azurerm_elastic_san_volume_snapshot.test.source_volume_id
+}
+`, r.basic(data))
+}
@@ -25,6 +25,8 @@ func (Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ElasticSANResource{},
 		ElasticSANVolumeGroupResource{},
+		ElasticSANVolumeResource{},
+		ElasticSANVolumeSnapshotResource{},
 	}
 }
 
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestElasticSanVolumeSnapshotName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "hello",
+			expected: true,
+		},
+		{
+			// 1 char
+			input:    "a",
+			expected: true,
+		},
+		{
+			// 80 chars
+			input:    "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzab",
+			expected: true,
+		},
+		{
+			// 81 chars
+			input:    "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabc",
+			expected: false,
+		},
+		{
+			// may contain alphanumerics, periods, dashes and underscores
+			input:    "hello_world7-goodbye.2024",
+			expected: true,
+		},
+		{
+			// must begin with an alphanumeric
+			input:    "-hello",
+			expected: false,
+		},
+		{
+			// can't end with a period
+			input:    "hello.",
+			expected: false,
+		},
+		{
+			// cannot have consecutive periods
+			input:    "hello..world",
+			expected: false,
+		},
+		{
+			// cannot have consecutive underscore or dash
+			input:    "hello-_world",
+			expected: false,
+		},
+		{
+			// can't contain uppercase letters
+			input:    "Hello",
+			expected: false,
+		},
+		{
+			// contain only numbers
+			input:    "12345",
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ElasticSanVolumeSnapshotName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			if len(errors) > 0 {
+				t.Logf("[DEBUG] Errors: %v", errors)
+			}
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type CaCertificatesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewCaCertificatesClientWithBaseURI(sdkApi environments.Api) (*CaCertificatesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "cacertificates", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating CaCertificatesClient: %+v", err)
+	}
+
+	return &CaCertificatesClient{
+		Client: client,
+	}, nil
+}
+
+// Get ...
+func (c CaCertificatesClient) Get(ctx context.Context, id CaCertificateId) (GetOperationResponse[CaCertificate], error) {
+	return get[CaCertificate](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c CaCertificatesClient) CreateOrUpdateThenPoll(ctx context.Context, id CaCertificateId, input CaCertificate) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c CaCertificatesClient) DeleteThenPoll(ctx context.Context, id CaCertificateId) error {
+	return deleteThenPoll(ctx, c.Client, id.ID())
+}
+
+type CaCertificate struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *CaCertificateProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
+
+type CaCertificateProperties struct {
+	Description        *string `json:"description,omitempty"`
+	EncodedCertificate *string `json:"encodedCertificate,omitempty"`
+	ExpiryTimeInUtc    *string `json:"expiryTimeInUtc,omitempty"`
+	IssueTimeInUtc     *string `json:"issueTimeInUtc,omitempty"`
+	ProvisioningState  *string `json:"provisioningState,omitempty"`
+}
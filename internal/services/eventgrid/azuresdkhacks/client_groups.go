@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type ClientGroupsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewClientGroupsClientWithBaseURI(sdkApi environments.Api) (*ClientGroupsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "clientgroups", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ClientGroupsClient: %+v", err)
+	}
+
+	return &ClientGroupsClient{
+		Client: client,
+	}, nil
+}
+
+// Get ...
+func (c ClientGroupsClient) Get(ctx context.Context, id ClientGroupId) (GetOperationResponse[ClientGroup], error) {
+	return get[ClientGroup](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c ClientGroupsClient) CreateOrUpdateThenPoll(ctx context.Context, id ClientGroupId, input ClientGroup) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c ClientGroupsClient) DeleteThenPoll(ctx context.Context, id ClientGroupId) error {
+	return deleteThenPoll(ctx, c.Client, id.ID())
+}
+
+type ClientGroup struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *ClientGroupProperties `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type ClientGroupProperties struct {
+	Description       *string `json:"description,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	Query             *string `json:"query,omitempty"`
+}
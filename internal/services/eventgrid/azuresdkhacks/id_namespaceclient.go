@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &NamespaceClientId{}
+
+// NamespaceClientId is a struct representing the Resource ID for a Namespace Client
+type NamespaceClientId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	NamespaceName     string
+	ClientName        string
+}
+
+// NewNamespaceClientID returns a new NamespaceClientId struct
+func NewNamespaceClientID(subscriptionId string, resourceGroupName string, namespaceName string, clientName string) NamespaceClientId {
+	return NamespaceClientId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		NamespaceName:     namespaceName,
+		ClientName:        clientName,
+	}
+}
+
+// ParseNamespaceClientID parses 'input' into a NamespaceClientId
+func ParseNamespaceClientID(input string) (*NamespaceClientId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&NamespaceClientId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := NamespaceClientId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseNamespaceClientIDInsensitively parses 'input' case-insensitively into a NamespaceClientId
+// note: this method should only be used for API response data and not user input
+func ParseNamespaceClientIDInsensitively(input string) (*NamespaceClientId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&NamespaceClientId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := NamespaceClientId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *NamespaceClientId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.NamespaceName, ok = input.Parsed["namespaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "namespaceName", input)
+	}
+
+	if id.ClientName, ok = input.Parsed["clientName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "clientName", input)
+	}
+
+	return nil
+}
+
+// ValidateNamespaceClientID checks that 'input' can be parsed as a Namespace Client ID
+func ValidateNamespaceClientID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseNamespaceClientID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Namespace Client ID
+func (id NamespaceClientId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/namespaces/%s/clients/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.ClientName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Namespace Client ID
+func (id NamespaceClientId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftEventGrid", "Microsoft.EventGrid", "Microsoft.EventGrid"),
+		resourceids.StaticSegment("staticNamespaces", "namespaces", "namespaces"),
+		resourceids.UserSpecifiedSegment("namespaceName", "namespaceValue"),
+		resourceids.StaticSegment("staticClients", "clients", "clients"),
+		resourceids.UserSpecifiedSegment("clientName", "clientValue"),
+	}
+}
+
+// String returns a human-readable description of this Namespace Client ID
+func (id NamespaceClientId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Namespace Name: %q", id.NamespaceName),
+		fmt.Sprintf("Client Name: %q", id.ClientName),
+	}
+	return fmt.Sprintf("Namespace Client (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type NamespaceClientsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewNamespaceClientsClientWithBaseURI(sdkApi environments.Api) (*NamespaceClientsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "clients", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating NamespaceClientsClient: %+v", err)
+	}
+
+	return &NamespaceClientsClient{
+		Client: client,
+	}, nil
+}
+
+// Get ...
+func (c NamespaceClientsClient) Get(ctx context.Context, id NamespaceClientId) (GetOperationResponse[NamespaceClient], error) {
+	return get[NamespaceClient](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c NamespaceClientsClient) CreateOrUpdateThenPoll(ctx context.Context, id NamespaceClientId, input NamespaceClient) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c NamespaceClientsClient) DeleteThenPoll(ctx context.Context, id NamespaceClientId) error {
+	return deleteThenPoll(ctx, c.Client, id.ID())
+}
+
+type NamespaceClient struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *NamespaceClientProperties `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
+
+type NamespaceClientProperties struct {
+	Attributes                      *map[string]interface{}          `json:"attributes,omitempty"`
+	AuthenticationName              *string                          `json:"authenticationName,omitempty"`
+	ClientCertificateAuthentication *ClientCertificateAuthentication `json:"clientCertificateAuthentication,omitempty"`
+	Description                     *string                          `json:"description,omitempty"`
+	ProvisioningState               *string                          `json:"provisioningState,omitempty"`
+	State                           *ClientState                     `json:"state,omitempty"`
+}
+
+type ClientCertificateAuthentication struct {
+	AllowedThumbprints *[]string                          `json:"allowedThumbprints,omitempty"`
+	ValidationScheme   *ClientCertificateValidationScheme `json:"validationScheme,omitempty"`
+}
+
+type ClientCertificateValidationScheme string
+
+const (
+	ClientCertificateValidationSchemeDnsMatchesAuthenticationName     ClientCertificateValidationScheme = "DnsMatchesAuthenticationName"
+	ClientCertificateValidationSchemeEmailMatchesAuthenticationName   ClientCertificateValidationScheme = "EmailMatchesAuthenticationName"
+	ClientCertificateValidationSchemeIPMatchesAuthenticationName      ClientCertificateValidationScheme = "IpMatchesAuthenticationName"
+	ClientCertificateValidationSchemeSubjectMatchesAuthenticationName ClientCertificateValidationScheme = "SubjectMatchesAuthenticationName"
+	ClientCertificateValidationSchemeThumbprintMatch                  ClientCertificateValidationScheme = "ThumbprintMatch"
+	ClientCertificateValidationSchemeUriMatchesAuthenticationName     ClientCertificateValidationScheme = "UriMatchesAuthenticationName"
+)
+
+func PossibleValuesForClientCertificateValidationScheme() []string {
+	return []string{
+		string(ClientCertificateValidationSchemeDnsMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeEmailMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeIPMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeSubjectMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeThumbprintMatch),
+		string(ClientCertificateValidationSchemeUriMatchesAuthenticationName),
+	}
+}
+
+type ClientState string
+
+const (
+	ClientStateDisabled ClientState = "Disabled"
+	ClientStateEnabled  ClientState = "Enabled"
+)
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type NamespaceTopicEventSubscriptionsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewNamespaceTopicEventSubscriptionsClientWithBaseURI(sdkApi environments.Api) (*NamespaceTopicEventSubscriptionsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "namespacetopiceventsubscriptions", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating NamespaceTopicEventSubscriptionsClient: %+v", err)
+	}
+
+	return &NamespaceTopicEventSubscriptionsClient{
+		Client: client,
+	}, nil
+}
+
+// Get ...
+func (c NamespaceTopicEventSubscriptionsClient) Get(ctx context.Context, id NamespaceTopicEventSubscriptionId) (GetOperationResponse[NamespaceTopicEventSubscription], error) {
+	return get[NamespaceTopicEventSubscription](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c NamespaceTopicEventSubscriptionsClient) CreateOrUpdateThenPoll(ctx context.Context, id NamespaceTopicEventSubscriptionId, input NamespaceTopicEventSubscription) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c NamespaceTopicEventSubscriptionsClient) DeleteThenPoll(ctx context.Context, id NamespaceTopicEventSubscriptionId) error {
+	return deleteThenPoll(ctx, c.Client, id.ID())
+}
+
+type NamespaceTopicEventSubscription struct {
+	Id         *string                                    `json:"id,omitempty"`
+	Name       *string                                    `json:"name,omitempty"`
+	Properties *NamespaceTopicEventSubscriptionProperties `json:"properties,omitempty"`
+	Type       *string                                    `json:"type,omitempty"`
+}
+
+type NamespaceTopicEventSubscriptionProperties struct {
+	DeliveryConfiguration *DeliveryConfiguration `json:"deliveryConfiguration,omitempty"`
+	EventDeliverySchema   *DeliverySchema        `json:"eventDeliverySchema,omitempty"`
+	FiltersConfiguration  *FiltersConfiguration  `json:"filtersConfiguration,omitempty"`
+	ProvisioningState     *string                `json:"provisioningState,omitempty"`
+}
+
+type DeliveryConfiguration struct {
+	DeliveryMode *DeliveryMode `json:"deliveryMode,omitempty"`
+	Push         *PushInfo     `json:"push,omitempty"`
+	Queue        *QueueInfo    `json:"queue,omitempty"`
+}
+
+type FiltersConfiguration struct {
+	IncludedEventTypes *[]string `json:"includedEventTypes,omitempty"`
+}
+
+type QueueInfo struct {
+	EventTimeToLive              *string `json:"eventTimeToLive,omitempty"`
+	MaxDeliveryCount             *int64  `json:"maxDeliveryCount,omitempty"`
+	ReceiveLockDurationInSeconds *int64  `json:"receiveLockDurationInSeconds,omitempty"`
+}
+
+type PushInfo struct {
+	DeliveryWithResourceIdentity *DeliveryWithResourceIdentity `json:"deliveryWithResourceIdentity,omitempty"`
+	Destination                  *EventSubscriptionDestination `json:"destination,omitempty"`
+	EventTimeToLive              *string                       `json:"eventTimeToLive,omitempty"`
+	MaxDeliveryCount             *int64                        `json:"maxDeliveryCount,omitempty"`
+}
+
+type DeliveryWithResourceIdentity struct {
+	Destination *EventSubscriptionDestination `json:"destination,omitempty"`
+	Identity    *EventSubscriptionIdentity    `json:"identity,omitempty"`
+}
+
+type EventSubscriptionIdentity struct {
+	Type                 *EventSubscriptionIdentityType `json:"type,omitempty"`
+	UserAssignedIdentity *string                        `json:"userAssignedIdentity,omitempty"`
+}
+
+// EventSubscriptionDestination is a discriminated type in the API, the properties of the supported
+// destinations are flattened into a single model here since they don't overlap.
+type EventSubscriptionDestination struct {
+	EndpointType EndpointType                            `json:"endpointType"`
+	Properties   *EventSubscriptionDestinationProperties `json:"properties,omitempty"`
+}
+
+type EventSubscriptionDestinationProperties struct {
+	// EndpointBaseUrl is returned by the API for a `WebHook` destination, since the `EndpointUrl` may contain secrets
+	EndpointBaseUrl *string `json:"endpointBaseUrl,omitempty"`
+	EndpointUrl     *string `json:"endpointUrl,omitempty"`
+
+	// ResourceId is used for the `EventHub`, `ServiceBusQueue` and `ServiceBusTopic` destinations
+	ResourceId *string `json:"resourceId,omitempty"`
+}
+
+type DeliveryMode string
+
+const (
+	DeliveryModePush  DeliveryMode = "Push"
+	DeliveryModeQueue DeliveryMode = "Queue"
+)
+
+type DeliverySchema string
+
+const (
+	DeliverySchemaCloudEventSchemaVOneZero DeliverySchema = "CloudEventSchemaV1_0"
+)
+
+func PossibleValuesForDeliverySchema() []string {
+	return []string{
+		string(DeliverySchemaCloudEventSchemaVOneZero),
+	}
+}
+
+type EndpointType string
+
+const (
+	EndpointTypeEventHub        EndpointType = "EventHub"
+	EndpointTypeServiceBusQueue EndpointType = "ServiceBusQueue"
+	EndpointTypeServiceBusTopic EndpointType = "ServiceBusTopic"
+	EndpointTypeWebHook         EndpointType = "WebHook"
+)
+
+type EventSubscriptionIdentityType string
+
+const (
+	EventSubscriptionIdentityTypeSystemAssigned EventSubscriptionIdentityType = "SystemAssigned"
+	EventSubscriptionIdentityTypeUserAssigned   EventSubscriptionIdentityType = "UserAssigned"
+)
+
+func PossibleValuesForEventSubscriptionIdentityType() []string {
+	return []string{
+		string(EventSubscriptionIdentityTypeSystemAssigned),
+		string(EventSubscriptionIdentityTypeUserAssigned),
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type NamespaceTopicsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewNamespaceTopicsClientWithBaseURI(sdkApi environments.Api) (*NamespaceTopicsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "namespacetopics", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating NamespaceTopicsClient: %+v", err)
+	}
+
+	return &NamespaceTopicsClient{
+		Client: client,
+	}, nil
+}
+
+// Get ...
+func (c NamespaceTopicsClient) Get(ctx context.Context, id NamespaceTopicId) (GetOperationResponse[NamespaceTopic], error) {
+	return get[NamespaceTopic](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c NamespaceTopicsClient) CreateOrUpdateThenPoll(ctx context.Context, id NamespaceTopicId, input NamespaceTopic) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c NamespaceTopicsClient) DeleteThenPoll(ctx context.Context, id NamespaceTopicId) error {
+	return deleteThenPoll(ctx, c.Client, id.ID())
+}
+
+type NamespaceTopic struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *NamespaceTopicProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type NamespaceTopicProperties struct {
+	EventRetentionInDays *int64            `json:"eventRetentionInDays,omitempty"`
+	InputSchema          *EventInputSchema `json:"inputSchema,omitempty"`
+	ProvisioningState    *string           `json:"provisioningState,omitempty"`
+	PublisherType        *PublisherType    `json:"publisherType,omitempty"`
+}
+
+type EventInputSchema string
+
+const (
+	EventInputSchemaCloudEventSchemaVOneZero EventInputSchema = "CloudEventSchemaV1_0"
+)
+
+func PossibleValuesForEventInputSchema() []string {
+	return []string{
+		string(EventInputSchemaCloudEventSchemaVOneZero),
+	}
+}
+
+type PublisherType string
+
+const (
+	PublisherTypeCustom PublisherType = "Custom"
+)
+
+func PossibleValuesForPublisherType() []string {
+	return []string{
+		string(PublisherTypeCustom),
+	}
+}
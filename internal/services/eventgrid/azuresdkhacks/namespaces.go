@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type NamespacesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewNamespacesClientWithBaseURI(sdkApi environments.Api) (*NamespacesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "namespaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating NamespacesClient: %+v", err)
+	}
+
+	return &NamespacesClient{
+		Client: client,
+	}, nil
+}
+
+// Get ...
+func (c NamespacesClient) Get(ctx context.Context, id NamespaceId) (GetOperationResponse[Namespace], error) {
+	return get[Namespace](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c NamespacesClient) CreateOrUpdateThenPoll(ctx context.Context, id NamespaceId, input Namespace) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c NamespacesClient) DeleteThenPoll(ctx context.Context, id NamespaceId) error {
+	return deleteThenPoll(ctx, c.Client, id.ID())
+}
+
+type Namespace struct {
+	Id         *string                            `json:"id,omitempty"`
+	Identity   *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   string                             `json:"location"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *NamespaceProperties               `json:"properties,omitempty"`
+	Sku        *NamespaceSku                      `json:"sku,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
+
+type NamespaceProperties struct {
+	InboundIPRules           *[]InboundIPRule              `json:"inboundIpRules,omitempty"`
+	IsZoneRedundant          *bool                         `json:"isZoneRedundant,omitempty"`
+	MinimumTlsVersionAllowed *TlsVersion                   `json:"minimumTlsVersionAllowed,omitempty"`
+	ProvisioningState        *string                       `json:"provisioningState,omitempty"`
+	PublicNetworkAccess      *PublicNetworkAccess          `json:"publicNetworkAccess,omitempty"`
+	TopicSpacesConfiguration *TopicSpacesConfiguration     `json:"topicSpacesConfiguration,omitempty"`
+	TopicsConfiguration      *NamespaceTopicsConfiguration `json:"topicsConfiguration,omitempty"`
+}
+
+type NamespaceSku struct {
+	Capacity *int64            `json:"capacity,omitempty"`
+	Name     *NamespaceSkuName `json:"name,omitempty"`
+}
+
+type InboundIPRule struct {
+	Action *IPActionType `json:"action,omitempty"`
+	IPMask *string       `json:"ipMask,omitempty"`
+}
+
+type NamespaceTopicsConfiguration struct {
+	Hostname *string `json:"hostname,omitempty"`
+}
+
+// TopicSpacesConfiguration configures the MQTT Broker for the Namespace
+type TopicSpacesConfiguration struct {
+	ClientAuthentication                       *ClientAuthenticationSettings  `json:"clientAuthentication,omitempty"`
+	Hostname                                   *string                        `json:"hostname,omitempty"`
+	MaximumClientSessionsPerAuthenticationName *int64                         `json:"maximumClientSessionsPerAuthenticationName,omitempty"`
+	MaximumSessionExpiryInHours                *int64                         `json:"maximumSessionExpiryInHours,omitempty"`
+	RouteTopicResourceId                       *string                        `json:"routeTopicResourceId,omitempty"`
+	RoutingEnrichments                         *RoutingEnrichments            `json:"routingEnrichments,omitempty"`
+	State                                      *TopicSpacesConfigurationState `json:"state,omitempty"`
+}
+
+type ClientAuthenticationSettings struct {
+	AlternativeAuthenticationNameSources *[]AlternativeAuthenticationNameSource `json:"alternativeAuthenticationNameSources,omitempty"`
+}
+
+type RoutingEnrichments struct {
+	Dynamic *[]DynamicRoutingEnrichment `json:"dynamic,omitempty"`
+	Static  *[]StaticRoutingEnrichment  `json:"static,omitempty"`
+}
+
+type DynamicRoutingEnrichment struct {
+	Key   *string `json:"key,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type StaticRoutingEnrichment struct {
+	Key       *string                     `json:"key,omitempty"`
+	Value     *string                     `json:"value,omitempty"`
+	ValueType StaticRoutingEnrichmentType `json:"valueType"`
+}
+
+type AlternativeAuthenticationNameSource string
+
+const (
+	AlternativeAuthenticationNameSourceClientCertificateDns     AlternativeAuthenticationNameSource = "ClientCertificateDns"
+	AlternativeAuthenticationNameSourceClientCertificateEmail   AlternativeAuthenticationNameSource = "ClientCertificateEmail"
+	AlternativeAuthenticationNameSourceClientCertificateIP      AlternativeAuthenticationNameSource = "ClientCertificateIp"
+	AlternativeAuthenticationNameSourceClientCertificateSubject AlternativeAuthenticationNameSource = "ClientCertificateSubject"
+	AlternativeAuthenticationNameSourceClientCertificateUri     AlternativeAuthenticationNameSource = "ClientCertificateUri"
+)
+
+func PossibleValuesForAlternativeAuthenticationNameSource() []string {
+	return []string{
+		string(AlternativeAuthenticationNameSourceClientCertificateDns),
+		string(AlternativeAuthenticationNameSourceClientCertificateEmail),
+		string(AlternativeAuthenticationNameSourceClientCertificateIP),
+		string(AlternativeAuthenticationNameSourceClientCertificateSubject),
+		string(AlternativeAuthenticationNameSourceClientCertificateUri),
+	}
+}
+
+type IPActionType string
+
+const (
+	IPActionTypeAllow IPActionType = "Allow"
+)
+
+type NamespaceSkuName string
+
+const (
+	NamespaceSkuNameStandard NamespaceSkuName = "Standard"
+)
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+type StaticRoutingEnrichmentType string
+
+const (
+	StaticRoutingEnrichmentTypeString StaticRoutingEnrichmentType = "String"
+)
+
+type TlsVersion string
+
+const (
+	TlsVersionOnePointOne  TlsVersion = "1.1"
+	TlsVersionOnePointTwo  TlsVersion = "1.2"
+	TlsVersionOnePointZero TlsVersion = "1.0"
+)
+
+func PossibleValuesForTlsVersion() []string {
+	return []string{
+		string(TlsVersionOnePointOne),
+		string(TlsVersionOnePointTwo),
+		string(TlsVersionOnePointZero),
+	}
+}
+
+type TopicSpacesConfigurationState string
+
+const (
+	TopicSpacesConfigurationStateDisabled TopicSpacesConfigurationState = "Disabled"
+	TopicSpacesConfigurationStateEnabled  TopicSpacesConfigurationState = "Enabled"
+)
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// TODO: remove this once the EventGrid SDK includes Namespaces
+// Event Grid Namespaces (and the MQTT Broker) are only available from API version `2023-12-15-preview`, which
+// isn't vendored - as such the Clients for the Namespace resources are defined here until the SDK has been updated.
+const defaultApiVersion = "2023-12-15-preview"
+
+type GetOperationResponse[T any] struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *T
+}
+
+// get retrieves the resource at the specified path, unmarshalling it into the Model
+func get[T any](ctx context.Context, c *resourcemanager.Client, path string) (result GetOperationResponse[T], err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       path,
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// createOrUpdateThenPoll performs a PUT against the specified path, then polls until it's completed
+func createOrUpdateThenPoll(ctx context.Context, c *resourcemanager.Client, path string, input interface{}) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       path,
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return fmt.Errorf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c)
+	if err != nil {
+		return fmt.Errorf("building poller: %+v", err)
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// deleteThenPoll deletes the resource at the specified path, then polls until it's gone
+func deleteThenPoll(ctx context.Context, c *resourcemanager.Client, path string) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       path,
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c)
+	if err != nil {
+		return fmt.Errorf("building poller: %+v", err)
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
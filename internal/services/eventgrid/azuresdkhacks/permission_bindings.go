@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type PermissionBindingsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewPermissionBindingsClientWithBaseURI(sdkApi environments.Api) (*PermissionBindingsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "permissionbindings", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating PermissionBindingsClient: %+v", err)
+	}
+
+	return &PermissionBindingsClient{
+		Client: client,
+	}, nil
+}
+
+// Get ...
+func (c PermissionBindingsClient) Get(ctx context.Context, id PermissionBindingId) (GetOperationResponse[PermissionBinding], error) {
+	return get[PermissionBinding](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c PermissionBindingsClient) CreateOrUpdateThenPoll(ctx context.Context, id PermissionBindingId, input PermissionBinding) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c PermissionBindingsClient) DeleteThenPoll(ctx context.Context, id PermissionBindingId) error {
+	return deleteThenPoll(ctx, c.Client, id.ID())
+}
+
+type PermissionBinding struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *PermissionBindingProperties `json:"properties,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
+
+type PermissionBindingProperties struct {
+	ClientGroupName   *string         `json:"clientGroupName,omitempty"`
+	Description       *string         `json:"description,omitempty"`
+	Permission        *PermissionType `json:"permission,omitempty"`
+	ProvisioningState *string         `json:"provisioningState,omitempty"`
+	TopicSpaceName    *string         `json:"topicSpaceName,omitempty"`
+}
+
+type PermissionType string
+
+const (
+	PermissionTypePublisher  PermissionType = "Publisher"
+	PermissionTypeSubscriber PermissionType = "Subscriber"
+)
+
+func PossibleValuesForPermissionType() []string {
+	return []string{
+		string(PermissionTypePublisher),
+		string(PermissionTypeSubscriber),
+	}
+}
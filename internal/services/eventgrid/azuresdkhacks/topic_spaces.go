@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type TopicSpacesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewTopicSpacesClientWithBaseURI(sdkApi environments.Api) (*TopicSpacesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "topicspaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating TopicSpacesClient: %+v", err)
+	}
+
+	return &TopicSpacesClient{
+		Client: client,
+	}, nil
+}
+
+// Get ...
+func (c TopicSpacesClient) Get(ctx context.Context, id TopicSpaceId) (GetOperationResponse[TopicSpace], error) {
+	return get[TopicSpace](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c TopicSpacesClient) CreateOrUpdateThenPoll(ctx context.Context, id TopicSpaceId, input TopicSpace) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c TopicSpacesClient) DeleteThenPoll(ctx context.Context, id TopicSpaceId) error {
+	return deleteThenPoll(ctx, c.Client, id.ID())
+}
+
+type TopicSpace struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *TopicSpaceProperties `json:"properties,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
+
+type TopicSpaceProperties struct {
+	Description       *string   `json:"description,omitempty"`
+	ProvisioningState *string   `json:"provisioningState,omitempty"`
+	TopicTemplates    *[]string `json:"topicTemplates,omitempty"`
+}
@@ -9,6 +9,7 @@ import (
 	eventgrid_v2022_06_15 "github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
 )
 
 func NewClient(o *common.ClientOptions) (*eventgrid_v2022_06_15.Client, error) {
@@ -20,3 +21,77 @@ func NewClient(o *common.ClientOptions) (*eventgrid_v2022_06_15.Client, error) {
 	}
 	return client, nil
 }
+
+// NamespacesClient contains the Clients for Event Grid Namespaces, which aren't available in the vendored SDK
+// TODO: replace these with the SDK Clients once the vendored EventGrid SDK includes Namespaces
+type NamespacesClient struct {
+	CaCertificatesClient                   *azuresdkhacks.CaCertificatesClient
+	ClientGroupsClient                     *azuresdkhacks.ClientGroupsClient
+	NamespaceClientsClient                 *azuresdkhacks.NamespaceClientsClient
+	NamespacesClient                       *azuresdkhacks.NamespacesClient
+	NamespaceTopicEventSubscriptionsClient *azuresdkhacks.NamespaceTopicEventSubscriptionsClient
+	NamespaceTopicsClient                  *azuresdkhacks.NamespaceTopicsClient
+	PermissionBindingsClient               *azuresdkhacks.PermissionBindingsClient
+	TopicSpacesClient                      *azuresdkhacks.TopicSpacesClient
+}
+
+func NewNamespacesClient(o *common.ClientOptions) (*NamespacesClient, error) {
+	caCertificatesClient, err := azuresdkhacks.NewCaCertificatesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building CaCertificates client: %+v", err)
+	}
+	o.Configure(caCertificatesClient.Client, o.Authorizers.ResourceManager)
+
+	clientGroupsClient, err := azuresdkhacks.NewClientGroupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ClientGroups client: %+v", err)
+	}
+	o.Configure(clientGroupsClient.Client, o.Authorizers.ResourceManager)
+
+	namespaceClientsClient, err := azuresdkhacks.NewNamespaceClientsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building NamespaceClients client: %+v", err)
+	}
+	o.Configure(namespaceClientsClient.Client, o.Authorizers.ResourceManager)
+
+	namespacesClient, err := azuresdkhacks.NewNamespacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Namespaces client: %+v", err)
+	}
+	o.Configure(namespacesClient.Client, o.Authorizers.ResourceManager)
+
+	namespaceTopicEventSubscriptionsClient, err := azuresdkhacks.NewNamespaceTopicEventSubscriptionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building NamespaceTopicEventSubscriptions client: %+v", err)
+	}
+	o.Configure(namespaceTopicEventSubscriptionsClient.Client, o.Authorizers.ResourceManager)
+
+	namespaceTopicsClient, err := azuresdkhacks.NewNamespaceTopicsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building NamespaceTopics client: %+v", err)
+	}
+	o.Configure(namespaceTopicsClient.Client, o.Authorizers.ResourceManager)
+
+	permissionBindingsClient, err := azuresdkhacks.NewPermissionBindingsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building PermissionBindings client: %+v", err)
+	}
+	o.Configure(permissionBindingsClient.Client, o.Authorizers.ResourceManager)
+
+	topicSpacesClient, err := azuresdkhacks.NewTopicSpacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building TopicSpaces client: %+v", err)
+	}
+	o.Configure(topicSpacesClient.Client, o.Authorizers.ResourceManager)
+
+	return &NamespacesClient{
+		CaCertificatesClient:                   caCertificatesClient,
+		ClientGroupsClient:                     clientGroupsClient,
+		NamespaceClientsClient:                 namespaceClientsClient,
+		NamespacesClient:                       namespacesClient,
+		NamespaceTopicEventSubscriptionsClient: namespaceTopicEventSubscriptionsClient,
+		NamespaceTopicsClient:                  namespaceTopicsClient,
+		PermissionBindingsClient:               permissionBindingsClient,
+		TopicSpacesClient:                      topicSpacesClient,
+	}, nil
+}
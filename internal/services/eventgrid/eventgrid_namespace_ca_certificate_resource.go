@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceCaCertificateResource struct{}
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceCaCertificateResource{}
+
+type EventGridNamespaceCaCertificateModel struct {
+	Name               string `tfschema:"name"`
+	NamespaceId        string `tfschema:"namespace_id"`
+	EncodedCertificate string `tfschema:"encoded_certificate"`
+	Description        string `tfschema:"description"`
+	IssueTime          string `tfschema:"issue_time"`
+	ExpiryTime         string `tfschema:"expiry_time"`
+}
+
+func (r EventGridNamespaceCaCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace CA Certificate name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azuresdkhacks.ValidateNamespaceID,
+		},
+
+		// the PEM-encoded certificate
+		"encoded_certificate": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"issue_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"expiry_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) ModelObject() interface{} {
+	return &EventGridNamespaceCaCertificateModel{}
+}
+
+func (r EventGridNamespaceCaCertificateResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_ca_certificate"
+}
+
+func (r EventGridNamespaceCaCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateCaCertificateID
+}
+
+func (r EventGridNamespaceCaCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.CaCertificatesClient
+
+			var model EventGridNamespaceCaCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := azuresdkhacks.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewCaCertificateID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandEventGridNamespaceCaCertificate(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.CaCertificatesClient
+
+			id, err := azuresdkhacks.ParseCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceCaCertificateModel{
+				Name:        id.CaCertificateName,
+				NamespaceId: azuresdkhacks.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EncodedCertificate = pointer.From(props.EncodedCertificate)
+					state.Description = pointer.From(props.Description)
+					state.IssueTime = pointer.From(props.IssueTimeInUtc)
+					state.ExpiryTime = pointer.From(props.ExpiryTimeInUtc)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.CaCertificatesClient
+
+			id, err := azuresdkhacks.ParseCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceCaCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandEventGridNamespaceCaCertificate(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.CaCertificatesClient
+
+			id, err := azuresdkhacks.ParseCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceCaCertificate(input EventGridNamespaceCaCertificateModel) azuresdkhacks.CaCertificate {
+	output := azuresdkhacks.CaCertificate{
+		Properties: &azuresdkhacks.CaCertificateProperties{
+			EncodedCertificate: pointer.To(input.EncodedCertificate),
+		},
+	}
+
+	if input.Description != "" {
+		output.Properties.Description = pointer.To(input.Description)
+	}
+
+	return output
+}
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceClientResource struct{}
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceClientResource{}
+
+type EventGridNamespaceClientModel struct {
+	Name                            string                                              `tfschema:"name"`
+	NamespaceId                     string                                              `tfschema:"namespace_id"`
+	AuthenticationName              string                                              `tfschema:"authentication_name"`
+	Attributes                      map[string]string                                   `tfschema:"attributes"`
+	ClientCertificateAuthentication []EventGridNamespaceClientCertificateAuthentication `tfschema:"client_certificate_authentication"`
+	Description                     string                                              `tfschema:"description"`
+	Enabled                         bool                                                `tfschema:"enabled"`
+}
+
+type EventGridNamespaceClientCertificateAuthentication struct {
+	ValidationScheme   string   `tfschema:"validation_scheme"`
+	AllowedThumbprints []string `tfschema:"allowed_thumbprints"`
+}
+
+func (r EventGridNamespaceClientResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9._:]{3,50}$"),
+				"EventGrid Namespace Client name must be 3 - 50 characters long, contain only letters, numbers, hyphens, periods, underscores and colons.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azuresdkhacks.ValidateNamespaceID,
+		},
+
+		"authentication_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(3, 128),
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"client_certificate_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_scheme": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForClientCertificateValidationScheme(), false),
+					},
+
+					"allowed_thumbprints": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceClientResource) ModelObject() interface{} {
+	return &EventGridNamespaceClientModel{}
+}
+
+func (r EventGridNamespaceClientResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client"
+}
+
+func (r EventGridNamespaceClientResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateNamespaceClientID
+}
+
+func (r EventGridNamespaceClientResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceClientsClient
+
+			var model EventGridNamespaceClientModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := azuresdkhacks.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewNamespaceClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandEventGridNamespaceClient(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceClientsClient
+
+			id, err := azuresdkhacks.ParseNamespaceClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceClientModel{
+				Name:        id.ClientName,
+				NamespaceId: azuresdkhacks.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AuthenticationName = pointer.From(props.AuthenticationName)
+					state.Attributes = flattenEventGridNamespaceClientAttributes(props.Attributes)
+					state.Description = pointer.From(props.Description)
+					state.Enabled = pointer.From(props.State) != azuresdkhacks.ClientStateDisabled
+
+					if auth := props.ClientCertificateAuthentication; auth != nil {
+						state.ClientCertificateAuthentication = []EventGridNamespaceClientCertificateAuthentication{
+							{
+								ValidationScheme:   string(pointer.From(auth.ValidationScheme)),
+								AllowedThumbprints: pointer.From(auth.AllowedThumbprints),
+							},
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceClientsClient
+
+			id, err := azuresdkhacks.ParseNamespaceClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceClientModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandEventGridNamespaceClient(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceClientsClient
+
+			id, err := azuresdkhacks.ParseNamespaceClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceClient(input EventGridNamespaceClientModel) azuresdkhacks.NamespaceClient {
+	state := azuresdkhacks.ClientStateDisabled
+	if input.Enabled {
+		state = azuresdkhacks.ClientStateEnabled
+	}
+
+	attributes := make(map[string]interface{})
+	for k, v := range input.Attributes {
+		attributes[k] = v
+	}
+
+	output := azuresdkhacks.NamespaceClient{
+		Properties: &azuresdkhacks.NamespaceClientProperties{
+			Attributes: &attributes,
+			State:      pointer.To(state),
+		},
+	}
+
+	if input.AuthenticationName != "" {
+		output.Properties.AuthenticationName = pointer.To(input.AuthenticationName)
+	}
+
+	if input.Description != "" {
+		output.Properties.Description = pointer.To(input.Description)
+	}
+
+	if len(input.ClientCertificateAuthentication) > 0 {
+		auth := input.ClientCertificateAuthentication[0]
+		output.Properties.ClientCertificateAuthentication = &azuresdkhacks.ClientCertificateAuthentication{
+			ValidationScheme: pointer.To(azuresdkhacks.ClientCertificateValidationScheme(auth.ValidationScheme)),
+		}
+		if len(auth.AllowedThumbprints) > 0 {
+			output.Properties.ClientCertificateAuthentication.AllowedThumbprints = pointer.To(auth.AllowedThumbprints)
+		}
+	}
+
+	return output
+}
+
+// flattenEventGridNamespaceClientAttributes returns the string attributes of a Client - the API also supports
+// attributes containing an array of strings, which aren't supported and are ignored.
+func flattenEventGridNamespaceClientAttributes(input *map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		if s, ok := v.(string); ok {
+			output[k] = s
+		}
+	}
+
+	return output
+}
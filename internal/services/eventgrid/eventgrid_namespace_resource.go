@@ -0,0 +1,528 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = EventGridNamespaceResource{}
+	_ sdk.ResourceWithCustomizeDiff = EventGridNamespaceResource{}
+)
+
+type EventGridNamespaceModel struct {
+	Name                       string                                     `tfschema:"name"`
+	ResourceGroupName          string                                     `tfschema:"resource_group_name"`
+	Location                   string                                     `tfschema:"location"`
+	Sku                        string                                     `tfschema:"sku"`
+	Capacity                   int64                                      `tfschema:"capacity"`
+	Identity                   []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	InboundIPRules             []EventGridNamespaceInboundIPRuleModel     `tfschema:"inbound_ip_rule"`
+	PublicNetworkAccessEnabled bool                                       `tfschema:"public_network_access_enabled"`
+	TopicSpacesConfiguration   []EventGridNamespaceTopicSpacesConfigModel `tfschema:"topic_spaces_configuration"`
+	TopicsHostname             string                                     `tfschema:"topics_hostname"`
+	TopicSpacesHostname        string                                     `tfschema:"topic_spaces_hostname"`
+	Tags                       map[string]string                          `tfschema:"tags"`
+}
+
+type EventGridNamespaceInboundIPRuleModel struct {
+	IPMask string `tfschema:"ip_mask"`
+	Action string `tfschema:"action"`
+}
+
+type EventGridNamespaceTopicSpacesConfigModel struct {
+	AlternativeAuthenticationNameSources       []string                                   `tfschema:"alternative_authentication_name_sources"`
+	MaximumClientSessionsPerAuthenticationName int64                                      `tfschema:"maximum_client_sessions_per_authentication_name"`
+	MaximumSessionExpiryInHours                int64                                      `tfschema:"maximum_session_expiry_in_hours"`
+	RouteTopicId                               string                                     `tfschema:"route_topic_id"`
+	DynamicRoutingEnrichments                  []EventGridNamespaceRoutingEnrichmentModel `tfschema:"dynamic_routing_enrichment"`
+	StaticRoutingEnrichments                   []EventGridNamespaceRoutingEnrichmentModel `tfschema:"static_routing_enrichment"`
+}
+
+type EventGridNamespaceRoutingEnrichmentModel struct {
+	Key   string `tfschema:"key"`
+	Value string `tfschema:"value"`
+}
+
+func (r EventGridNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	routingEnrichmentSchema := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(azuresdkhacks.NamespaceSkuNameStandard),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.NamespaceSkuNameStandard),
+			}, false),
+		},
+
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 40),
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"inbound_ip_rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 128,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_mask": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPAddress),
+					},
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(azuresdkhacks.IPActionTypeAllow),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(azuresdkhacks.IPActionTypeAllow),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		// the presence of this block enables the MQTT Broker for the Namespace
+		"topic_spaces_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"alternative_authentication_name_sources": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForAlternativeAuthenticationNameSource(), false),
+						},
+					},
+
+					"maximum_client_sessions_per_authentication_name": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+
+					"maximum_session_expiry_in_hours": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 8),
+					},
+
+					"route_topic_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"dynamic_routing_enrichment": routingEnrichmentSchema(),
+
+					"static_routing_enrichment": routingEnrichmentSchema(),
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r EventGridNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"topics_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"topic_spaces_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) ModelObject() interface{} {
+	return &EventGridNamespaceModel{}
+}
+
+func (r EventGridNamespaceResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace"
+}
+
+func (r EventGridNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateNamespaceID
+}
+
+func (r EventGridNamespaceResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the MQTT Broker can't be disabled once it's been enabled, so removing this block recreates the Namespace
+			if oldVal, newVal := metadata.ResourceDiff.GetChange("topic_spaces_configuration"); len(oldVal.([]interface{})) > 0 && len(newVal.([]interface{})) == 0 {
+				if err := metadata.ResourceDiff.ForceNew("topic_spaces_configuration"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model EventGridNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := azuresdkhacks.NewNamespaceID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandEventGridNamespace(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespacesClient
+
+			id, err := azuresdkhacks.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceModel{
+				Name:              id.NamespaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(flattenedIdentity)
+
+				if sku := model.Sku; sku != nil {
+					state.Sku = string(pointer.From(sku.Name))
+					state.Capacity = pointer.From(sku.Capacity)
+				}
+
+				if props := model.Properties; props != nil {
+					state.InboundIPRules = flattenEventGridNamespaceInboundIPRules(props.InboundIPRules)
+					state.PublicNetworkAccessEnabled = pointer.From(props.PublicNetworkAccess) != azuresdkhacks.PublicNetworkAccessDisabled
+
+					if config := props.TopicsConfiguration; config != nil {
+						state.TopicsHostname = pointer.From(config.Hostname)
+					}
+
+					if config := props.TopicSpacesConfiguration; config != nil {
+						state.TopicSpacesHostname = pointer.From(config.Hostname)
+						state.TopicSpacesConfiguration = flattenEventGridNamespaceTopicSpacesConfiguration(config)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespacesClient
+
+			id, err := azuresdkhacks.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload, err := expandEventGridNamespace(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespacesClient
+
+			id, err := azuresdkhacks.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespace(input EventGridNamespaceModel) (*azuresdkhacks.Namespace, error) {
+	expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(input.Identity)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	publicNetworkAccess := azuresdkhacks.PublicNetworkAccessDisabled
+	if input.PublicNetworkAccessEnabled {
+		publicNetworkAccess = azuresdkhacks.PublicNetworkAccessEnabled
+	}
+
+	topicSpacesConfiguration := &azuresdkhacks.TopicSpacesConfiguration{
+		State: pointer.To(azuresdkhacks.TopicSpacesConfigurationStateDisabled),
+	}
+	if len(input.TopicSpacesConfiguration) > 0 {
+		topicSpacesConfiguration = expandEventGridNamespaceTopicSpacesConfiguration(input.TopicSpacesConfiguration[0])
+	}
+
+	return &azuresdkhacks.Namespace{
+		Identity: expandedIdentity,
+		Location: location.Normalize(input.Location),
+		Properties: &azuresdkhacks.NamespaceProperties{
+			InboundIPRules:           expandEventGridNamespaceInboundIPRules(input.InboundIPRules),
+			PublicNetworkAccess:      pointer.To(publicNetworkAccess),
+			TopicSpacesConfiguration: topicSpacesConfiguration,
+		},
+		Sku: &azuresdkhacks.NamespaceSku{
+			Capacity: pointer.To(input.Capacity),
+			Name:     pointer.To(azuresdkhacks.NamespaceSkuName(input.Sku)),
+		},
+		Tags: pointer.To(input.Tags),
+	}, nil
+}
+
+func expandEventGridNamespaceInboundIPRules(input []EventGridNamespaceInboundIPRuleModel) *[]azuresdkhacks.InboundIPRule {
+	output := make([]azuresdkhacks.InboundIPRule, 0)
+	for _, v := range input {
+		output = append(output, azuresdkhacks.InboundIPRule{
+			Action: pointer.To(azuresdkhacks.IPActionType(v.Action)),
+			IPMask: pointer.To(v.IPMask),
+		})
+	}
+	return &output
+}
+
+func flattenEventGridNamespaceInboundIPRules(input *[]azuresdkhacks.InboundIPRule) []EventGridNamespaceInboundIPRuleModel {
+	output := make([]EventGridNamespaceInboundIPRuleModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, EventGridNamespaceInboundIPRuleModel{
+			Action: string(pointer.From(v.Action)),
+			IPMask: pointer.From(v.IPMask),
+		})
+	}
+	return output
+}
+
+func expandEventGridNamespaceTopicSpacesConfiguration(input EventGridNamespaceTopicSpacesConfigModel) *azuresdkhacks.TopicSpacesConfiguration {
+	alternativeAuthenticationNameSources := make([]azuresdkhacks.AlternativeAuthenticationNameSource, 0)
+	for _, v := range input.AlternativeAuthenticationNameSources {
+		alternativeAuthenticationNameSources = append(alternativeAuthenticationNameSources, azuresdkhacks.AlternativeAuthenticationNameSource(v))
+	}
+
+	dynamic := make([]azuresdkhacks.DynamicRoutingEnrichment, 0)
+	for _, v := range input.DynamicRoutingEnrichments {
+		dynamic = append(dynamic, azuresdkhacks.DynamicRoutingEnrichment{
+			Key:   pointer.To(v.Key),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	static := make([]azuresdkhacks.StaticRoutingEnrichment, 0)
+	for _, v := range input.StaticRoutingEnrichments {
+		static = append(static, azuresdkhacks.StaticRoutingEnrichment{
+			Key:       pointer.To(v.Key),
+			Value:     pointer.To(v.Value),
+			ValueType: azuresdkhacks.StaticRoutingEnrichmentTypeString,
+		})
+	}
+
+	output := &azuresdkhacks.TopicSpacesConfiguration{
+		ClientAuthentication: &azuresdkhacks.ClientAuthenticationSettings{
+			AlternativeAuthenticationNameSources: &alternativeAuthenticationNameSources,
+		},
+		MaximumClientSessionsPerAuthenticationName: pointer.To(input.MaximumClientSessionsPerAuthenticationName),
+		MaximumSessionExpiryInHours:                pointer.To(input.MaximumSessionExpiryInHours),
+		RoutingEnrichments: &azuresdkhacks.RoutingEnrichments{
+			Dynamic: &dynamic,
+			Static:  &static,
+		},
+		State: pointer.To(azuresdkhacks.TopicSpacesConfigurationStateEnabled),
+	}
+
+	if input.RouteTopicId != "" {
+		output.RouteTopicResourceId = pointer.To(input.RouteTopicId)
+	}
+
+	return output
+}
+
+func flattenEventGridNamespaceTopicSpacesConfiguration(input *azuresdkhacks.TopicSpacesConfiguration) []EventGridNamespaceTopicSpacesConfigModel {
+	if input == nil || pointer.From(input.State) != azuresdkhacks.TopicSpacesConfigurationStateEnabled {
+		return []EventGridNamespaceTopicSpacesConfigModel{}
+	}
+
+	output := EventGridNamespaceTopicSpacesConfigModel{
+		MaximumClientSessionsPerAuthenticationName: pointer.From(input.MaximumClientSessionsPerAuthenticationName),
+		MaximumSessionExpiryInHours:                pointer.From(input.MaximumSessionExpiryInHours),
+		DynamicRoutingEnrichments:                  make([]EventGridNamespaceRoutingEnrichmentModel, 0),
+		StaticRoutingEnrichments:                   make([]EventGridNamespaceRoutingEnrichmentModel, 0),
+	}
+
+	if v := pointer.From(input.RouteTopicResourceId); v != "" {
+		// the API returns the ID of a Namespace Topic with inconsistent casing
+		if topicId, err := azuresdkhacks.ParseNamespaceTopicIDInsensitively(v); err == nil {
+			v = topicId.ID()
+		}
+		output.RouteTopicId = v
+	}
+
+	if auth := input.ClientAuthentication; auth != nil && auth.AlternativeAuthenticationNameSources != nil {
+		for _, v := range *auth.AlternativeAuthenticationNameSources {
+			output.AlternativeAuthenticationNameSources = append(output.AlternativeAuthenticationNameSources, string(v))
+		}
+	}
+
+	if enrichments := input.RoutingEnrichments; enrichments != nil {
+		for _, v := range pointer.From(enrichments.Dynamic) {
+			output.DynamicRoutingEnrichments = append(output.DynamicRoutingEnrichments, EventGridNamespaceRoutingEnrichmentModel{
+				Key:   pointer.From(v.Key),
+				Value: pointer.From(v.Value),
+			})
+		}
+		for _, v := range pointer.From(enrichments.Static) {
+			output.StaticRoutingEnrichments = append(output.StaticRoutingEnrichments, EventGridNamespaceRoutingEnrichmentModel{
+				Key:   pointer.From(v.Key),
+				Value: pointer.From(v.Value),
+			})
+		}
+	}
+
+	return []EventGridNamespaceTopicSpacesConfigModel{output}
+}
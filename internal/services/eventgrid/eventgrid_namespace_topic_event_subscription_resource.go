@@ -0,0 +1,532 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	serviceBusQueues "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/queues"
+	serviceBusTopics "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/topics"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceTopicEventSubscriptionResource struct{}
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceTopicEventSubscriptionResource{}
+
+type EventGridNamespaceTopicEventSubscriptionModel struct {
+	Name                string                                `tfschema:"name"`
+	NamespaceTopicId    string                                `tfschema:"namespace_topic_id"`
+	EventDeliverySchema string                                `tfschema:"event_delivery_schema"`
+	IncludedEventTypes  []string                              `tfschema:"included_event_types"`
+	PullDelivery        []EventGridNamespacePullDeliveryModel `tfschema:"pull_delivery"`
+	PushDelivery        []EventGridNamespacePushDeliveryModel `tfschema:"push_delivery"`
+}
+
+type EventGridNamespacePullDeliveryModel struct {
+	EventTimeToLive       string `tfschema:"event_time_to_live"`
+	LockDurationInSeconds int64  `tfschema:"lock_duration_in_seconds"`
+	MaxDeliveryCount      int64  `tfschema:"max_delivery_count"`
+}
+
+type EventGridNamespacePushDeliveryModel struct {
+	EventHubId        string                                    `tfschema:"event_hub_id"`
+	ServiceBusQueueId string                                    `tfschema:"service_bus_queue_id"`
+	ServiceBusTopicId string                                    `tfschema:"service_bus_topic_id"`
+	WebhookUrl        string                                    `tfschema:"webhook_url"`
+	WebhookBaseUrl    string                                    `tfschema:"webhook_base_url"`
+	DeliveryIdentity  []EventGridNamespaceDeliveryIdentityModel `tfschema:"delivery_identity"`
+	EventTimeToLive   string                                    `tfschema:"event_time_to_live"`
+	MaxDeliveryCount  int64                                     `tfschema:"max_delivery_count"`
+}
+
+type EventGridNamespaceDeliveryIdentityModel struct {
+	Type                   string `tfschema:"type"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	pushDestinations := []string{
+		"push_delivery.0.event_hub_id",
+		"push_delivery.0.service_bus_queue_id",
+		"push_delivery.0.service_bus_topic_id",
+		"push_delivery.0.webhook_url",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace Topic Event Subscription name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"namespace_topic_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azuresdkhacks.ValidateNamespaceTopicID,
+		},
+
+		"event_delivery_schema": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(azuresdkhacks.DeliverySchemaCloudEventSchemaVOneZero),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForDeliverySchema(), false),
+		},
+
+		"included_event_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		// the Event Subscription is either pulled from (Queue) or pushed to a destination (Push)
+		"pull_delivery": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"pull_delivery", "push_delivery"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+
+					"lock_duration_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      60,
+						ValidateFunc: validation.IntBetween(60, 300),
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+				},
+			},
+		},
+
+		"push_delivery": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"pull_delivery", "push_delivery"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"event_hub_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ExactlyOneOf: pushDestinations,
+						ValidateFunc: eventhubs.ValidateEventhubID,
+					},
+
+					"service_bus_queue_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ExactlyOneOf: pushDestinations,
+						ValidateFunc: serviceBusQueues.ValidateQueueID,
+					},
+
+					"service_bus_topic_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ExactlyOneOf: pushDestinations,
+						ValidateFunc: serviceBusTopics.ValidateTopicID,
+					},
+
+					"webhook_url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ExactlyOneOf: pushDestinations,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+
+					"delivery_identity": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForEventSubscriptionIdentityType(), false),
+								},
+
+								"user_assigned_identity_id": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+								},
+							},
+						},
+					},
+
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+
+					"webhook_base_url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ModelObject() interface{} {
+	return &EventGridNamespaceTopicEventSubscriptionModel{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_topic_event_subscription"
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateNamespaceTopicEventSubscriptionID
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceTopicEventSubscriptionsClient
+
+			var model EventGridNamespaceTopicEventSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			topicId, err := azuresdkhacks.ParseNamespaceTopicID(model.NamespaceTopicId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewNamespaceTopicEventSubscriptionID(topicId.SubscriptionId, topicId.ResourceGroupName, topicId.NamespaceName, topicId.TopicName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandEventGridNamespaceTopicEventSubscription(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceTopicEventSubscriptionsClient
+
+			id, err := azuresdkhacks.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the webhook URL isn't returned by the API since it may contain secrets
+			var config EventGridNamespaceTopicEventSubscriptionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			webhookUrl := ""
+			if len(config.PushDelivery) > 0 {
+				webhookUrl = config.PushDelivery[0].WebhookUrl
+			}
+
+			state := EventGridNamespaceTopicEventSubscriptionModel{
+				Name:             id.EventSubscriptionName,
+				NamespaceTopicId: azuresdkhacks.NewNamespaceTopicID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EventDeliverySchema = string(pointer.From(props.EventDeliverySchema))
+
+					if filters := props.FiltersConfiguration; filters != nil {
+						state.IncludedEventTypes = pointer.From(filters.IncludedEventTypes)
+					}
+
+					if delivery := props.DeliveryConfiguration; delivery != nil {
+						state.PullDelivery = flattenEventGridNamespacePullDelivery(delivery.Queue)
+						state.PushDelivery = flattenEventGridNamespacePushDelivery(delivery.Push, webhookUrl)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceTopicEventSubscriptionsClient
+
+			id, err := azuresdkhacks.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceTopicEventSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload, err := expandEventGridNamespaceTopicEventSubscription(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceTopicEventSubscriptionsClient
+
+			id, err := azuresdkhacks.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceTopicEventSubscription(input EventGridNamespaceTopicEventSubscriptionModel) (*azuresdkhacks.NamespaceTopicEventSubscription, error) {
+	delivery := &azuresdkhacks.DeliveryConfiguration{}
+	if len(input.PullDelivery) > 0 {
+		pull := input.PullDelivery[0]
+		delivery.DeliveryMode = pointer.To(azuresdkhacks.DeliveryModeQueue)
+		delivery.Queue = &azuresdkhacks.QueueInfo{
+			MaxDeliveryCount:             pointer.To(pull.MaxDeliveryCount),
+			ReceiveLockDurationInSeconds: pointer.To(pull.LockDurationInSeconds),
+		}
+		if pull.EventTimeToLive != "" {
+			delivery.Queue.EventTimeToLive = pointer.To(pull.EventTimeToLive)
+		}
+	}
+
+	if len(input.PushDelivery) > 0 {
+		push, err := expandEventGridNamespacePushDelivery(input.PushDelivery[0])
+		if err != nil {
+			return nil, err
+		}
+		delivery.DeliveryMode = pointer.To(azuresdkhacks.DeliveryModePush)
+		delivery.Push = push
+	}
+
+	return &azuresdkhacks.NamespaceTopicEventSubscription{
+		Properties: &azuresdkhacks.NamespaceTopicEventSubscriptionProperties{
+			DeliveryConfiguration: delivery,
+			EventDeliverySchema:   pointer.To(azuresdkhacks.DeliverySchema(input.EventDeliverySchema)),
+			FiltersConfiguration: &azuresdkhacks.FiltersConfiguration{
+				IncludedEventTypes: pointer.To(input.IncludedEventTypes),
+			},
+		},
+	}, nil
+}
+
+func expandEventGridNamespacePushDelivery(input EventGridNamespacePushDeliveryModel) (*azuresdkhacks.PushInfo, error) {
+	destination := &azuresdkhacks.EventSubscriptionDestination{
+		Properties: &azuresdkhacks.EventSubscriptionDestinationProperties{},
+	}
+	switch {
+	case input.EventHubId != "":
+		destination.EndpointType = azuresdkhacks.EndpointTypeEventHub
+		destination.Properties.ResourceId = pointer.To(input.EventHubId)
+	case input.ServiceBusQueueId != "":
+		destination.EndpointType = azuresdkhacks.EndpointTypeServiceBusQueue
+		destination.Properties.ResourceId = pointer.To(input.ServiceBusQueueId)
+	case input.ServiceBusTopicId != "":
+		destination.EndpointType = azuresdkhacks.EndpointTypeServiceBusTopic
+		destination.Properties.ResourceId = pointer.To(input.ServiceBusTopicId)
+	case input.WebhookUrl != "":
+		destination.EndpointType = azuresdkhacks.EndpointTypeWebHook
+		destination.Properties.EndpointUrl = pointer.To(input.WebhookUrl)
+	}
+
+	output := &azuresdkhacks.PushInfo{
+		MaxDeliveryCount: pointer.To(input.MaxDeliveryCount),
+	}
+	if input.EventTimeToLive != "" {
+		output.EventTimeToLive = pointer.To(input.EventTimeToLive)
+	}
+
+	if len(input.DeliveryIdentity) == 0 {
+		output.Destination = destination
+		return output, nil
+	}
+
+	deliveryIdentity := input.DeliveryIdentity[0]
+	identityType := azuresdkhacks.EventSubscriptionIdentityType(deliveryIdentity.Type)
+	if identityType == azuresdkhacks.EventSubscriptionIdentityTypeUserAssigned && deliveryIdentity.UserAssignedIdentityId == "" {
+		return nil, fmt.Errorf("`user_assigned_identity_id` must be specified when `type` is `%s` within the `delivery_identity` block", azuresdkhacks.EventSubscriptionIdentityTypeUserAssigned)
+	}
+	if identityType == azuresdkhacks.EventSubscriptionIdentityTypeSystemAssigned && deliveryIdentity.UserAssignedIdentityId != "" {
+		return nil, fmt.Errorf("`user_assigned_identity_id` cannot be specified when `type` is `%s` within the `delivery_identity` block", azuresdkhacks.EventSubscriptionIdentityTypeSystemAssigned)
+	}
+
+	eventSubscriptionIdentity := &azuresdkhacks.EventSubscriptionIdentity{
+		Type: pointer.To(identityType),
+	}
+	if deliveryIdentity.UserAssignedIdentityId != "" {
+		eventSubscriptionIdentity.UserAssignedIdentity = pointer.To(deliveryIdentity.UserAssignedIdentityId)
+	}
+
+	output.DeliveryWithResourceIdentity = &azuresdkhacks.DeliveryWithResourceIdentity{
+		Destination: destination,
+		Identity:    eventSubscriptionIdentity,
+	}
+
+	return output, nil
+}
+
+func flattenEventGridNamespacePullDelivery(input *azuresdkhacks.QueueInfo) []EventGridNamespacePullDeliveryModel {
+	if input == nil {
+		return []EventGridNamespacePullDeliveryModel{}
+	}
+
+	return []EventGridNamespacePullDeliveryModel{
+		{
+			EventTimeToLive:       pointer.From(input.EventTimeToLive),
+			LockDurationInSeconds: pointer.From(input.ReceiveLockDurationInSeconds),
+			MaxDeliveryCount:      pointer.From(input.MaxDeliveryCount),
+		},
+	}
+}
+
+func flattenEventGridNamespacePushDelivery(input *azuresdkhacks.PushInfo, webhookUrl string) []EventGridNamespacePushDeliveryModel {
+	if input == nil {
+		return []EventGridNamespacePushDeliveryModel{}
+	}
+
+	output := EventGridNamespacePushDeliveryModel{
+		DeliveryIdentity: []EventGridNamespaceDeliveryIdentityModel{},
+		EventTimeToLive:  pointer.From(input.EventTimeToLive),
+		MaxDeliveryCount: pointer.From(input.MaxDeliveryCount),
+	}
+
+	destination := input.Destination
+	if withIdentity := input.DeliveryWithResourceIdentity; withIdentity != nil {
+		destination = withIdentity.Destination
+
+		if v := withIdentity.Identity; v != nil {
+			output.DeliveryIdentity = []EventGridNamespaceDeliveryIdentityModel{
+				{
+					Type:                   string(pointer.From(v.Type)),
+					UserAssignedIdentityId: pointer.From(v.UserAssignedIdentity),
+				},
+			}
+		}
+	}
+
+	if destination != nil && destination.Properties != nil {
+		resourceId := pointer.From(destination.Properties.ResourceId)
+		switch destination.EndpointType {
+		case azuresdkhacks.EndpointTypeEventHub:
+			if parsed, err := eventhubs.ParseEventhubIDInsensitively(resourceId); err == nil {
+				resourceId = parsed.ID()
+			}
+			output.EventHubId = resourceId
+		case azuresdkhacks.EndpointTypeServiceBusQueue:
+			if parsed, err := serviceBusQueues.ParseQueueIDInsensitively(resourceId); err == nil {
+				resourceId = parsed.ID()
+			}
+			output.ServiceBusQueueId = resourceId
+		case azuresdkhacks.EndpointTypeServiceBusTopic:
+			if parsed, err := serviceBusTopics.ParseTopicIDInsensitively(resourceId); err == nil {
+				resourceId = parsed.ID()
+			}
+			output.ServiceBusTopicId = resourceId
+		case azuresdkhacks.EndpointTypeWebHook:
+			output.WebhookUrl = webhookUrl
+			output.WebhookBaseUrl = pointer.From(destination.Properties.EndpointBaseUrl)
+		}
+	}
+
+	return []EventGridNamespacePushDeliveryModel{output}
+}
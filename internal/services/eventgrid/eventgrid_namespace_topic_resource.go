@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceTopicResource struct{}
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceTopicResource{}
+
+type EventGridNamespaceTopicModel struct {
+	Name                 string `tfschema:"name"`
+	NamespaceId          string `tfschema:"namespace_id"`
+	EventRetentionInDays int64  `tfschema:"event_retention_in_days"`
+	InputSchema          string `tfschema:"input_schema"`
+	PublisherType        string `tfschema:"publisher_type"`
+}
+
+func (r EventGridNamespaceTopicResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace Topic name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azuresdkhacks.ValidateNamespaceID,
+		},
+
+		"event_retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      7,
+			ValidateFunc: validation.IntBetween(1, 7),
+		},
+
+		"input_schema": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(azuresdkhacks.EventInputSchemaCloudEventSchemaVOneZero),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForEventInputSchema(), false),
+		},
+
+		"publisher_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(azuresdkhacks.PublisherTypeCustom),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForPublisherType(), false),
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceTopicResource) ModelObject() interface{} {
+	return &EventGridNamespaceTopicModel{}
+}
+
+func (r EventGridNamespaceTopicResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_topic"
+}
+
+func (r EventGridNamespaceTopicResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateNamespaceTopicID
+}
+
+func (r EventGridNamespaceTopicResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceTopicsClient
+
+			var model EventGridNamespaceTopicModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := azuresdkhacks.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewNamespaceTopicID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandEventGridNamespaceTopic(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceTopicsClient
+
+			id, err := azuresdkhacks.ParseNamespaceTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceTopicModel{
+				Name:        id.TopicName,
+				NamespaceId: azuresdkhacks.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EventRetentionInDays = pointer.From(props.EventRetentionInDays)
+					state.InputSchema = string(pointer.From(props.InputSchema))
+					state.PublisherType = string(pointer.From(props.PublisherType))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceTopicsClient
+
+			id, err := azuresdkhacks.ParseNamespaceTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceTopicModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandEventGridNamespaceTopic(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGridNamespaces.NamespaceTopicsClient
+
+			id, err := azuresdkhacks.ParseNamespaceTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceTopic(input EventGridNamespaceTopicModel) azuresdkhacks.NamespaceTopic {
+	return azuresdkhacks.NamespaceTopic{
+		Properties: &azuresdkhacks.NamespaceTopicProperties{
+			EventRetentionInDays: pointer.To(input.EventRetentionInDays),
+			InputSchema:          pointer.To(azuresdkhacks.EventInputSchema(input.InputSchema)),
+			PublisherType:        pointer.To(azuresdkhacks.PublisherType(input.PublisherType)),
+		},
+	}
+}
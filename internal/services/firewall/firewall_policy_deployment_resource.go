@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/firewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var (
+	_ sdk.Resource           = FirewallPolicyDeploymentResource{}
+	_ sdk.ResourceWithUpdate = FirewallPolicyDeploymentResource{}
+)
+
+type FirewallPolicyDeploymentResource struct{}
+
+type FirewallPolicyDeploymentResourceModel struct {
+	FirewallPolicyId string            `tfschema:"firewall_policy_id"`
+	Triggers         map[string]string `tfschema:"triggers"`
+}
+
+func (r FirewallPolicyDeploymentResource) ResourceType() string {
+	return "azurerm_firewall_policy_deployment"
+}
+
+func (r FirewallPolicyDeploymentResource) ModelObject() interface{} {
+	return &FirewallPolicyDeploymentResourceModel{}
+}
+
+func (r FirewallPolicyDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return firewallpolicies.ValidateFirewallPolicyID
+}
+
+func (r FirewallPolicyDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: firewallpolicies.ValidateFirewallPolicyID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r FirewallPolicyDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FirewallPolicyDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config FirewallPolicyDeploymentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := firewallpolicies.ParseFirewallPolicyID(config.FirewallPolicyId)
+			if err != nil {
+				return err
+			}
+
+			if err := deployFirewallPolicyDraft(ctx, metadata, *id); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r FirewallPolicyDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.FirewallPolicies
+
+			id, err := firewallpolicies.ParseFirewallPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, firewallpolicies.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var state FirewallPolicyDeploymentResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.FirewallPolicyId = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r FirewallPolicyDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := firewallpolicies.ParseFirewallPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the Draft is only deployed when `triggers` changes (or when this resource is created), it's up to the user
+			// to reference the Draft Rule Collection Groups in `triggers` so that their changes get deployed
+			if metadata.ResourceData.HasChange("triggers") {
+				if err := deployFirewallPolicyDraft(ctx, metadata, *id); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FirewallPolicyDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// deployed changes can't be rolled back, so there's nothing to do here other than removing it from the state
+			return nil
+		},
+	}
+}
+
+// deployFirewallPolicyDraft deploys any pending Draft of the Firewall Policy, which applies the Drafts of the
+// Firewall Policy and all of its Rule Collection Groups in a single operation
+func deployFirewallPolicyDraft(ctx context.Context, metadata sdk.ResourceMetaData, id firewallpolicies.FirewallPolicyId) error {
+	client := metadata.Client.Network.FirewallPolicyDraftsClient
+
+	locks.ByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+
+	existing, err := client.GetPolicyDraft(ctx, id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			metadata.Logger.Infof("no Draft of %s exists, nothing to deploy", id)
+			return nil
+		}
+		return fmt.Errorf("retrieving Draft of %s: %+v", id, err)
+	}
+
+	if err := client.DeployThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deploying Draft of %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/firewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FirewallPolicyDeploymentResource struct{}
+
+func TestAccFirewallPolicyDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_deployment", "test")
+	r := FirewallPolicyDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: FirewallPolicyRuleCollectionGroupResource{}.draftMode(data, 500),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: FirewallPolicyRuleCollectionGroupResource{}.draftMode(data, 600),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+// Exists checks the Firewall Policy exists and that there's no pending Draft, since the Draft is removed once deployed
+func (FirewallPolicyDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := firewallpolicies.ParseFirewallPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.FirewallPolicies.Get(ctx, *id, firewallpolicies.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	draft, err := clients.Network.FirewallPolicyDraftsClient.GetPolicyDraft(ctx, *id)
+	if err != nil && !response.WasNotFound(draft.HttpResponse) {
+		return nil, fmt.Errorf("retrieving Draft of %s: %+v", *id, err)
+	}
+	if draft.Model != nil {
+		return nil, fmt.Errorf("expected the Draft of %s to have been deployed but it still exists", *id)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
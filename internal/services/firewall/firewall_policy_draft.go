@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/firewallpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
+)
+
+// ensureFirewallPolicyDraft creates a Draft of the Firewall Policy (based on the live Firewall Policy) when one
+// doesn't already exist, since the Rule Collection Group Drafts can only be created within a Firewall Policy Draft.
+// Deploying the Draft replaces the Rule Collection Groups of the Firewall Policy with those in the Draft, so each of
+// the existing Rule Collection Groups is copied into the Draft to avoid removing them when it's deployed.
+func ensureFirewallPolicyDraft(ctx context.Context, client *clients.Client, id firewallpolicies.FirewallPolicyId) error {
+	draftsClient := client.Network.FirewallPolicyDraftsClient
+
+	existing, err := draftsClient.GetPolicyDraft(ctx, id)
+	if err != nil && !response.WasNotFound(existing.HttpResponse) {
+		return fmt.Errorf("checking for an existing Draft of %s: %+v", id, err)
+	}
+	if existing.Model != nil {
+		return nil
+	}
+
+	policy, err := client.Network.FirewallPolicies.Get(ctx, id, firewallpolicies.DefaultGetOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if policy.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+
+	draft := azuresdkhacks.FirewallPolicyDraft{
+		Location:   policy.Model.Location,
+		Tags:       policy.Model.Tags,
+		Properties: &azuresdkhacks.FirewallPolicyDraftProperties{},
+	}
+	if props := policy.Model.Properties; props != nil {
+		draft.Properties.BasePolicy = props.BasePolicy
+		draft.Properties.DnsSettings = props.DnsSettings
+		draft.Properties.ExplicitProxy = props.ExplicitProxy
+		draft.Properties.Insights = props.Insights
+		draft.Properties.IntrusionDetection = props.IntrusionDetection
+		draft.Properties.Snat = props.Snat
+		draft.Properties.Sql = props.Sql
+		draft.Properties.ThreatIntelMode = props.ThreatIntelMode
+		draft.Properties.ThreatIntelWhitelist = props.ThreatIntelWhitelist
+	}
+
+	log.Printf("[DEBUG] Creating a Draft of %s", id)
+	if err := draftsClient.CreateOrUpdatePolicyDraft(ctx, id, draft); err != nil {
+		return fmt.Errorf("creating a Draft of %s: %+v", id, err)
+	}
+
+	policyId := firewallpolicyrulecollectiongroups.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroupName, id.FirewallPolicyName)
+	ruleCollectionGroups, err := client.Network.FirewallPolicyRuleCollectionGroups.ListComplete(ctx, policyId)
+	if err != nil {
+		return fmt.Errorf("listing the Rule Collection Groups for %s: %+v", id, err)
+	}
+	for _, item := range ruleCollectionGroups.Items {
+		if item.Name == nil {
+			continue
+		}
+
+		ruleCollectionGroupId := firewallpolicyrulecollectiongroups.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroupName, id.FirewallPolicyName, *item.Name)
+		log.Printf("[DEBUG] Copying %s into the Draft", ruleCollectionGroupId)
+		ruleCollectionGroupDraft := azuresdkhacks.FirewallPolicyRuleCollectionGroupDraft{
+			Properties: item.Properties,
+		}
+		if err := draftsClient.CreateOrUpdateRuleCollectionGroupDraft(ctx, ruleCollectionGroupId, ruleCollectionGroupDraft); err != nil {
+			return fmt.Errorf("copying %s into the Draft: %+v", ruleCollectionGroupId, err)
+		}
+	}
+
+	return nil
+}
+
+// syncFirewallPolicyRuleCollectionGroupDraft updates the copy of a Rule Collection Group (which isn't using Draft Mode)
+// within the Draft of the Firewall Policy when one exists, so that deploying the Draft doesn't revert the changes made
+// to the live Rule Collection Group. When `input` is nil the copy is removed from the Draft.
+func syncFirewallPolicyRuleCollectionGroupDraft(ctx context.Context, client *clients.Client, id firewallpolicyrulecollectiongroups.RuleCollectionGroupId, input *firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollectionGroupProperties) error {
+	draftsClient := client.Network.FirewallPolicyDraftsClient
+
+	policyId := firewallpolicies.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroupName, id.FirewallPolicyName)
+	existing, err := draftsClient.GetPolicyDraft(ctx, policyId)
+	if err != nil && !response.WasNotFound(existing.HttpResponse) {
+		return fmt.Errorf("checking for an existing Draft of %s: %+v", policyId, err)
+	}
+	if existing.Model == nil {
+		return nil
+	}
+
+	if input == nil {
+		draftResp, err := draftsClient.GetRuleCollectionGroupDraft(ctx, id)
+		if err != nil && !response.WasNotFound(draftResp.HttpResponse) {
+			return fmt.Errorf("retrieving Draft of %s: %+v", id, err)
+		}
+		if draftResp.Model == nil {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Removing %s from the Draft", id)
+		if err := draftsClient.DeleteRuleCollectionGroupDraft(ctx, id); err != nil {
+			return fmt.Errorf("removing %s from the Draft: %+v", id, err)
+		}
+		return nil
+	}
+
+	log.Printf("[DEBUG] Updating the copy of %s within the Draft", id)
+	draft := azuresdkhacks.FirewallPolicyRuleCollectionGroupDraft{
+		Properties: input,
+	}
+	if err := draftsClient.CreateOrUpdateRuleCollectionGroupDraft(ctx, id, draft); err != nil {
+		return fmt.Errorf("updating the copy of %s within the Draft: %+v", id, err)
+	}
+
+	return nil
+}
+
+// firewallPolicyRuleCollectionGroupHash returns a hash of the priority and Rule Collections of a Rule Collection Group,
+// which is used to expose when a change has been staged in (or deployed from) the Draft
+func firewallPolicyRuleCollectionGroupHash(input *firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollectionGroupProperties) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	application, network, nat, err := flattenFirewallPolicyRuleCollection(input.RuleCollections)
+	if err != nil {
+		return "", fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
+	}
+
+	b, err := json.Marshal([]interface{}{pointer.From(input.Priority), application, network, nat})
+	if err != nil {
+		return "", fmt.Errorf("marshaling Firewall Policy Rule Collections: %+v", err)
+	}
+
+	return fmt.Sprintf("%x", sha1.Sum(b)), nil
+}
+
+// firewallPolicyRuleCollectionGroupDraftDiffers returns whether the Draft of a Rule Collection Group contains changes
+// which haven't been deployed to the live Rule Collection Group yet
+func firewallPolicyRuleCollectionGroupDraftDiffers(live, draft *firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollectionGroupProperties) (bool, error) {
+	if live == nil || draft == nil {
+		return live != draft, nil
+	}
+
+	if pointer.From(live.Priority) != pointer.From(draft.Priority) {
+		return true, nil
+	}
+
+	liveApplication, liveNetwork, liveNat, err := flattenFirewallPolicyRuleCollection(live.RuleCollections)
+	if err != nil {
+		return false, fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
+	}
+	draftApplication, draftNetwork, draftNat, err := flattenFirewallPolicyRuleCollection(draft.RuleCollections)
+	if err != nil {
+		return false, fmt.Errorf("flattening Firewall Policy Rule Collections of the Draft: %+v", err)
+	}
+
+	return !reflect.DeepEqual(liveApplication, draftApplication) || !reflect.DeepEqual(liveNetwork, draftNetwork) || !reflect.DeepEqual(liveNat, draftNat), nil
+}
@@ -4,6 +4,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -36,6 +38,20 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			// `draft_hash` is referenced in the `triggers` of `azurerm_firewall_policy_deployment`, so must be unknown
+			// whenever a change is going to be staged so that the Draft is deployed afterwards
+			if diff.HasChanges("priority", "application_rule_collection", "network_rule_collection", "nat_rule_collection", "draft_mode_enabled") {
+				if err := diff.SetNewComputed("draft_hash"); err != nil {
+					return err
+				}
+				if diff.Get("draft_mode_enabled").(bool) {
+					return diff.SetNewComputed("draft_pending_deployment")
+				}
+			}
+			return nil
+		}),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -64,6 +80,22 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(100, 65000),
 			},
 
+			"draft_mode_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"draft_pending_deployment": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"draft_hash": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"application_rule_collection": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -456,6 +488,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 
 func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.FirewallPolicyRuleCollectionGroups
+	draftsClient := meta.(*clients.Client).Network.FirewallPolicyDraftsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -466,6 +499,8 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 
 	id := firewallpolicyrulecollectiongroups.NewRuleCollectionGroupID(policyId.SubscriptionId, policyId.ResourceGroupName, policyId.FirewallPolicyName, d.Get("name").(string))
 
+	draftModeEnabled := d.Get("draft_mode_enabled").(bool)
+
 	if d.IsNewResource() {
 		resp, err := client.Get(ctx, id)
 		if err != nil {
@@ -477,6 +512,19 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		if resp.Model != nil {
 			return tf.ImportAsExistsError("azurerm_firewall_policy_rule_collection_group", id.ID())
 		}
+
+		if draftModeEnabled {
+			draftResp, err := draftsClient.GetRuleCollectionGroupDraft(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(draftResp.HttpResponse) {
+					return fmt.Errorf("checking for an existing Draft of %s: %+v", id, err)
+				}
+			}
+
+			if draftResp.Model != nil {
+				return tf.ImportAsExistsError("azurerm_firewall_policy_rule_collection_group", id.ID())
+			}
+		}
 	}
 
 	locks.ByName(policyId.FirewallPolicyName, AzureFirewallPolicyResourceName)
@@ -499,10 +547,31 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 
 	param.Properties.RuleCollections = &rulesCollections
 
+	if draftModeEnabled {
+		// changes are made to the Draft and only applied once it's deployed (`azurerm_firewall_policy_deployment`)
+		if err = ensureFirewallPolicyDraft(ctx, meta.(*clients.Client), *policyId); err != nil {
+			return err
+		}
+
+		draft := azuresdkhacks.FirewallPolicyRuleCollectionGroupDraft{
+			Properties: param.Properties,
+		}
+		if err = draftsClient.CreateOrUpdateRuleCollectionGroupDraft(ctx, id, draft); err != nil {
+			return fmt.Errorf("creating a Draft of %s: %+v", id, err)
+		}
+
+		d.SetId(id.ID())
+		return resourceFirewallPolicyRuleCollectionGroupRead(d, meta)
+	}
+
 	if err = client.CreateOrUpdateThenPoll(ctx, id, param); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
+	if err = syncFirewallPolicyRuleCollectionGroupDraft(ctx, meta.(*clients.Client), id, param.Properties); err != nil {
+		return err
+	}
+
 	d.SetId(id.ID())
 
 	return resourceFirewallPolicyRuleCollectionGroupRead(d, meta)
@@ -511,6 +580,7 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).Network.FirewallPolicyRuleCollectionGroups
+	draftsClient := meta.(*clients.Client).Network.FirewallPolicyDraftsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -519,38 +589,76 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 		return err
 	}
 
+	draftModeEnabled := d.Get("draft_mode_enabled").(bool)
+
 	resp, err := client.Get(ctx, *id)
-	if err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
-			log.Printf("[DEBUG] %s was not found- removing from state!", id)
-			d.SetId("")
-			return nil
+	if err != nil && !response.WasNotFound(resp.HttpResponse) {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	draftExists := false
+	var draft *firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollectionGroupProperties
+	if draftModeEnabled {
+		draftResp, err := draftsClient.GetRuleCollectionGroupDraft(ctx, *id)
+		if err != nil && !response.WasNotFound(draftResp.HttpResponse) {
+			return fmt.Errorf("retrieving Draft of %s: %+v", id, err)
 		}
+		if model := draftResp.Model; model != nil {
+			draftExists = true
+			draft = model.Properties
+		}
+	}
 
-		return fmt.Errorf("retrieving %s: %+v", id, err)
+	if resp.Model == nil && !draftExists {
+		log.Printf("[DEBUG] %s was not found- removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	var live *firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollectionGroupProperties
+	if resp.Model != nil {
+		live = resp.Model.Properties
+	}
+
+	// the Draft contains the staged changes (including a Rule Collection Group which hasn't been deployed yet), which
+	// are read into the state so that these aren't shown as a diff until the Draft is deployed
+	props := live
+	draftPendingDeployment := false
+	if draftExists {
+		props = draft
+		if draftPendingDeployment, err = firewallPolicyRuleCollectionGroupDraftDiffers(live, draft); err != nil {
+			return fmt.Errorf("comparing Draft of %s: %+v", id, err)
+		}
+	}
+
+	// once deployed the Draft is removed, so the hash of the live Rule Collection Group matches the hash of the Draft
+	draftHash, err := firewallPolicyRuleCollectionGroupHash(props)
+	if err != nil {
+		return fmt.Errorf("hashing %s: %+v", id, err)
 	}
 
 	d.Set("name", id.RuleCollectionGroupName)
 	d.Set("firewall_policy_id", firewallpolicies.NewFirewallPolicyID(subscriptionId, id.ResourceGroupName, id.FirewallPolicyName).ID())
+	d.Set("draft_mode_enabled", draftModeEnabled)
+	d.Set("draft_pending_deployment", draftPendingDeployment)
+	d.Set("draft_hash", draftHash)
 
-	if model := resp.Model; model != nil {
-		if props := model.Properties; props != nil {
-			d.Set("priority", props.Priority)
+	if props != nil {
+		d.Set("priority", props.Priority)
 
-			applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(props.RuleCollections)
-			if err != nil {
-				return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
-			}
+		applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(props.RuleCollections)
+		if err != nil {
+			return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
+		}
 
-			if err := d.Set("application_rule_collection", applicationRuleCollections); err != nil {
-				return fmt.Errorf("setting `application_rule_collection`: %+v", err)
-			}
-			if err := d.Set("network_rule_collection", networkRuleCollections); err != nil {
-				return fmt.Errorf("setting `network_rule_collection`: %+v", err)
-			}
-			if err := d.Set("nat_rule_collection", natRuleCollections); err != nil {
-				return fmt.Errorf("setting `nat_rule_collection`: %+v", err)
-			}
+		if err := d.Set("application_rule_collection", applicationRuleCollections); err != nil {
+			return fmt.Errorf("setting `application_rule_collection`: %+v", err)
+		}
+		if err := d.Set("network_rule_collection", networkRuleCollections); err != nil {
+			return fmt.Errorf("setting `network_rule_collection`: %+v", err)
+		}
+		if err := d.Set("nat_rule_collection", natRuleCollections); err != nil {
+			return fmt.Errorf("setting `nat_rule_collection`: %+v", err)
 		}
 	}
 
@@ -570,10 +678,32 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 	locks.ByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
 	defer locks.UnlockByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
 
+	if d.Get("draft_mode_enabled").(bool) {
+		// the deletion is staged in the Draft by removing the Rule Collection Group from it, the live Rule Collection
+		// Group is then removed from the Firewall Policy once the Draft is deployed
+		if err = ensureFirewallPolicyDraft(ctx, meta.(*clients.Client), firewallpolicies.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroupName, id.FirewallPolicyName)); err != nil {
+			return err
+		}
+
+		draftsClient := meta.(*clients.Client).Network.FirewallPolicyDraftsClient
+		draftResp, err := draftsClient.GetRuleCollectionGroupDraft(ctx, *id)
+		if err != nil && !response.WasNotFound(draftResp.HttpResponse) {
+			return fmt.Errorf("retrieving Draft of %s: %+v", id, err)
+		}
+		if draftResp.Model != nil {
+			if err := draftsClient.DeleteRuleCollectionGroupDraft(ctx, *id); err != nil {
+				return fmt.Errorf("deleting Draft of %s: %+v", id, err)
+			}
+		}
+
+		return nil
+	}
+
 	if err = client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
-	return nil
+
+	return syncFirewallPolicyRuleCollectionGroupDraft(ctx, meta.(*clients.Client), *id, nil)
 }
 
 func expandFirewallPolicyRuleCollectionApplication(input []interface{}) []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection {
@@ -136,6 +136,29 @@ func TestAccFirewallPolicyRuleCollectionGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_draftMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.draftMode(data, 500),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("draft_mode_enabled", "draft_pending_deployment"),
+		{
+			Config: r.draftMode(data, 600),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("priority").HasValue("600"),
+			),
+		},
+		data.ImportStep("draft_mode_enabled", "draft_pending_deployment"),
+	})
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := firewallpolicyrulecollectiongroups.ParseRuleCollectionGroupID(state.ID)
 	if err != nil {
@@ -904,3 +927,50 @@ resource "azurerm_firewall_policy_rule_collection_group" "import" {
 }
 `, template)
 }
+
+func (FirewallPolicyRuleCollectionGroupResource) draftMode(data acceptance.TestData, priority int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = %[3]d
+  draft_mode_enabled = true
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP", "UDP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1", "192.168.1.2"]
+      destination_ports     = ["80", "1000-2000"]
+    }
+  }
+}
+
+resource "azurerm_firewall_policy_deployment" "test" {
+  firewall_policy_id = azurerm_firewall_policy.test.id
+
+  triggers = {
+    test = azurerm_firewall_policy_rule_collection_group.test.draft_hash
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, priority)
+}
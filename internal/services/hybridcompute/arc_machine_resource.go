@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ArcMachineResourceModel struct {
+	Name                       string                            `tfschema:"name"`
+	ResourceGroupName          string                            `tfschema:"resource_group_name"`
+	Location                   string                            `tfschema:"location"`
+	Identity                   []identity.ModelSystemAssigned    `tfschema:"identity"`
+	LicenseProfile             []LicenseProfileModel             `tfschema:"license_profile"`
+	OnboardingServicePrincipal []OnboardingServicePrincipalModel `tfschema:"onboarding_service_principal"`
+	Tags                       map[string]string                 `tfschema:"tags"`
+	OnboardingConfiguration    string                            `tfschema:"onboarding_configuration"`
+}
+
+type LicenseProfileModel struct {
+	EsuLicenseId                   string `tfschema:"esu_license_id"`
+	SoftwareAssuranceEnabled       bool   `tfschema:"software_assurance_enabled"`
+	WindowsServerPayAsYouGoEnabled bool   `tfschema:"windows_server_pay_as_you_go_enabled"`
+}
+
+type OnboardingServicePrincipalModel struct {
+	ClientId     string `tfschema:"client_id"`
+	ClientSecret string `tfschema:"client_secret"`
+}
+
+var (
+	_ sdk.Resource                  = ArcMachineResource{}
+	_ sdk.ResourceWithUpdate        = ArcMachineResource{}
+	_ sdk.ResourceWithCustomizeDiff = ArcMachineResource{}
+)
+
+type ArcMachineResource struct{}
+
+func (r ArcMachineResource) ModelObject() interface{} {
+	return &ArcMachineResourceModel{}
+}
+
+func (r ArcMachineResource) ResourceType() string {
+	return "azurerm_arc_machine"
+}
+
+func (r ArcMachineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return machines.ValidateMachineID
+}
+
+func (r ArcMachineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,54}$`), "`name` must be between 1 and 54 characters and can only contain alphanumeric characters, hyphens, underscores and periods"),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedIdentityOptional(),
+
+		"license_profile": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"esu_license_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: azure.ValidateResourceID,
+						AtLeastOneOf: []string{
+							"license_profile.0.esu_license_id",
+							"license_profile.0.software_assurance_enabled",
+							"license_profile.0.windows_server_pay_as_you_go_enabled",
+						},
+					},
+
+					"software_assurance_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						AtLeastOneOf: []string{
+							"license_profile.0.esu_license_id",
+							"license_profile.0.software_assurance_enabled",
+							"license_profile.0.windows_server_pay_as_you_go_enabled",
+						},
+					},
+
+					"windows_server_pay_as_you_go_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						AtLeastOneOf: []string{
+							"license_profile.0.esu_license_id",
+							"license_profile.0.software_assurance_enabled",
+							"license_profile.0.windows_server_pay_as_you_go_enabled",
+						},
+					},
+				},
+			},
+		},
+
+		// the Service Principal is only used to build the `onboarding_configuration` and isn't sent to Azure
+		"onboarding_service_principal": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"client_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"client_secret": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		// this contains the `client_secret` of the `onboarding_service_principal` so must remain Sensitive
+		"onboarding_configuration": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r ArcMachineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachinesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ArcMachineResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := machines.NewMachineID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id, machines.DefaultGetOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAssignedFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			parameters := machines.Machine{
+				Identity:   identityValue,
+				Location:   location.Normalize(model.Location),
+				Properties: &machines.MachineProperties{},
+				Tags:       pointer.To(model.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(model.LicenseProfile) > 0 {
+				licenseProfile := expandArcMachineLicenseProfile(model.Location, model.LicenseProfile)
+				if err := metadata.Client.HybridCompute.LicenseProfilesClient.CreateOrUpdateThenPoll(ctx, id, licenseProfile); err != nil {
+					return fmt.Errorf("creating the License Profile for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the `onboarding_configuration` is built from the `onboarding_service_principal`, so changes once it's updated
+			if metadata.ResourceDiff.Id() != "" && metadata.ResourceDiff.HasChange("onboarding_service_principal") {
+				return metadata.ResourceDiff.SetNewComputed("onboarding_configuration")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, machines.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the Service Principal isn't returned by the API, so we pull it from the config
+			var config ArcMachineResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ArcMachineResourceModel{
+				Name:                       id.MachineName,
+				ResourceGroupName:          id.ResourceGroupName,
+				OnboardingServicePrincipal: config.OnboardingServicePrincipal,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Identity = identity.FlattenSystemAssignedToModel(model.Identity)
+				state.Tags = pointer.From(model.Tags)
+			}
+
+			licenseProfile, err := metadata.Client.HybridCompute.LicenseProfilesClient.Get(ctx, *id)
+			if err != nil && !response.WasNotFound(licenseProfile.HttpResponse) {
+				return fmt.Errorf("retrieving the License Profile for %s: %+v", *id, err)
+			}
+			state.LicenseProfile = flattenArcMachineLicenseProfile(licenseProfile.Model)
+
+			onboardingConfiguration, err := buildArcMachineOnboardingConfiguration(metadata, *id, state)
+			if err != nil {
+				return err
+			}
+			state.OnboardingConfiguration = onboardingConfiguration
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachineResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("identity", "tags") {
+				update := machines.MachineUpdate{}
+
+				if metadata.ResourceData.HasChange("identity") {
+					identityValue, err := identity.ExpandSystemAssignedFromModel(model.Identity)
+					if err != nil {
+						return fmt.Errorf("expanding `identity`: %+v", err)
+					}
+					update.Identity = identityValue
+				}
+
+				if metadata.ResourceData.HasChange("tags") {
+					update.Tags = pointer.To(model.Tags)
+				}
+
+				if _, err := client.Update(ctx, *id, update); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("license_profile") {
+				licenseProfilesClient := metadata.Client.HybridCompute.LicenseProfilesClient
+				if len(model.LicenseProfile) > 0 {
+					licenseProfile := expandArcMachineLicenseProfile(model.Location, model.LicenseProfile)
+					if err := licenseProfilesClient.CreateOrUpdateThenPoll(ctx, *id, licenseProfile); err != nil {
+						return fmt.Errorf("updating the License Profile for %s: %+v", *id, err)
+					}
+				} else {
+					if err := licenseProfilesClient.DeleteThenPoll(ctx, *id); err != nil {
+						return fmt.Errorf("deleting the License Profile for %s: %+v", *id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachinesClient
+
+			id, err := machines.ParseMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandArcMachineLicenseProfile(machineLocation string, input []LicenseProfileModel) azuresdkhacks.LicenseProfile {
+	licenseProfile := input[0]
+
+	subscriptionStatus := azuresdkhacks.LicenseProfileSubscriptionStatusDisabled
+	if licenseProfile.WindowsServerPayAsYouGoEnabled {
+		subscriptionStatus = azuresdkhacks.LicenseProfileSubscriptionStatusEnabled
+	}
+
+	output := azuresdkhacks.LicenseProfile{
+		Location: location.Normalize(machineLocation),
+		Properties: &azuresdkhacks.LicenseProfileProperties{
+			ProductProfile: &azuresdkhacks.LicenseProfileProductProperties{
+				ProductType:        pointer.To(azuresdkhacks.LicenseProfileProductTypeWindowsServer),
+				SubscriptionStatus: pointer.To(subscriptionStatus),
+			},
+			SoftwareAssurance: &azuresdkhacks.LicenseProfileSoftwareAssuranceProperties{
+				SoftwareAssuranceCustomer: pointer.To(licenseProfile.SoftwareAssuranceEnabled),
+			},
+		},
+	}
+
+	if licenseProfile.EsuLicenseId != "" {
+		output.Properties.EsuProfile = &azuresdkhacks.LicenseProfileEsuProperties{
+			AssignedLicense: pointer.To(licenseProfile.EsuLicenseId),
+		}
+	}
+
+	return output
+}
+
+func flattenArcMachineLicenseProfile(input *azuresdkhacks.LicenseProfile) []LicenseProfileModel {
+	if input == nil || input.Properties == nil {
+		return []LicenseProfileModel{}
+	}
+
+	output := LicenseProfileModel{}
+	props := input.Properties
+
+	if esuProfile := props.EsuProfile; esuProfile != nil {
+		output.EsuLicenseId = pointer.From(esuProfile.AssignedLicense)
+	}
+
+	if productProfile := props.ProductProfile; productProfile != nil {
+		output.WindowsServerPayAsYouGoEnabled = pointer.From(productProfile.SubscriptionStatus) == azuresdkhacks.LicenseProfileSubscriptionStatusEnabled
+	}
+
+	if softwareAssurance := props.SoftwareAssurance; softwareAssurance != nil {
+		output.SoftwareAssuranceEnabled = pointer.From(softwareAssurance.SoftwareAssuranceCustomer)
+	}
+
+	// the API returns an empty License Profile once everything has been unassigned, which we treat as not configured
+	if output == (LicenseProfileModel{}) {
+		return []LicenseProfileModel{}
+	}
+
+	return []LicenseProfileModel{output}
+}
+
+// buildArcMachineOnboardingConfiguration returns the configuration file used to connect the Connected Machine Agent
+// to this Arc Machine via `azcmagent connect --config`. When no Service Principal is specified the configuration
+// is for token-based onboarding, where an access token has to be passed to `azcmagent connect` via `--access-token`.
+func buildArcMachineOnboardingConfiguration(metadata sdk.ResourceMetaData, id machines.MachineId, state ArcMachineResourceModel) (string, error) {
+	cloud := "AzureCloud"
+	switch metadata.Client.Account.Environment.Name {
+	case environments.AzureChinaCloud:
+		cloud = "AzureChinaCloud"
+	case environments.AzureUSGovernmentCloud:
+		cloud = "AzureUSGovernment"
+	}
+
+	configuration := map[string]string{
+		"cloud":           cloud,
+		"location":        state.Location,
+		"resource-group":  id.ResourceGroupName,
+		"resource-name":   id.MachineName,
+		"subscription-id": id.SubscriptionId,
+		"tenant-id":       metadata.Client.Account.TenantId,
+	}
+
+	if len(state.OnboardingServicePrincipal) > 0 {
+		configuration["service-principal-id"] = state.OnboardingServicePrincipal[0].ClientId
+		configuration["service-principal-secret"] = state.OnboardingServicePrincipal[0].ClientSecret
+	}
+
+	output, err := json.Marshal(configuration)
+	if err != nil {
+		return "", fmt.Errorf("building the onboarding configuration for %s: %+v", id, err)
+	}
+
+	return string(output), nil
+}
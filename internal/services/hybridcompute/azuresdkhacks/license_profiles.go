@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// TODO: remove this once the Hybrid Compute SDK has been updated to `2023-10-03-preview` or later
+// License Profiles (used to assign Extended Security Updates and Windows Server pay-as-you-go to an Arc Machine)
+// are only available from API version `2023-10-03-preview`, which isn't vendored - as such the Client for these is
+// defined here until the SDK has been updated.
+const licenseProfilesApiVersion = "2023-10-03-preview"
+
+type LicenseProfilesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewLicenseProfilesClientWithBaseURI(sdkApi environments.Api) (*LicenseProfilesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "licenseprofiles", licenseProfilesApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating LicenseProfilesClient: %+v", err)
+	}
+
+	return &LicenseProfilesClient{
+		Client: client,
+	}, nil
+}
+
+type LicenseProfileProductType string
+
+const (
+	LicenseProfileProductTypeWindowsServer LicenseProfileProductType = "WindowsServer"
+)
+
+type LicenseProfileSubscriptionStatus string
+
+const (
+	LicenseProfileSubscriptionStatusDisabled LicenseProfileSubscriptionStatus = "Disabled"
+	LicenseProfileSubscriptionStatusEnabled  LicenseProfileSubscriptionStatus = "Enabled"
+)
+
+type LicenseProfile struct {
+	Id         *string                   `json:"id,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *LicenseProfileProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type LicenseProfileProperties struct {
+	EsuProfile        *LicenseProfileEsuProperties               `json:"esuProfile,omitempty"`
+	ProductProfile    *LicenseProfileProductProperties           `json:"productProfile,omitempty"`
+	SoftwareAssurance *LicenseProfileSoftwareAssuranceProperties `json:"softwareAssurance,omitempty"`
+}
+
+type LicenseProfileEsuProperties struct {
+	AssignedLicense        *string `json:"assignedLicense,omitempty"`
+	EsuEligibility         *string `json:"esuEligibility,omitempty"`
+	LicenseAssignmentState *string `json:"licenseAssignmentState,omitempty"`
+}
+
+type LicenseProfileProductProperties struct {
+	ProductType        *LicenseProfileProductType        `json:"productType,omitempty"`
+	SubscriptionStatus *LicenseProfileSubscriptionStatus `json:"subscriptionStatus,omitempty"`
+}
+
+type LicenseProfileSoftwareAssuranceProperties struct {
+	SoftwareAssuranceCustomer *bool `json:"softwareAssuranceCustomer,omitempty"`
+}
+
+type GetLicenseProfileOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *LicenseProfile
+}
+
+// Get retrieves the License Profile of the specified Arc Machine
+func (c LicenseProfilesClient) Get(ctx context.Context, id machines.MachineId) (result GetLicenseProfileOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, licenseProfilePath(id), nil, []int{http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	err = resp.Unmarshal(&result.Model)
+	return
+}
+
+// CreateOrUpdateThenPoll creates or updates the License Profile of the specified Arc Machine, then polls until
+// it's completed
+func (c LicenseProfilesClient) CreateOrUpdateThenPoll(ctx context.Context, id machines.MachineId, input LicenseProfile) error {
+	resp, err := c.execute(ctx, http.MethodPut, licenseProfilePath(id), input, []int{http.StatusCreated, http.StatusOK})
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	return c.poll(ctx, resp, "CreateOrUpdate")
+}
+
+// DeleteThenPoll deletes the License Profile of the specified Arc Machine, then polls until it's gone
+func (c LicenseProfilesClient) DeleteThenPoll(ctx context.Context, id machines.MachineId) error {
+	resp, err := c.execute(ctx, http.MethodDelete, licenseProfilePath(id), nil, []int{http.StatusAccepted, http.StatusNoContent, http.StatusOK})
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	return c.poll(ctx, resp, "Delete")
+}
+
+func (c LicenseProfilesClient) poll(ctx context.Context, resp *client.Response, operation string) error {
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return fmt.Errorf("building poller: %+v", err)
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after %s: %+v", operation, err)
+	}
+
+	return nil
+}
+
+func (c LicenseProfilesClient) execute(ctx context.Context, method string, path string, input interface{}, expectedStatusCodes []int) (*client.Response, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: expectedStatusCodes,
+		HttpMethod:          method,
+		Path:                path,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	if input != nil {
+		if err := req.Marshal(input); err != nil {
+			return nil, fmt.Errorf("marshaling request: %+v", err)
+		}
+	}
+
+	return req.Execute(ctx)
+}
+
+// there's only a single License Profile per Arc Machine, which is always named `default`
+func licenseProfilePath(id machines.MachineId) string {
+	return fmt.Sprintf("%s/licenseProfiles/default", id.ID())
+}
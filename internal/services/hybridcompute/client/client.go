@@ -11,9 +11,12 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privateendpointconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privatelinkscopes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/azuresdkhacks"
 )
 
 type Client struct {
+	// TODO: switch to the SDK Client once the Hybrid Compute SDK has been updated to `2023-10-03-preview` or later
+	LicenseProfilesClient            *azuresdkhacks.LicenseProfilesClient
 	MachineExtensionsClient          *machineextensions.MachineExtensionsClient
 	MachinesClient                   *machines.MachinesClient
 	PrivateEndpointConnectionsClient *privateendpointconnections.PrivateEndpointConnectionsClient
@@ -21,6 +24,12 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	licenseProfilesClient, err := azuresdkhacks.NewLicenseProfilesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building LicenseProfiles client: %+v", err)
+	}
+	o.Configure(licenseProfilesClient.Client, o.Authorizers.ResourceManager)
+
 	machineExtensionsClient, err := machineextensions.NewMachineExtensionsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building MachineExtensions client: %+v", err)
@@ -46,6 +55,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(privateLinkScopesClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
+		LicenseProfilesClient:            licenseProfilesClient,
 		MachineExtensionsClient:          machineExtensionsClient,
 		MachinesClient:                   machinesClient,
 		PrivateEndpointConnectionsClient: privateEndpointConnectionsClient,
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &SummaryRuleId{}
+
+// SummaryRuleId is a struct representing the Resource ID for a Summary Rule
+type SummaryRuleId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WorkspaceName     string
+	SummaryRuleName   string
+}
+
+// NewSummaryRuleID returns a new SummaryRuleId struct
+func NewSummaryRuleID(subscriptionId string, resourceGroupName string, workspaceName string, summaryRuleName string) SummaryRuleId {
+	return SummaryRuleId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		WorkspaceName:     workspaceName,
+		SummaryRuleName:   summaryRuleName,
+	}
+}
+
+// ParseSummaryRuleID parses 'input' into a SummaryRuleId
+func ParseSummaryRuleID(input string) (*SummaryRuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&SummaryRuleId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := SummaryRuleId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseSummaryRuleIDInsensitively parses 'input' case-insensitively into a SummaryRuleId
+// note: this method should only be used for API response data and not user input
+func ParseSummaryRuleIDInsensitively(input string) (*SummaryRuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&SummaryRuleId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := SummaryRuleId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *SummaryRuleId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.SummaryRuleName, ok = input.Parsed["summaryRuleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "summaryRuleName", input)
+	}
+
+	return nil
+}
+
+// ValidateSummaryRuleID checks that 'input' can be parsed as a Summary Rule ID
+func ValidateSummaryRuleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSummaryRuleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Summary Rule ID
+func (id SummaryRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/summaryLogs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.SummaryRuleName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Summary Rule ID
+func (id SummaryRuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights", "Microsoft.OperationalInsights"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspaceValue"),
+		resourceids.StaticSegment("staticSummaryLogs", "summaryLogs", "summaryLogs"),
+		resourceids.UserSpecifiedSegment("summaryRuleName", "summaryRuleValue"),
+	}
+}
+
+// String returns a human-readable description of this Summary Rule ID
+func (id SummaryRuleId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Workspace Name: %q", id.WorkspaceName),
+		fmt.Sprintf("Summary Rule Name: %q", id.SummaryRuleName),
+	}
+	return fmt.Sprintf("Summary Rule (%s)", strings.Join(components, "\n"))
+}
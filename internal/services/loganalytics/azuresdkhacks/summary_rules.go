@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type SummaryRulesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewSummaryRulesClientWithBaseURI(sdkApi environments.Api) (*SummaryRulesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "summaryrules", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SummaryRulesClient: %+v", err)
+	}
+
+	return &SummaryRulesClient{
+		Client: client,
+	}, nil
+}
+
+type SummaryRuleCreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *SummaryRule
+}
+
+// CreateOrUpdate ...
+func (c SummaryRulesClient) CreateOrUpdate(ctx context.Context, id SummaryRuleId, input SummaryRule) (result SummaryRuleCreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type SummaryRuleGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *SummaryRule
+}
+
+// Get ...
+func (c SummaryRulesClient) Get(ctx context.Context, id SummaryRuleId) (result SummaryRuleGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type SummaryRuleDeleteOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+// Delete ...
+func (c SummaryRulesClient) Delete(ctx context.Context, id SummaryRuleId) (result SummaryRuleDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+type SummaryRule struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *SummaryRuleProperties `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type SummaryRuleProperties struct {
+	Description       *string                `json:"description,omitempty"`
+	DisplayName       *string                `json:"displayName,omitempty"`
+	IsActive          *bool                  `json:"isActive,omitempty"`
+	ProvisioningState *string                `json:"provisioningState,omitempty"`
+	RuleDefinition    *SummaryRuleDefinition `json:"ruleDefinition,omitempty"`
+	RuleType          *SummaryRuleType       `json:"ruleType,omitempty"`
+	StatusCode        *string                `json:"statusCode,omitempty"`
+}
+
+type SummaryRuleDefinition struct {
+	BinDelay         *int64  `json:"binDelay,omitempty"`
+	BinSize          *int64  `json:"binSize,omitempty"`
+	BinStartTime     *string `json:"binStartTime,omitempty"`
+	DestinationTable *string `json:"destinationTable,omitempty"`
+	Query            *string `json:"query,omitempty"`
+}
+
+type SummaryRuleType string
+
+const (
+	SummaryRuleTypeUser SummaryRuleType = "User"
+)
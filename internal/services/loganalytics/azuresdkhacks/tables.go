@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// TODO: remove this once the Tables SDK has been updated to a newer API version
+// the `Auxiliary` table plan and Summary Rules are only available from API version `2023-01-01-preview`,
+// the request/response models are otherwise compatible so we reuse the `2022-10-01` SDK with the newer API version.
+// This client is only used for tables on the `Auxiliary` plan, all other tables continue to use the stable API version.
+const defaultApiVersion = "2023-01-01-preview"
+
+const TablePlanEnumAuxiliary tables.TablePlanEnum = "Auxiliary"
+
+func NewTablesPreviewClientWithBaseURI(sdkApi environments.Api) (*tables.TablesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "tables", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating TablesPreviewClient: %+v", err)
+	}
+
+	return &tables.TablesClient{
+		Client: client,
+	}, nil
+}
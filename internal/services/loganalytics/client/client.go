@@ -20,6 +20,7 @@ import (
 	featureWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
 )
 
 type Client struct {
@@ -32,9 +33,11 @@ type Client struct {
 	SavedSearchesClient        *savedsearches.SavedSearchesClient
 	SolutionsClient            *solution.SolutionClient
 	StorageInsightsClient      *storageinsights.StorageInsightsClient
+	SummaryRulesClient         *azuresdkhacks.SummaryRulesClient
 	QueryPackQueriesClient     *querypackqueries.QueryPackQueriesClient
 	SharedKeyWorkspacesClient  *workspaces.WorkspacesClient
 	TablesClient               *tables.TablesClient
+	TablesPreviewClient        *tables.TablesClient
 	WorkspaceClient            *featureWorkspaces.WorkspacesClient // 2022-10-01 API version does not contain sharedkeys related API, so we keep two versions SDK of this API
 }
 
@@ -111,12 +114,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(queryPackQueriesClient.Client, o.Authorizers.ResourceManager)
 
+	summaryRulesClient, err := azuresdkhacks.NewSummaryRulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building SummaryRules client: %+v", err)
+	}
+	o.Configure(summaryRulesClient.Client, o.Authorizers.ResourceManager)
+
 	tablesClient, err := tables.NewTablesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Tables client: %+v", err)
 	}
 	o.Configure(tablesClient.Client, o.Authorizers.ResourceManager)
 
+	tablesPreviewClient, err := azuresdkhacks.NewTablesPreviewClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Tables Preview client: %+v", err)
+	}
+	o.Configure(tablesPreviewClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ClusterClient:              clusterClient,
 		DataExportClient:           dataExportClient,
@@ -128,8 +143,10 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SavedSearchesClient:        savedSearchesClient,
 		SolutionsClient:            solutionsClient,
 		StorageInsightsClient:      storageInsightsClient,
+		SummaryRulesClient:         summaryRulesClient,
 		SharedKeyWorkspacesClient:  workspacesClient,
 		TablesClient:               tablesClient,
+		TablesPreviewClient:        tablesPreviewClient,
 		WorkspaceClient:            featureWorkspaceClient,
 	}, nil
 }
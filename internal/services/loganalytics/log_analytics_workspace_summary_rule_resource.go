@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LogAnalyticsWorkspaceSummaryRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = LogAnalyticsWorkspaceSummaryRuleResource{}
+
+type LogAnalyticsWorkspaceSummaryRuleModel struct {
+	Name                 string `tfschema:"name"`
+	WorkspaceId          string `tfschema:"workspace_id"`
+	Query                string `tfschema:"query"`
+	DestinationTableName string `tfschema:"destination_table_name"`
+	BinSizeInMinutes     int64  `tfschema:"bin_size_in_minutes"`
+	BinDelayInMinutes    int64  `tfschema:"bin_delay_in_minutes"`
+	BinStartTime         string `tfschema:"bin_start_time"`
+	DisplayName          string `tfschema:"display_name"`
+	Description          string `tfschema:"description"`
+	Active               bool   `tfschema:"active"`
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,62}$`),
+				"`name` must be between 1 and 63 characters long, start with a letter or number and contain only letters, numbers, hyphens and underscores",
+			),
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"destination_table_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateLogAnalyticsCustomTableName,
+		},
+
+		"bin_size_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntInSlice([]int{20, 30, 60, 120, 180, 360, 720, 1440}),
+		},
+
+		"bin_delay_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 1440),
+		},
+
+		"bin_start_time": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"active": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceSummaryRuleModel{}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_summary_rule"
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateSummaryRuleID
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryRulesClient
+
+			var model LogAnalyticsWorkspaceSummaryRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewSummaryRuleID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandLogAnalyticsWorkspaceSummaryRule(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryRulesClient
+
+			id, err := azuresdkhacks.ParseSummaryRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LogAnalyticsWorkspaceSummaryRuleModel{
+				Name:        id.SummaryRuleName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.Description = pointer.From(props.Description)
+					state.Active = pointer.From(props.IsActive)
+
+					if definition := props.RuleDefinition; definition != nil {
+						state.Query = pointer.From(definition.Query)
+						state.DestinationTableName = pointer.From(definition.DestinationTable)
+						state.BinSizeInMinutes = pointer.From(definition.BinSize)
+						state.BinDelayInMinutes = pointer.From(definition.BinDelay)
+						state.BinStartTime = pointer.From(definition.BinStartTime)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryRulesClient
+
+			id, err := azuresdkhacks.ParseSummaryRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceSummaryRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandLogAnalyticsWorkspaceSummaryRule(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryRulesClient
+
+			id, err := azuresdkhacks.ParseSummaryRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandLogAnalyticsWorkspaceSummaryRule(input LogAnalyticsWorkspaceSummaryRuleModel) azuresdkhacks.SummaryRule {
+	output := azuresdkhacks.SummaryRule{
+		Properties: &azuresdkhacks.SummaryRuleProperties{
+			RuleType: pointer.To(azuresdkhacks.SummaryRuleTypeUser),
+			RuleDefinition: &azuresdkhacks.SummaryRuleDefinition{
+				Query:            pointer.To(input.Query),
+				DestinationTable: pointer.To(input.DestinationTableName),
+				BinSize:          pointer.To(input.BinSizeInMinutes),
+				BinDelay:         pointer.To(input.BinDelayInMinutes),
+			},
+		},
+	}
+
+	if input.BinStartTime != "" {
+		output.Properties.RuleDefinition.BinStartTime = pointer.To(input.BinStartTime)
+	}
+
+	if input.DisplayName != "" {
+		output.Properties.DisplayName = pointer.To(input.DisplayName)
+	}
+
+	if input.Description != "" {
+		output.Properties.Description = pointer.To(input.Description)
+	}
+
+	return output
+}
+
+func validateLogAnalyticsCustomTableName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !strings.HasSuffix(v, "_CL") || len(v) <= len("_CL") {
+		errors = append(errors, fmt.Errorf("expected %q to be the name of a custom table ending with `_CL` but got %q", k, v))
+	}
+
+	return
+}
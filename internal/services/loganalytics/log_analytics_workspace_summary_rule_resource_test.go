@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LogAnalyticsWorkspaceSummaryRuleResource struct{}
+
+func TestAccLogAnalyticsWorkspaceSummaryRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_summary_rule", "test")
+	r := LogAnalyticsWorkspaceSummaryRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceSummaryRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_summary_rule", "test")
+	r := LogAnalyticsWorkspaceSummaryRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceSummaryRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_summary_rule", "test")
+	r := LogAnalyticsWorkspaceSummaryRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseSummaryRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.SummaryRulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_summary_rule" "test" {
+  name                   = "acctest-sr-%d"
+  workspace_id           = azurerm_log_analytics_workspace.test.id
+  query                  = "AppTraces | summarize Count = count() by AppRoleName"
+  destination_table_name = "AppTracesSummary%d_CL"
+  bin_size_in_minutes    = 60
+}
+`, LogAnalyticsWorkspaceTableResource{}.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_summary_rule" "import" {
+  name                   = azurerm_log_analytics_workspace_summary_rule.test.name
+  workspace_id           = azurerm_log_analytics_workspace_summary_rule.test.workspace_id
+  query                  = azurerm_log_analytics_workspace_summary_rule.test.query
+  destination_table_name = azurerm_log_analytics_workspace_summary_rule.test.destination_table_name
+  bin_size_in_minutes    = azurerm_log_analytics_workspace_summary_rule.test.bin_size_in_minutes
+}
+`, r.basic(data))
+}
+
+func (r LogAnalyticsWorkspaceSummaryRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_summary_rule" "test" {
+  name                   = "acctest-sr-%d"
+  workspace_id           = azurerm_log_analytics_workspace.test.id
+  query                  = "AppTraces | summarize Count = count() by AppRoleName, SeverityLevel"
+  destination_table_name = "AppTracesSummary%d_CL"
+  bin_size_in_minutes    = 60
+  bin_delay_in_minutes   = 10
+  display_name           = "App Traces Summary"
+  description            = "Hourly summary of the application traces"
+}
+`, LogAnalyticsWorkspaceTableResource{}.template(data), data.RandomInteger, data.RandomInteger)
+}
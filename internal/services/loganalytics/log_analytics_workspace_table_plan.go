@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
+)
+
+// logAnalyticsWorkspaceTablePlanSwitchInterval is the minimum interval between two changes to the plan of a table,
+// the API only allows switching a table between the `Analytics` and `Basic` plans once a week.
+const logAnalyticsWorkspaceTablePlanSwitchInterval = 7 * 24 * time.Hour
+
+// logAnalyticsWorkspaceTablePlanRequiresRecreation returns whether changing the plan of a table from `old` to `new`
+// requires the table to be re-created - the `Auxiliary` plan can only be set when a table is created.
+func logAnalyticsWorkspaceTablePlanRequiresRecreation(old, new string) bool {
+	if old == new {
+		return false
+	}
+
+	auxiliary := string(azuresdkhacks.TablePlanEnumAuxiliary)
+	return old == auxiliary || new == auxiliary
+}
+
+// validateLogAnalyticsWorkspaceTablePlanTransition validates that the plan of a table can be switched from `old`
+// to `new` at `now`, given the date the plan was last modified (if known).
+func validateLogAnalyticsWorkspaceTablePlanTransition(old, new string, lastModified *time.Time, now time.Time) error {
+	if old == new || logAnalyticsWorkspaceTablePlanRequiresRecreation(old, new) {
+		return nil
+	}
+
+	if lastModified == nil {
+		return nil
+	}
+
+	nextAllowed := lastModified.Add(logAnalyticsWorkspaceTablePlanSwitchInterval)
+	if now.Before(nextAllowed) {
+		return fmt.Errorf("the `plan` of a table can only be switched once a week - the plan was last modified at %s so can next be switched from %q to %q at %s", lastModified.Format(time.RFC3339), old, new, nextAllowed.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// validateLogAnalyticsWorkspaceTableConfiguration validates the combination of the name, plan, retention and columns of a
+// table, which can't be validated at the schema level
+func validateLogAnalyticsWorkspaceTableConfiguration(name, plan string, retentionInDays int, columns []LogAnalyticsWorkspaceTableColumnModel) error {
+	if retentionInDays != 0 {
+		switch plan {
+		case string(tables.TablePlanEnumBasic):
+			return fmt.Errorf("cannot set retention_in_days because the retention is fixed at eight days on Basic plan")
+		case string(azuresdkhacks.TablePlanEnumAuxiliary):
+			return fmt.Errorf("cannot set retention_in_days because the retention is fixed at thirty days on Auxiliary plan")
+		}
+	}
+
+	if len(columns) == 0 {
+		if plan == string(azuresdkhacks.TablePlanEnumAuxiliary) {
+			return fmt.Errorf("the Auxiliary plan can only be used for custom tables, which requires that one or more `column` blocks are specified")
+		}
+		return nil
+	}
+
+	// the values aren't known yet, so we can't validate them
+	if name == "" {
+		return nil
+	}
+
+	if !strings.HasSuffix(name, "_CL") {
+		return fmt.Errorf("`column` can only be specified for custom tables, whose `name` must end with `_CL` but got %q", name)
+	}
+
+	names := make(map[string]struct{})
+	hasTimeGenerated := false
+	for _, v := range columns {
+		if v.Name == "" || v.Type == "" {
+			// the value isn't known yet
+			return nil
+		}
+
+		if _, ok := names[strings.ToLower(v.Name)]; ok {
+			return fmt.Errorf("the column %q is defined more than once", v.Name)
+		}
+		names[strings.ToLower(v.Name)] = struct{}{}
+
+		if v.Name == "TimeGenerated" {
+			if v.Type != string(tables.ColumnTypeEnumDateTime) {
+				return fmt.Errorf("the `TimeGenerated` column must be of type `dateTime` but got %q", v.Type)
+			}
+			hasTimeGenerated = true
+		}
+	}
+
+	if !hasTimeGenerated {
+		return fmt.Errorf("custom tables must contain a `TimeGenerated` column of type `dateTime`")
+	}
+
+	return nil
+}
+
+// logAnalyticsWorkspaceTableColumnRebuildReasons returns the reasons (if any) why changing the columns of a custom table
+// from `old` to `new` requires the table to be re-created - new columns can be added to an existing table and their
+// description and display name changed, however columns can't be removed or have their type changed.
+func logAnalyticsWorkspaceTableColumnRebuildReasons(old, new []LogAnalyticsWorkspaceTableColumnModel) []string {
+	reasons := make([]string, 0)
+
+	newColumns := make(map[string]LogAnalyticsWorkspaceTableColumnModel)
+	for _, v := range new {
+		newColumns[v.Name] = v
+	}
+
+	for _, o := range old {
+		n, ok := newColumns[o.Name]
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("column %q was removed", o.Name))
+			continue
+		}
+
+		if o.Type != n.Type {
+			reasons = append(reasons, fmt.Sprintf("the type of column %q changed from %q to %q", o.Name, o.Type, n.Type))
+		}
+	}
+
+	return reasons
+}
+
+// logAnalyticsWorkspaceTableIsCustom returns whether the table is a custom table (which is created and deleted by this
+// resource) rather than a table which exists as a part of the workspace, such as a built-in or solution table.
+func logAnalyticsWorkspaceTableIsCustom(name string, props *tables.TableProperties) bool {
+	if props != nil && props.Schema != nil && props.Schema.TableType != nil {
+		return *props.Schema.TableType == tables.TableTypeEnumCustomLog
+	}
+
+	return strings.HasSuffix(name, "_CL")
+}
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+)
+
+func TestValidateLogAnalyticsWorkspaceTablePlanTransition(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		Name         string
+		Old          string
+		New          string
+		LastModified *time.Time
+		Valid        bool
+	}{
+		{
+			Name:         "Unchanged",
+			Old:          "Basic",
+			New:          "Basic",
+			LastModified: pointer.To(now.Add(-1 * time.Hour)),
+			Valid:        true,
+		},
+		{
+			Name:  "Never Modified",
+			Old:   "Analytics",
+			New:   "Basic",
+			Valid: true,
+		},
+		{
+			Name:         "Modified Over A Week Ago",
+			Old:          "Analytics",
+			New:          "Basic",
+			LastModified: pointer.To(now.Add(-8 * 24 * time.Hour)),
+			Valid:        true,
+		},
+		{
+			Name:         "Modified Exactly A Week Ago",
+			Old:          "Basic",
+			New:          "Analytics",
+			LastModified: pointer.To(now.Add(-7 * 24 * time.Hour)),
+			Valid:        true,
+		},
+		{
+			Name:         "Modified Within The Last Week",
+			Old:          "Basic",
+			New:          "Analytics",
+			LastModified: pointer.To(now.Add(-2 * 24 * time.Hour)),
+			Valid:        false,
+		},
+		{
+			Name:         "Switching To Auxiliary Recreates The Table",
+			Old:          "Analytics",
+			New:          "Auxiliary",
+			LastModified: pointer.To(now.Add(-1 * time.Hour)),
+			Valid:        true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateLogAnalyticsWorkspaceTablePlanTransition(v.Old, v.New, v.LastModified, now)
+		if v.Valid && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestLogAnalyticsWorkspaceTablePlanRequiresRecreation(t *testing.T) {
+	testData := []struct {
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Old:      "Analytics",
+			New:      "Analytics",
+			Expected: false,
+		},
+		{
+			Old:      "Analytics",
+			New:      "Basic",
+			Expected: false,
+		},
+		{
+			Old:      "Basic",
+			New:      "Auxiliary",
+			Expected: true,
+		},
+		{
+			Old:      "Auxiliary",
+			New:      "Analytics",
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q -> %q", v.Old, v.New)
+
+		if actual := logAnalyticsWorkspaceTablePlanRequiresRecreation(v.Old, v.New); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestValidateLogAnalyticsWorkspaceTableConfiguration(t *testing.T) {
+	columns := []LogAnalyticsWorkspaceTableColumnModel{
+		{
+			Name: "TimeGenerated",
+			Type: "dateTime",
+		},
+		{
+			Name: "Message",
+			Type: "string",
+		},
+	}
+
+	testData := []struct {
+		Name            string
+		TableName       string
+		Plan            string
+		RetentionInDays int
+		Columns         []LogAnalyticsWorkspaceTableColumnModel
+		Valid           bool
+	}{
+		{
+			Name:            "Existing Table",
+			TableName:       "AppEvents",
+			Plan:            "Analytics",
+			RetentionInDays: 30,
+			Valid:           true,
+		},
+		{
+			Name:            "Basic With Retention",
+			TableName:       "AppTraces",
+			Plan:            "Basic",
+			RetentionInDays: 30,
+			Valid:           false,
+		},
+		{
+			Name:            "Auxiliary With Retention",
+			TableName:       "Example_CL",
+			Plan:            "Auxiliary",
+			RetentionInDays: 30,
+			Columns:         columns,
+			Valid:           false,
+		},
+		{
+			Name:      "Auxiliary Without Columns",
+			TableName: "Example_CL",
+			Plan:      "Auxiliary",
+			Valid:     false,
+		},
+		{
+			Name:      "Custom Table",
+			TableName: "Example_CL",
+			Plan:      "Auxiliary",
+			Columns:   columns,
+			Valid:     true,
+		},
+		{
+			Name:      "Columns On Non-Custom Table",
+			TableName: "AppEvents",
+			Plan:      "Analytics",
+			Columns:   columns,
+			Valid:     false,
+		},
+		{
+			Name:      "Missing TimeGenerated",
+			TableName: "Example_CL",
+			Plan:      "Analytics",
+			Columns:   columns[1:],
+			Valid:     false,
+		},
+		{
+			Name:      "TimeGenerated Wrong Type",
+			TableName: "Example_CL",
+			Plan:      "Analytics",
+			Columns: []LogAnalyticsWorkspaceTableColumnModel{
+				{
+					Name: "TimeGenerated",
+					Type: "string",
+				},
+			},
+			Valid: false,
+		},
+		{
+			Name:      "Duplicate Column",
+			TableName: "Example_CL",
+			Plan:      "Analytics",
+			Columns:   append(columns, LogAnalyticsWorkspaceTableColumnModel{Name: "message", Type: "string"}),
+			Valid:     false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateLogAnalyticsWorkspaceTableConfiguration(v.TableName, v.Plan, v.RetentionInDays, v.Columns)
+		if v.Valid && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestLogAnalyticsWorkspaceTableColumnRebuildReasons(t *testing.T) {
+	existing := []LogAnalyticsWorkspaceTableColumnModel{
+		{
+			Name: "TimeGenerated",
+			Type: "dateTime",
+		},
+		{
+			Name: "Message",
+			Type: "string",
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		New      []LogAnalyticsWorkspaceTableColumnModel
+		Expected int
+	}{
+		{
+			Name:     "No Changes",
+			New:      existing,
+			Expected: 0,
+		},
+		{
+			Name: "Column Added and Description Changed",
+			New: []LogAnalyticsWorkspaceTableColumnModel{
+				existing[0],
+				{
+					Name:        "Message",
+					Type:        "string",
+					Description: "The message",
+				},
+				{
+					Name: "Level",
+					Type: "int",
+				},
+			},
+			Expected: 0,
+		},
+		{
+			Name:     "Column Removed",
+			New:      existing[:1],
+			Expected: 1,
+		},
+		{
+			Name: "Column Type Changed",
+			New: []LogAnalyticsWorkspaceTableColumnModel{
+				existing[0],
+				{
+					Name: "Message",
+					Type: "dynamic",
+				},
+			},
+			Expected: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := logAnalyticsWorkspaceTableColumnRebuildReasons(existing, v.New)
+		if len(actual) != v.Expected {
+			t.Fatalf("expected %d reasons but got %d: %+v", v.Expected, len(actual), actual)
+		}
+	}
+}
+
+func TestLogAnalyticsWorkspaceTableIsCustom(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Table    string
+		Props    *tables.TableProperties
+		Expected bool
+	}{
+		{
+			Name:     "custom table type",
+			Table:    "Example_CL",
+			Props:    &tables.TableProperties{Schema: &tables.Schema{TableType: pointer.To(tables.TableTypeEnumCustomLog)}},
+			Expected: true,
+		},
+		{
+			Name:     "built-in table type",
+			Table:    "AppTraces",
+			Props:    &tables.TableProperties{Schema: &tables.Schema{TableType: pointer.To(tables.TableTypeEnumMicrosoft)}},
+			Expected: false,
+		},
+		{
+			Name:     "table type takes precedence over the name",
+			Table:    "Example_CL",
+			Props:    &tables.TableProperties{Schema: &tables.Schema{TableType: pointer.To(tables.TableTypeEnumMicrosoft)}},
+			Expected: false,
+		},
+		{
+			Name:     "no table type with a custom name",
+			Table:    "Example_CL",
+			Props:    &tables.TableProperties{},
+			Expected: true,
+		},
+		{
+			Name:     "no properties with a built-in name",
+			Table:    "AppTraces",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := logAnalyticsWorkspaceTableIsCustom(v.Table, v.Props); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -26,11 +28,20 @@ var _ sdk.ResourceWithUpdate = LogAnalyticsWorkspaceTableResource{}
 var _ sdk.ResourceWithCustomizeDiff = LogAnalyticsWorkspaceTableResource{}
 
 type LogAnalyticsWorkspaceTableResourceModel struct {
-	Name                 string `tfschema:"name"`
-	WorkspaceId          string `tfschema:"workspace_id"`
-	Plan                 string `tfschema:"plan"`
-	RetentionInDays      int64  `tfschema:"retention_in_days"`
-	TotalRetentionInDays int64  `tfschema:"total_retention_in_days"`
+	Name                 string                                  `tfschema:"name"`
+	WorkspaceId          string                                  `tfschema:"workspace_id"`
+	Plan                 string                                  `tfschema:"plan"`
+	RetentionInDays      int64                                   `tfschema:"retention_in_days"`
+	TotalRetentionInDays int64                                   `tfschema:"total_retention_in_days"`
+	Column               []LogAnalyticsWorkspaceTableColumnModel `tfschema:"column"`
+	LastPlanModifiedDate string                                  `tfschema:"last_plan_modified_date"`
+}
+
+type LogAnalyticsWorkspaceTableColumnModel struct {
+	Name        string `tfschema:"name"`
+	Type        string `tfschema:"type"`
+	Description string `tfschema:"description"`
+	DisplayName string `tfschema:"display_name"`
 }
 
 func (r LogAnalyticsWorkspaceTableResource) CustomizeDiff() sdk.ResourceFunc {
@@ -39,9 +50,47 @@ func (r LogAnalyticsWorkspaceTableResource) CustomizeDiff() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			rd := metadata.ResourceDiff
 
-			if string(tables.TablePlanEnumBasic) == rd.Get("plan").(string) {
-				if _, ok := rd.GetOk("retention_in_days"); ok {
-					return fmt.Errorf("cannot set retention_in_days because the retention is fixed at eight days on Basic plan")
+			columns := expandLogAnalyticsWorkspaceTableColumnsFromDiff(rd.Get("column").([]interface{}))
+			if err := validateLogAnalyticsWorkspaceTableConfiguration(rd.Get("name").(string), rd.Get("plan").(string), rd.Get("retention_in_days").(int), columns); err != nil {
+				return err
+			}
+
+			// the remaining validation only applies to existing tables
+			if rd.Id() == "" {
+				return nil
+			}
+
+			if rd.HasChange("plan") {
+				oldPlan, newPlan := rd.GetChange("plan")
+				if logAnalyticsWorkspaceTablePlanRequiresRecreation(oldPlan.(string), newPlan.(string)) {
+					if err := rd.ForceNew("plan"); err != nil {
+						return err
+					}
+				} else {
+					var lastModified *time.Time
+					if v := rd.Get("last_plan_modified_date").(string); v != "" {
+						t, err := time.Parse(time.RFC3339, v)
+						if err != nil {
+							return fmt.Errorf("parsing `last_plan_modified_date` %q: %+v", v, err)
+						}
+						lastModified = &t
+					}
+
+					if err := validateLogAnalyticsWorkspaceTablePlanTransition(oldPlan.(string), newPlan.(string), lastModified, time.Now()); err != nil {
+						return err
+					}
+				}
+			}
+
+			if rd.HasChange("column") {
+				oldRaw, newRaw := rd.GetChange("column")
+				oldColumns := expandLogAnalyticsWorkspaceTableColumnsFromDiff(oldRaw.([]interface{}))
+				newColumns := expandLogAnalyticsWorkspaceTableColumnsFromDiff(newRaw.([]interface{}))
+				if reasons := logAnalyticsWorkspaceTableColumnRebuildReasons(oldColumns, newColumns); len(reasons) > 0 {
+					log.Printf("[DEBUG] the columns of %s require the table to be re-created: %s", rd.Id(), strings.Join(reasons, "; "))
+					if err := rd.ForceNew("column"); err != nil {
+						return err
+					}
 				}
 			}
 
@@ -69,6 +118,7 @@ func (r LogAnalyticsWorkspaceTableResource) Arguments() map[string]*pluginsdk.Sc
 			Default:  string(tables.TablePlanEnumAnalytics),
 			ValidateFunc: validation.StringInSlice([]string{
 				string(tables.TablePlanEnumAnalytics),
+				string(azuresdkhacks.TablePlanEnumAuxiliary),
 				string(tables.TablePlanEnumBasic),
 			}, false),
 		},
@@ -80,15 +130,58 @@ func (r LogAnalyticsWorkspaceTableResource) Arguments() map[string]*pluginsdk.Sc
 		},
 
 		"total_retention_in_days": {
-			Type:         pluginsdk.TypeInt,
-			Optional:     true,
-			ValidateFunc: validation.Any(validation.IntBetween(30, 730), validation.IntInSlice([]int{7})),
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			ValidateFunc: validation.Any(
+				validation.IntBetween(30, 730),
+				validation.IntInSlice([]int{7, 1095, 1461, 1826, 2191, 2556, 2922, 3288, 3653, 4018, 4383}),
+			),
+		},
+
+		// the columns of custom tables are always read back, as such this is Computed so that custom tables created outside
+		// of Terraform (e.g. by a Data Collection Rule) can have their retention managed without specifying the columns
+		"column": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(tables.PossibleValuesForColumnTypeEnum(), false),
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"display_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
 		},
 	}
 }
 
 func (r LogAnalyticsWorkspaceTableResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"last_plan_modified_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
 }
 
 func (r LogAnalyticsWorkspaceTableResource) ModelObject() interface{} {
@@ -111,7 +204,7 @@ func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
 			if err := metadata.Decode(&model); err != nil {
 				return fmt.Errorf("decoding %+v", err)
 			}
-			client := metadata.Client.LogAnalytics.TablesClient
+			client := r.tablesClient(metadata, model.Plan)
 			subscriptionId := metadata.Client.Account.SubscriptionId
 
 			tableName := model.Name
@@ -124,6 +217,17 @@ func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
 
 			id := tables.NewTableID(subscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, tableName)
 
+			// custom tables are created by this resource, whereas other tables exist as a part of the workspace
+			if len(model.Column) > 0 {
+				existing, err := client.Get(ctx, id)
+				if err != nil && !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				if !response.WasNotFound(existing.HttpResponse) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
 			updateInput := tables.Table{
 				Properties: &tables.TableProperties{
 					Plan: pointer.To(tables.TablePlanEnum(model.Plan)),
@@ -134,6 +238,17 @@ func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
 				updateInput.Properties.RetentionInDays = pointer.To(model.RetentionInDays)
 				updateInput.Properties.TotalRetentionInDays = pointer.To(model.TotalRetentionInDays)
 			}
+
+			if model.Plan == string(azuresdkhacks.TablePlanEnumAuxiliary) && model.TotalRetentionInDays != 0 {
+				updateInput.Properties.TotalRetentionInDays = pointer.To(model.TotalRetentionInDays)
+			}
+
+			if len(model.Column) > 0 {
+				updateInput.Properties.Schema = &tables.Schema{
+					Name:    pointer.To(tableName),
+					Columns: expandLogAnalyticsWorkspaceTableColumns(model.Column),
+				}
+			}
 			if err := client.CreateOrUpdateThenPoll(ctx, id, updateInput); err != nil {
 				return fmt.Errorf("failed to update table %s in workspace %s in resource group %s: %s", tableName, workspaceId.WorkspaceName, workspaceId.ResourceGroupName, err)
 			}
@@ -148,7 +263,6 @@ func (r LogAnalyticsWorkspaceTableResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.LogAnalytics.TablesClient
 			id, err := tables.ParseTableID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
@@ -159,6 +273,8 @@ func (r LogAnalyticsWorkspaceTableResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			client := r.tablesClient(metadata, state.Plan)
+
 			existing, err := client.Get(ctx, *id)
 			if err != nil {
 				return fmt.Errorf("reading Log Analytics Workspace Table %s: %v", id, err)
@@ -188,6 +304,18 @@ func (r LogAnalyticsWorkspaceTableResource) Update() sdk.ResourceFunc {
 						}
 					}
 
+					if state.Plan == string(azuresdkhacks.TablePlanEnumAuxiliary) && metadata.ResourceData.HasChange("total_retention_in_days") {
+						updateInput.Properties.TotalRetentionInDays = pointer.To(state.TotalRetentionInDays)
+					}
+
+					// new columns can be added to a custom table in-place, any other changes require the table to be re-created
+					if metadata.ResourceData.HasChange("column") && len(state.Column) > 0 {
+						updateInput.Properties.Schema = &tables.Schema{
+							Name:    pointer.To(id.TableName),
+							Columns: expandLogAnalyticsWorkspaceTableColumns(state.Column),
+						}
+					}
+
 					if err := client.CreateOrUpdateThenPoll(ctx, *id, updateInput); err != nil {
 						return fmt.Errorf("failed to update table: %s: %+v", id.TableName, err)
 					}
@@ -208,14 +336,10 @@ func (r LogAnalyticsWorkspaceTableResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("while parsing resource ID: %+v", err)
 			}
 
-			workspaceId, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Get("workspace_id").(string))
-			if err != nil {
-				return fmt.Errorf("while parsing resource ID: %+v", err)
-			}
-
-			client := metadata.Client.LogAnalytics.TablesClient
+			workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
 
-			resp, err := client.Get(ctx, *id)
+			// the preview API returns tables on any plan, whereas the stable API doesn't support the `Auxiliary` plan
+			resp, err := metadata.Client.LogAnalytics.TablesPreviewClient.Get(ctx, *id)
 			if err != nil {
 				if response.WasNotFound(resp.HttpResponse) {
 					return metadata.MarkAsGone(id)
@@ -234,7 +358,17 @@ func (r LogAnalyticsWorkspaceTableResource) Read() sdk.ResourceFunc {
 						state.RetentionInDays = pointer.From(props.RetentionInDays)
 						state.TotalRetentionInDays = pointer.From(props.TotalRetentionInDays)
 					}
+					if pointer.From(props.Plan) == azuresdkhacks.TablePlanEnumAuxiliary {
+						state.TotalRetentionInDays = pointer.From(props.TotalRetentionInDays)
+					}
 					state.Plan = string(pointer.From(props.Plan))
+					state.LastPlanModifiedDate = pointer.From(props.LastPlanModifiedDate)
+
+					// the columns are only managed for custom tables, the schema of other tables is defined by Azure
+					state.Column = make([]LogAnalyticsWorkspaceTableColumnModel, 0)
+					if logAnalyticsWorkspaceTableIsCustom(id.TableName, props) && props.Schema != nil {
+						state.Column = flattenLogAnalyticsWorkspaceTableColumns(props.Schema.Columns)
+					}
 				}
 			}
 
@@ -247,16 +381,38 @@ func (r LogAnalyticsWorkspaceTableResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			var model LogAnalyticsWorkspaceTableResourceModel
-			if err := metadata.Decode(&model); err != nil {
-				return fmt.Errorf("decoding %+v", err)
-			}
-			client := metadata.Client.LogAnalytics.TablesClient
 			id, err := tables.ParseTableID(metadata.ResourceData.Id())
 			if err != nil {
 				return fmt.Errorf("while parsing resource ID: %+v", err)
 			}
 
+			existing, err := metadata.Client.LogAnalytics.TablesPreviewClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var props *tables.TableProperties
+			if model := existing.Model; model != nil {
+				props = model.Properties
+			}
+			plan := ""
+			if props != nil {
+				plan = string(pointer.From(props.Plan))
+			}
+			client := r.tablesClient(metadata, plan)
+
+			// custom tables are created by this resource and so are deleted, whereas other tables exist as a part of the workspace
+			if logAnalyticsWorkspaceTableIsCustom(id.TableName, props) {
+				if err := client.DeleteThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+
+				return nil
+			}
+
 			// We do not delete the resource here, just set the retention to workspace default value, which is
 			// achieved by setting the value to `-1`
 			retentionInDays := utils.Int64(-1)
@@ -277,3 +433,72 @@ func (r LogAnalyticsWorkspaceTableResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+// tablesClient returns the Tables client for the specified plan - the `Auxiliary` plan is only available in a preview
+// API version, so the preview client is only used for tables on that plan.
+func (r LogAnalyticsWorkspaceTableResource) tablesClient(metadata sdk.ResourceMetaData, plan string) *tables.TablesClient {
+	if plan == string(azuresdkhacks.TablePlanEnumAuxiliary) {
+		return metadata.Client.LogAnalytics.TablesPreviewClient
+	}
+
+	return metadata.Client.LogAnalytics.TablesClient
+}
+
+func expandLogAnalyticsWorkspaceTableColumns(input []LogAnalyticsWorkspaceTableColumnModel) *[]tables.Column {
+	output := make([]tables.Column, 0)
+	for _, v := range input {
+		column := tables.Column{
+			Name: pointer.To(v.Name),
+			Type: pointer.To(tables.ColumnTypeEnum(v.Type)),
+		}
+
+		if v.Description != "" {
+			column.Description = pointer.To(v.Description)
+		}
+
+		if v.DisplayName != "" {
+			column.DisplayName = pointer.To(v.DisplayName)
+		}
+
+		output = append(output, column)
+	}
+
+	return &output
+}
+
+func flattenLogAnalyticsWorkspaceTableColumns(input *[]tables.Column) []LogAnalyticsWorkspaceTableColumnModel {
+	output := make([]LogAnalyticsWorkspaceTableColumnModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, LogAnalyticsWorkspaceTableColumnModel{
+			Name:        pointer.From(v.Name),
+			Type:        string(pointer.From(v.Type)),
+			Description: pointer.From(v.Description),
+			DisplayName: pointer.From(v.DisplayName),
+		})
+	}
+
+	return output
+}
+
+func expandLogAnalyticsWorkspaceTableColumnsFromDiff(input []interface{}) []LogAnalyticsWorkspaceTableColumnModel {
+	output := make([]LogAnalyticsWorkspaceTableColumnModel, 0)
+	for _, item := range input {
+		v, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		output = append(output, LogAnalyticsWorkspaceTableColumnModel{
+			Name:        v["name"].(string),
+			Type:        v["type"].(string),
+			Description: v["description"].(string),
+			DisplayName: v["display_name"].(string),
+		})
+	}
+
+	return output
+}
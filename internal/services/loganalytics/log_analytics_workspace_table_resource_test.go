@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
@@ -50,6 +51,62 @@ func TestAccLogAnalyticsWorkspaceTable_plan(t *testing.T) {
 	})
 }
 
+func TestAccLogAnalyticsWorkspaceTable_customTable(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customTable(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("column.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.customTableUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("column.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceTable_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customTable(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceTable_auxiliaryPlan(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.auxiliaryPlan(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("plan").HasValue("Auxiliary"),
+				check.That(data.ResourceName).Key("total_retention_in_days").HasValue("365"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t LogAnalyticsWorkspaceTableResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := tables.ParseTableID(state.ID)
 	if err != nil {
@@ -58,6 +115,9 @@ func (t LogAnalyticsWorkspaceTableResource) Exists(ctx context.Context, clients
 
 	resp, err := clients.LogAnalytics.TablesClient.Get(ctx, *id)
 	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
 		return nil, fmt.Errorf("reading Log Analytics Workspace Table (%s): %+v", id.ID(), err)
 	}
 
@@ -110,3 +170,120 @@ resource "azurerm_log_analytics_workspace_table" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (LogAnalyticsWorkspaceTableResource) customTable(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name         = "acctest%d_CL"
+  workspace_id = azurerm_log_analytics_workspace.test.id
+
+  column {
+    name = "TimeGenerated"
+    type = "dateTime"
+  }
+
+  column {
+    name        = "Message"
+    type        = "string"
+    description = "The message which was logged"
+  }
+}
+`, LogAnalyticsWorkspaceTableResource{}.template(data), data.RandomInteger)
+}
+
+func (r LogAnalyticsWorkspaceTableResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "import" {
+  name         = azurerm_log_analytics_workspace_table.test.name
+  workspace_id = azurerm_log_analytics_workspace_table.test.workspace_id
+
+  column {
+    name = "TimeGenerated"
+    type = "dateTime"
+  }
+
+  column {
+    name        = "Message"
+    type        = "string"
+    description = "The message which was logged"
+  }
+}
+`, r.customTable(data))
+}
+
+func (LogAnalyticsWorkspaceTableResource) customTableUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name                    = "acctest%d_CL"
+  workspace_id            = azurerm_log_analytics_workspace.test.id
+  retention_in_days       = 60
+  total_retention_in_days = 90
+
+  column {
+    name = "TimeGenerated"
+    type = "dateTime"
+  }
+
+  column {
+    name         = "Message"
+    type         = "string"
+    description  = "The message which was logged"
+    display_name = "Log Message"
+  }
+
+  column {
+    name = "Level"
+    type = "int"
+  }
+}
+`, LogAnalyticsWorkspaceTableResource{}.template(data), data.RandomInteger)
+}
+
+func (LogAnalyticsWorkspaceTableResource) auxiliaryPlan(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name                    = "acctest%d_CL"
+  workspace_id            = azurerm_log_analytics_workspace.test.id
+  plan                    = "Auxiliary"
+  total_retention_in_days = 365
+
+  column {
+    name = "TimeGenerated"
+    type = "dateTime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+}
+`, LogAnalyticsWorkspaceTableResource{}.template(data), data.RandomInteger)
+}
+
+func (LogAnalyticsWorkspaceTableResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  retention_in_days   = 30
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
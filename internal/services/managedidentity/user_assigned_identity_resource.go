@@ -4,6 +4,15 @@
 package managedidentity
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2023-01-31/managedidentities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedidentity/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -11,6 +20,7 @@ import (
 
 var _ sdk.Resource = UserAssignedIdentityResource{}
 var _ sdk.ResourceWithStateMigration = UserAssignedIdentityResource{}
+var _ sdk.ResourceWithList = UserAssignedIdentityResource{}
 
 func (r UserAssignedIdentityResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
@@ -20,3 +30,49 @@ func (r UserAssignedIdentityResource) StateUpgraders() sdk.StateUpgradeData {
 		},
 	}
 }
+
+func (r UserAssignedIdentityResource) List() sdk.ResourceListFunc {
+	return sdk.ResourceListFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, client *clients.Client, scope sdk.ListScope) ([]sdk.ListedResource, error) {
+			identitiesClient := client.ManagedIdentity.V20230131.ManagedIdentities
+
+			var items []managedidentities.Identity
+			if scope.ResourceGroupName != "" {
+				resourceGroupId := commonids.NewResourceGroupID(scope.SubscriptionId, scope.ResourceGroupName)
+				resp, err := identitiesClient.UserAssignedIdentitiesListByResourceGroupComplete(ctx, resourceGroupId)
+				if err != nil {
+					return nil, fmt.Errorf("listing User Assigned Identities within %s: %+v", resourceGroupId, err)
+				}
+				items = resp.Items
+			} else {
+				subscriptionId := commonids.NewSubscriptionID(scope.SubscriptionId)
+				resp, err := identitiesClient.UserAssignedIdentitiesListBySubscriptionComplete(ctx, subscriptionId)
+				if err != nil {
+					return nil, fmt.Errorf("listing User Assigned Identities within %s: %+v", subscriptionId, err)
+				}
+				items = resp.Items
+			}
+
+			output := make([]sdk.ListedResource, 0)
+			for _, item := range items {
+				id, err := commonids.ParseUserAssignedIdentityIDInsensitively(pointer.From(item.Id))
+				if err != nil {
+					return nil, err
+				}
+
+				output = append(output, sdk.ListedResource{
+					ID:   id.ID(),
+					Name: id.UserAssignedIdentityName,
+					Arguments: map[string]interface{}{
+						"name":                id.UserAssignedIdentityName,
+						"resource_group_name": id.ResourceGroupName,
+						"location":            location.Normalize(item.Location),
+					},
+				})
+			}
+
+			return output, nil
+		},
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// monitorDiagnosticCategoryGroupAllLogs is the Category Group which covers all of the log categories for a resource,
+// including any categories which are introduced in the future
+const monitorDiagnosticCategoryGroupAllLogs = "allLogs"
+
+type monitorDiagnosticCategories struct {
+	Logs           []string
+	Metrics        []string
+	CategoryGroups []string
+}
+
+// listMonitorDiagnosticCategories retrieves the log categories, metric categories and category groups which
+// are available for the specified resource.
+func listMonitorDiagnosticCategories(ctx context.Context, client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, resourceUri string) (*monitorDiagnosticCategories, error) {
+	// trim off the leading `/` since the List method doesn't expect it
+	scopeId, err := commonids.ParseScopeID(strings.TrimPrefix(resourceUri, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", resourceUri, err)
+	}
+
+	resp, err := client.DiagnosticSettingsCategoryList(ctx, *scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Diagnostics Categories for Resource %q: %+v", resourceUri, err)
+	}
+	if resp.Model == nil || resp.Model.Value == nil {
+		return nil, fmt.Errorf("retrieving Diagnostics Categories for Resource %q: `categories.Value` was nil", resourceUri)
+	}
+
+	output := monitorDiagnosticCategories{
+		Logs:           make([]string, 0),
+		Metrics:        make([]string, 0),
+		CategoryGroups: make([]string, 0),
+	}
+	categoryGroups := make(map[string]struct{})
+	for _, v := range *resp.Model.Value {
+		if v.Name == nil || v.Properties == nil {
+			continue
+		}
+
+		if v.Properties.CategoryGroups != nil {
+			for _, group := range *v.Properties.CategoryGroups {
+				if _, ok := categoryGroups[group]; !ok {
+					categoryGroups[group] = struct{}{}
+					output.CategoryGroups = append(output.CategoryGroups, group)
+				}
+			}
+		}
+
+		if v.Properties.CategoryType != nil {
+			switch *v.Properties.CategoryType {
+			case diagnosticsettingscategories.CategoryTypeLogs:
+				output.Logs = append(output.Logs, *v.Name)
+			case diagnosticsettingscategories.CategoryTypeMetrics:
+				output.Metrics = append(output.Metrics, *v.Name)
+			}
+		}
+	}
+
+	return &output, nil
+}
+
+// expandMonitorDiagnosticsSettingsAllCategories returns the log and metric settings which enable all of the available
+// categories - where the resource supports the `allLogs` Category Group this is used so that any log categories which
+// are introduced later are also enabled, otherwise each of the log categories is enabled individually.
+func expandMonitorDiagnosticsSettingsAllCategories(input monitorDiagnosticCategories) ([]diagnosticsettings.LogSettings, []diagnosticsettings.MetricSettings) {
+	logs := make([]diagnosticsettings.LogSettings, 0)
+	if containsMonitorDiagnosticCategory(input.CategoryGroups, monitorDiagnosticCategoryGroupAllLogs) {
+		logs = append(logs, diagnosticsettings.LogSettings{
+			CategoryGroup: utils.String(monitorDiagnosticCategoryGroupAllLogs),
+			Enabled:       true,
+		})
+	} else {
+		for _, category := range input.Logs {
+			logs = append(logs, diagnosticsettings.LogSettings{
+				Category: utils.String(category),
+				Enabled:  true,
+			})
+		}
+	}
+
+	metrics := make([]diagnosticsettings.MetricSettings, 0)
+	for _, category := range input.Metrics {
+		metrics = append(metrics, diagnosticsettings.MetricSettings{
+			Category: utils.String(category),
+			Enabled:  true,
+		})
+	}
+
+	return logs, metrics
+}
+
+// unavailableMonitorDiagnosticCategories returns the log categories, category groups and metric categories which are
+// specified but which are no longer available for the resource.
+func unavailableMonitorDiagnosticCategories(logs []diagnosticsettings.LogSettings, metrics []diagnosticsettings.MetricSettings, available monitorDiagnosticCategories) []string {
+	output := make([]string, 0)
+
+	for _, v := range logs {
+		if v.Category != nil && !containsMonitorDiagnosticCategory(available.Logs, *v.Category) {
+			output = append(output, fmt.Sprintf("log category %q", *v.Category))
+		}
+		if v.CategoryGroup != nil && !containsMonitorDiagnosticCategory(available.CategoryGroups, *v.CategoryGroup) {
+			output = append(output, fmt.Sprintf("log category group %q", *v.CategoryGroup))
+		}
+	}
+
+	for _, v := range metrics {
+		if v.Category != nil && !containsMonitorDiagnosticCategory(available.Metrics, *v.Category) {
+			output = append(output, fmt.Sprintf("metric category %q", *v.Category))
+		}
+	}
+
+	return output
+}
+
+// containsMonitorDiagnosticCategory returns whether `value` is contained within `input`, since the casing of the
+// categories and category groups returned from the API isn't consistent with the casing in the configuration
+func containsMonitorDiagnosticCategory(input []string, value string) bool {
+	for _, v := range input {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filterMonitorDiagnosticSettingsToCategories returns the log and metric settings for the available categories and
+// category groups, omitting any settings which the service has added for categories which aren't listed as available.
+func filterMonitorDiagnosticSettingsToCategories(logs *[]diagnosticsettings.LogSettings, metrics *[]diagnosticsettings.MetricSettings, available monitorDiagnosticCategories) ([]diagnosticsettings.LogSettings, []diagnosticsettings.MetricSettings) {
+	filteredLogs := make([]diagnosticsettings.LogSettings, 0)
+	if logs != nil {
+		for _, v := range *logs {
+			if v.Category != nil && containsMonitorDiagnosticCategory(available.Logs, *v.Category) {
+				filteredLogs = append(filteredLogs, v)
+				continue
+			}
+			if v.CategoryGroup != nil && containsMonitorDiagnosticCategory(available.CategoryGroups, *v.CategoryGroup) {
+				filteredLogs = append(filteredLogs, v)
+			}
+		}
+	}
+
+	filteredMetrics := make([]diagnosticsettings.MetricSettings, 0)
+	if metrics != nil {
+		for _, v := range *metrics {
+			if v.Category != nil && containsMonitorDiagnosticCategory(available.Metrics, *v.Category) {
+				filteredMetrics = append(filteredMetrics, v)
+			}
+		}
+	}
+
+	return filteredLogs, filteredMetrics
+}
+
+// monitorDiagnosticSettingsAllCategoriesEnabled returns whether the log and metric settings enable each of the
+// categories which `all_categories` enables - which is false when a category has been disabled outside of Terraform.
+func monitorDiagnosticSettingsAllCategoriesEnabled(logs []diagnosticsettings.LogSettings, metrics []diagnosticsettings.MetricSettings, available monitorDiagnosticCategories) bool {
+	expectedLogs, expectedMetrics := expandMonitorDiagnosticsSettingsAllCategories(available)
+
+	for _, expected := range expectedLogs {
+		enabled := false
+		for _, v := range logs {
+			if !v.Enabled {
+				continue
+			}
+			if expected.Category != nil && v.Category != nil && strings.EqualFold(*expected.Category, *v.Category) {
+				enabled = true
+			}
+			if expected.CategoryGroup != nil && v.CategoryGroup != nil && strings.EqualFold(*expected.CategoryGroup, *v.CategoryGroup) {
+				enabled = true
+			}
+		}
+		if !enabled {
+			return false
+		}
+	}
+
+	for _, expected := range expectedMetrics {
+		enabled := false
+		for _, v := range metrics {
+			if v.Enabled && v.Category != nil && strings.EqualFold(*expected.Category, *v.Category) {
+				enabled = true
+			}
+		}
+		if !enabled {
+			return false
+		}
+	}
+
+	return true
+}
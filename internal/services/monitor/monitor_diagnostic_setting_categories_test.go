@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestExpandMonitorDiagnosticsSettingsAllCategories(t *testing.T) {
+	testData := []struct {
+		Name                  string
+		Input                 monitorDiagnosticCategories
+		ExpectedLogs          []string
+		ExpectedCategoryGroup bool
+		ExpectedMetrics       []string
+	}{
+		{
+			Name: "All Logs Category Group",
+			Input: monitorDiagnosticCategories{
+				Logs:           []string{"AuditEvent", "AzurePolicyEvaluationDetails"},
+				Metrics:        []string{"AllMetrics"},
+				CategoryGroups: []string{"audit", "allLogs"},
+			},
+			ExpectedLogs:          []string{"allLogs"},
+			ExpectedCategoryGroup: true,
+			ExpectedMetrics:       []string{"AllMetrics"},
+		},
+		{
+			Name: "No Category Groups",
+			Input: monitorDiagnosticCategories{
+				Logs:    []string{"AuditEvent", "AzurePolicyEvaluationDetails"},
+				Metrics: []string{},
+			},
+			ExpectedLogs:    []string{"AuditEvent", "AzurePolicyEvaluationDetails"},
+			ExpectedMetrics: []string{},
+		},
+		{
+			Name: "Metrics Only",
+			Input: monitorDiagnosticCategories{
+				Metrics: []string{"AllMetrics"},
+			},
+			ExpectedLogs:    []string{},
+			ExpectedMetrics: []string{"AllMetrics"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		logs, metrics := expandMonitorDiagnosticsSettingsAllCategories(v.Input)
+		if len(logs) != len(v.ExpectedLogs) {
+			t.Fatalf("expected %d logs but got %d", len(v.ExpectedLogs), len(logs))
+		}
+		for i, log := range logs {
+			if !log.Enabled {
+				t.Fatalf("expected log %d to be enabled", i)
+			}
+
+			actual := log.Category
+			if v.ExpectedCategoryGroup {
+				actual = log.CategoryGroup
+			}
+			if actual == nil || *actual != v.ExpectedLogs[i] {
+				t.Fatalf("expected log %d to be %q but got %v", i, v.ExpectedLogs[i], actual)
+			}
+		}
+
+		if len(metrics) != len(v.ExpectedMetrics) {
+			t.Fatalf("expected %d metrics but got %d", len(v.ExpectedMetrics), len(metrics))
+		}
+		for i, metric := range metrics {
+			if !metric.Enabled || metric.Category == nil || *metric.Category != v.ExpectedMetrics[i] {
+				t.Fatalf("expected metric %d to be %q and enabled", i, v.ExpectedMetrics[i])
+			}
+		}
+	}
+}
+
+func TestUnavailableMonitorDiagnosticCategories(t *testing.T) {
+	available := monitorDiagnosticCategories{
+		Logs:           []string{"AuditEvent"},
+		Metrics:        []string{"AllMetrics"},
+		CategoryGroups: []string{"audit", "allLogs"},
+	}
+
+	testData := []struct {
+		Name     string
+		Logs     []diagnosticsettings.LogSettings
+		Metrics  []diagnosticsettings.MetricSettings
+		Expected int
+	}{
+		{
+			Name: "All Available",
+			Logs: []diagnosticsettings.LogSettings{
+				{
+					Category: utils.String("AuditEvent"),
+				},
+				{
+					CategoryGroup: utils.String("AllLogs"),
+				},
+			},
+			Metrics: []diagnosticsettings.MetricSettings{
+				{
+					Category: utils.String("AllMetrics"),
+				},
+			},
+			Expected: 0,
+		},
+		{
+			Name: "Unavailable Category And Group",
+			Logs: []diagnosticsettings.LogSettings{
+				{
+					Category: utils.String("AuditEvent"),
+				},
+				{
+					Category: utils.String("RemovedCategory"),
+				},
+				{
+					CategoryGroup: utils.String("removedGroup"),
+				},
+			},
+			Expected: 2,
+		},
+		{
+			Name: "Unavailable Metric",
+			Metrics: []diagnosticsettings.MetricSettings{
+				{
+					Category: utils.String("Transaction"),
+				},
+			},
+			Expected: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := unavailableMonitorDiagnosticCategories(v.Logs, v.Metrics, available)
+		if len(actual) != v.Expected {
+			t.Fatalf("expected %d unavailable categories but got %d: %+v", v.Expected, len(actual), actual)
+		}
+	}
+}
+
+func TestFilterMonitorDiagnosticSettingsToCategories(t *testing.T) {
+	available := monitorDiagnosticCategories{
+		Logs:           []string{"AuditEvent"},
+		Metrics:        []string{"AllMetrics"},
+		CategoryGroups: []string{"allLogs"},
+	}
+
+	logs := &[]diagnosticsettings.LogSettings{
+		{Category: utils.String("auditevent"), Enabled: true},
+		{Category: utils.String("AddedByTheService"), Enabled: false},
+		{CategoryGroup: utils.String("allLogs"), Enabled: true},
+		{CategoryGroup: utils.String("audit"), Enabled: false},
+	}
+	metrics := &[]diagnosticsettings.MetricSettings{
+		{Category: utils.String("AllMetrics"), Enabled: true},
+		{Category: utils.String("Transaction"), Enabled: false},
+	}
+
+	filteredLogs, filteredMetrics := filterMonitorDiagnosticSettingsToCategories(logs, metrics, available)
+	if len(filteredLogs) != 2 {
+		t.Fatalf("expected 2 logs but got %d", len(filteredLogs))
+	}
+	if *filteredLogs[0].Category != "auditevent" || *filteredLogs[1].CategoryGroup != "allLogs" {
+		t.Fatalf("expected the `auditevent` category and `allLogs` category group but got %+v", filteredLogs)
+	}
+	if len(filteredMetrics) != 1 || *filteredMetrics[0].Category != "AllMetrics" {
+		t.Fatalf("expected only the `AllMetrics` metric but got %+v", filteredMetrics)
+	}
+
+	filteredLogs, filteredMetrics = filterMonitorDiagnosticSettingsToCategories(nil, nil, available)
+	if len(filteredLogs) != 0 || len(filteredMetrics) != 0 {
+		t.Fatalf("expected no logs or metrics but got %+v / %+v", filteredLogs, filteredMetrics)
+	}
+}
+
+func TestMonitorDiagnosticSettingsAllCategoriesEnabled(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Available monitorDiagnosticCategories
+		Logs      []diagnosticsettings.LogSettings
+		Metrics   []diagnosticsettings.MetricSettings
+		Expected  bool
+	}{
+		{
+			Name: "All Logs Category Group enabled",
+			Available: monitorDiagnosticCategories{
+				Logs:           []string{"AuditEvent"},
+				Metrics:        []string{"AllMetrics"},
+				CategoryGroups: []string{"allLogs"},
+			},
+			Logs: []diagnosticsettings.LogSettings{
+				{CategoryGroup: utils.String("alllogs"), Enabled: true},
+			},
+			Metrics: []diagnosticsettings.MetricSettings{
+				{Category: utils.String("AllMetrics"), Enabled: true},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Metric disabled",
+			Available: monitorDiagnosticCategories{
+				Metrics:        []string{"AllMetrics"},
+				CategoryGroups: []string{"allLogs"},
+			},
+			Logs: []diagnosticsettings.LogSettings{
+				{CategoryGroup: utils.String("allLogs"), Enabled: true},
+			},
+			Metrics: []diagnosticsettings.MetricSettings{
+				{Category: utils.String("AllMetrics"), Enabled: false},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Log category removed",
+			Available: monitorDiagnosticCategories{
+				Logs: []string{"AuditEvent", "AzurePolicyEvaluationDetails"},
+			},
+			Logs: []diagnosticsettings.LogSettings{
+				{Category: utils.String("AuditEvent"), Enabled: true},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Each log category enabled",
+			Available: monitorDiagnosticCategories{
+				Logs: []string{"AuditEvent", "AzurePolicyEvaluationDetails"},
+			},
+			Logs: []diagnosticsettings.LogSettings{
+				{Category: utils.String("AuditEvent"), Enabled: true},
+				{Category: utils.String("AzurePolicyEvaluationDetails"), Enabled: true},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := monitorDiagnosticSettingsAllCategoriesEnabled(v.Logs, v.Metrics, v.Available); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	authRuleParse "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/authorizationrulesnamespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -30,10 +32,20 @@ import (
 
 func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
-		Create: resourceMonitorDiagnosticSettingCreate,
-		Read:   resourceMonitorDiagnosticSettingRead,
-		Update: resourceMonitorDiagnosticSettingUpdate,
-		Delete: resourceMonitorDiagnosticSettingDelete,
+		// Create and Update return warnings for categories which are no longer available, which requires the
+		// context-aware functions
+		CreateContext: func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			return withMonitorDiagnosticSettingWarnings(d, meta, resourceMonitorDiagnosticSettingCreate)
+		},
+		ReadContext: func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(resourceMonitorDiagnosticSettingRead(d, meta))
+		},
+		UpdateContext: func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			return withMonitorDiagnosticSettingWarnings(d, meta, resourceMonitorDiagnosticSettingUpdate)
+		},
+		DeleteContext: func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(resourceMonitorDiagnosticSettingDelete(d, meta))
+		},
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := ParseMonitorDiagnosticId(id)
@@ -107,11 +119,19 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"all_categories": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				AtLeastOneOf:  []string{"all_categories", "enabled_log", "metric"},
+				ConflictsWith: []string{"enabled_log", "metric"},
+			},
+
 			"enabled_log": {
 				Type:         pluginsdk.TypeSet,
 				Optional:     true,
 				Computed:     !features.FourPointOhBeta(),
-				AtLeastOneOf: []string{"enabled_log", "metric"},
+				AtLeastOneOf: []string{"all_categories", "enabled_log", "metric"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
@@ -154,7 +174,7 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 			"metric": {
 				Type:         pluginsdk.TypeSet,
 				Optional:     true,
-				AtLeastOneOf: []string{"enabled_log", "metric"},
+				AtLeastOneOf: []string{"all_categories", "enabled_log", "metric"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
@@ -200,7 +220,7 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 			Type:         pluginsdk.TypeSet,
 			Optional:     true,
 			Computed:     true,
-			AtLeastOneOf: []string{"enabled_log", "log", "metric"},
+			AtLeastOneOf: []string{"all_categories", "enabled_log", "log", "metric"},
 			Deprecated:   "`log` has been superseded by `enabled_log` and will be removed in version 4.0 of the AzureRM Provider.",
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
@@ -247,16 +267,20 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 			Set: resourceMonitorDiagnosticLogSettingHash,
 		}
 
-		resource.Schema["metric"].AtLeastOneOf = []string{"enabled_log", "log", "metric"}
-		resource.Schema["enabled_log"].AtLeastOneOf = []string{"enabled_log", "log", "metric"}
+		resource.Schema["log"].ConflictsWith = []string{"all_categories"}
+		resource.Schema["all_categories"].AtLeastOneOf = []string{"all_categories", "enabled_log", "log", "metric"}
+		resource.Schema["all_categories"].ConflictsWith = []string{"enabled_log", "log", "metric"}
+		resource.Schema["metric"].AtLeastOneOf = []string{"all_categories", "enabled_log", "log", "metric"}
+		resource.Schema["enabled_log"].AtLeastOneOf = []string{"all_categories", "enabled_log", "log", "metric"}
 		resource.Schema["enabled_log"].ConflictsWith = []string{"log"}
 	}
 
 	return resource
 }
 
-func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta interface{}, warnings *diag.Diagnostics) error {
 	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
@@ -309,6 +333,17 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	if d.Get("all_categories").(bool) {
+		categories, err := listMonitorDiagnosticCategories(ctx, categoriesClient, id.ResourceUri)
+		if err != nil {
+			return err
+		}
+		logs, metrics = expandMonitorDiagnosticsSettingsAllCategories(*categories)
+		hasEnabledLogs = len(logs) > 0
+	} else {
+		*warnings = append(*warnings, unavailableMonitorDiagnosticCategoriesWarnings(ctx, categoriesClient, id.ResourceUri, logs, metrics)...)
+	}
+
 	// if no logs/metrics are enabled the API "creates" but 404's on Read
 	hasEnabledMetrics := false
 	if !hasEnabledLogs {
@@ -366,8 +401,9 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 	return resourceMonitorDiagnosticSettingRead(d, meta)
 }
 
-func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta interface{}, warnings *diag.Diagnostics) error {
 	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
@@ -428,6 +464,17 @@ func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	if d.Get("all_categories").(bool) {
+		categories, err := listMonitorDiagnosticCategories(ctx, categoriesClient, id.ResourceUri)
+		if err != nil {
+			return err
+		}
+		logs, metrics = expandMonitorDiagnosticsSettingsAllCategories(*categories)
+		hasEnabledLogs = len(logs) > 0
+	} else {
+		*warnings = append(*warnings, unavailableMonitorDiagnosticCategoriesWarnings(ctx, categoriesClient, id.ResourceUri, logs, metrics)...)
+	}
+
 	// if no logs/metrics are enabled the API "creates" but 404's on Read
 	hasEnabledMetrics := false
 	if !hasEnabledLogs {
@@ -484,6 +531,7 @@ func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta inte
 
 func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -559,17 +607,47 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("log_analytics_destination_type", logAnalyticsDestinationType)
 
 			enabledLogs := flattenMonitorDiagnosticEnabledLogs(resp.Model.Properties.Logs)
+			logs := flattenMonitorDiagnosticLogs(resp.Model.Properties.Logs)
+			metrics := flattenMonitorDiagnosticMetrics(resp.Model.Properties.Metrics)
+			if d.Get("all_categories").(bool) {
+				// `metric` isn't Computed (nor is `enabled_log` in 4.0), so these can't be set without causing a diff
+				// against the configuration - drift is instead surfaced via `all_categories` below
+				metrics = make([]interface{}, 0)
+				if features.FourPointOhBeta() {
+					enabledLogs = make([]interface{}, 0)
+				}
+
+				categories, err := listMonitorDiagnosticCategories(ctx, categoriesClient, id.ResourceUri)
+				if err != nil {
+					log.Printf("[DEBUG] unable to check the Diagnostic Categories for Resource %q: %+v", id.ResourceUri, err)
+				} else {
+					// the service can add settings for categories which aren't listed as available, these are
+					// omitted so that only the categories which `all_categories` manages are compared
+					filteredLogs, filteredMetrics := filterMonitorDiagnosticSettingsToCategories(props.Logs, props.Metrics, *categories)
+					if !features.FourPointOhBeta() {
+						enabledLogs = flattenMonitorDiagnosticEnabledLogs(&filteredLogs)
+						logs = flattenMonitorDiagnosticLogs(&filteredLogs)
+					}
+
+					// a category which has been disabled outside of Terraform causes a diff on `all_categories`
+					if !monitorDiagnosticSettingsAllCategoriesEnabled(filteredLogs, filteredMetrics, *categories) {
+						log.Printf("[DEBUG] not all of the Diagnostic Categories are enabled for Monitor Diagnostics Setting %q for Resource %q", id.DiagnosticSettingName, id.ResourceUri)
+						d.Set("all_categories", false)
+					}
+				}
+			}
+
 			if err = d.Set("enabled_log", enabledLogs); err != nil {
 				return fmt.Errorf("setting `enabled_log`: %+v", err)
 			}
 
 			if !features.FourPointOhBeta() {
-				if err = d.Set("log", flattenMonitorDiagnosticLogs(resp.Model.Properties.Logs)); err != nil {
+				if err = d.Set("log", logs); err != nil {
 					return fmt.Errorf("setting `log`: %+v", err)
 				}
 			}
 
-			if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Model.Properties.Metrics)); err != nil {
+			if err := d.Set("metric", metrics); err != nil {
 				return fmt.Errorf("setting `metric`: %+v", err)
 			}
 		}
@@ -627,6 +705,41 @@ func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *dia
 	}
 }
 
+// withMonitorDiagnosticSettingWarnings calls the specified Create or Update function, returning any warnings alongside
+// the error (if any) as diagnostics, so that the warnings are surfaced to the user
+func withMonitorDiagnosticSettingWarnings(d *pluginsdk.ResourceData, meta interface{}, f func(d *pluginsdk.ResourceData, meta interface{}, warnings *diag.Diagnostics) error) diag.Diagnostics {
+	warnings := make(diag.Diagnostics, 0)
+	if err := f(d, meta, &warnings); err != nil {
+		return append(warnings, diag.FromErr(err)...)
+	}
+
+	return warnings
+}
+
+// unavailableMonitorDiagnosticCategoriesWarnings returns a warning for any of the specified log categories, category groups
+// or metric categories which are no longer available for the resource - this is a best-effort check, since not all
+// resource types support listing the available categories.
+func unavailableMonitorDiagnosticCategoriesWarnings(ctx context.Context, client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, resourceUri string, logs []diagnosticsettings.LogSettings, metrics []diagnosticsettings.MetricSettings) diag.Diagnostics {
+	categories, err := listMonitorDiagnosticCategories(ctx, client, resourceUri)
+	if err != nil {
+		log.Printf("[DEBUG] unable to check the Diagnostic Categories for Resource %q: %+v", resourceUri, err)
+		return nil
+	}
+
+	unavailable := unavailableMonitorDiagnosticCategories(logs, metrics, *categories)
+	if len(unavailable) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Diagnostic Categories are no longer available",
+			Detail:   fmt.Sprintf("The following categories are no longer available for Resource %q and should be removed from the configuration: %s", resourceUri, strings.Join(unavailable, ", ")),
+		},
+	}
+}
+
 func expandMonitorDiagnosticsSettingsLogs(input []interface{}) (*[]diagnosticsettings.LogSettings, error) {
 	results := make([]diagnosticsettings.LogSettings, 0)
 
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
+)
+
+// kubernetesPrometheusPipelineDefaultName mirrors the naming used by the Portal when onboarding a cluster
+// to Managed Prometheus, truncated to the maximum length permitted for a Data Collection Endpoint
+func kubernetesPrometheusPipelineDefaultName(location, clusterName string) string {
+	name := fmt.Sprintf("MSProm-%s-%s", location, clusterName)
+	if len(name) > kubernetesPrometheusPipelineMaxNameLength {
+		name = name[:kubernetesPrometheusPipelineMaxNameLength]
+	}
+	return strings.TrimRight(name, "-_")
+}
+
+func flattenKubernetesPrometheusPipelineWorkspaceId(input *datacollectionrules.DestinationsSpec) (string, error) {
+	if input == nil || input.MonitoringAccounts == nil {
+		return "", nil
+	}
+
+	for _, account := range *input.MonitoringAccounts {
+		if pointer.From(account.Name) != kubernetesPrometheusPipelineDestinationName {
+			continue
+		}
+
+		workspaceId, err := azuremonitorworkspaces.ParseAccountIDInsensitively(pointer.From(account.AccountResourceId))
+		if err != nil {
+			return "", err
+		}
+		return workspaceId.ID(), nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionruleassociations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	kubernetesPrometheusPipelineDataSourceName  = "PrometheusDataSource"
+	kubernetesPrometheusPipelineDestinationName = "MonitoringAccount1"
+	kubernetesPrometheusPipelineMaxNameLength   = 44
+)
+
+type KubernetesPrometheusPipelineModel struct {
+	Name                          string            `tfschema:"name"`
+	KubernetesClusterId           string            `tfschema:"kubernetes_cluster_id"`
+	MonitorWorkspaceId            string            `tfschema:"monitor_workspace_id"`
+	Tags                          map[string]string `tfschema:"tags"`
+	Location                      string            `tfschema:"location"`
+	DataCollectionEndpointId      string            `tfschema:"data_collection_endpoint_id"`
+	DataCollectionRuleId          string            `tfschema:"data_collection_rule_id"`
+	DataCollectionRuleImmutableId string            `tfschema:"data_collection_rule_immutable_id"`
+}
+
+type KubernetesPrometheusPipelineResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesPrometheusPipelineResource{}
+
+func (r KubernetesPrometheusPipelineResource) ResourceType() string {
+	return "azurerm_monitor_kubernetes_prometheus_pipeline"
+}
+
+func (r KubernetesPrometheusPipelineResource) ModelObject() interface{} {
+	return &KubernetesPrometheusPipelineModel{}
+}
+
+func (r KubernetesPrometheusPipelineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return datacollectionruleassociations.ValidateScopedDataCollectionRuleAssociationID
+}
+
+func (r KubernetesPrometheusPipelineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"kubernetes_cluster_id": commonschema.ResourceIDReferenceRequiredForceNew(&commonids.KubernetesClusterId{}),
+
+		"monitor_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azuremonitorworkspaces.ValidateAccountID,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$`),
+				"`name` must be between 3 and 44 characters long, can only contain letters, numbers and dashes, and must begin and end with a letter or number",
+			),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r KubernetesPrometheusPipelineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"data_collection_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"data_collection_rule_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"data_collection_rule_immutable_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesPrometheusPipelineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			workspacesClient := metadata.Client.Monitor.WorkspacesClient
+			endpointsClient := metadata.Client.Monitor.DataCollectionEndpointsClient
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+			associationsClient := metadata.Client.Monitor.DataCollectionRuleAssociationsClient
+
+			var model KubernetesPrometheusPipelineModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			workspaceId, err := azuremonitorworkspaces.ParseAccountID(model.MonitorWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			workspace, err := workspacesClient.Get(ctx, *workspaceId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *workspaceId, err)
+			}
+			if workspace.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *workspaceId)
+			}
+			workspaceLocation := location.Normalize(workspace.Model.Location)
+
+			name := model.Name
+			if name == "" {
+				name = kubernetesPrometheusPipelineDefaultName(workspaceLocation, clusterId.ManagedClusterName)
+			}
+
+			id := datacollectionruleassociations.NewScopedDataCollectionRuleAssociationID(clusterId.ID(), name)
+			existing, err := associationsClient.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the Data Collection Endpoint and Rule live alongside the Azure Monitor Workspace they forward metrics to,
+			// since these are deleted alongside this resource they mustn't already exist
+			endpointId := datacollectionendpoints.NewDataCollectionEndpointID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, name)
+			existingEndpoint, err := endpointsClient.Get(ctx, endpointId)
+			if err != nil && !response.WasNotFound(existingEndpoint.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", endpointId, err)
+			}
+			if !response.WasNotFound(existingEndpoint.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), endpointId)
+			}
+
+			ruleId := datacollectionrules.NewDataCollectionRuleID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, name)
+			existingRule, err := rulesClient.Get(ctx, ruleId)
+			if err != nil && !response.WasNotFound(existingRule.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", ruleId, err)
+			}
+			if !response.WasNotFound(existingRule.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), ruleId)
+			}
+
+			endpoint := datacollectionendpoints.DataCollectionEndpointResource{
+				Kind:       pointer.To(datacollectionendpoints.KnownDataCollectionEndpointResourceKindLinux),
+				Location:   workspaceLocation,
+				Properties: &datacollectionendpoints.DataCollectionEndpoint{},
+				Tags:       pointer.To(model.Tags),
+			}
+			if _, err := endpointsClient.Create(ctx, endpointId, endpoint); err != nil {
+				return fmt.Errorf("creating %s: %+v", endpointId, err)
+			}
+
+			rule := expandKubernetesPrometheusPipelineDataCollectionRule(workspaceLocation, endpointId, *workspaceId, model.Tags)
+			if _, err := rulesClient.Create(ctx, ruleId, rule); err != nil {
+				err = fmt.Errorf("creating %s: %+v", ruleId, err)
+				return r.cleanUpAfterFailedCreate(ctx, metadata, err, nil, &endpointId)
+			}
+
+			association := datacollectionruleassociations.DataCollectionRuleAssociationProxyOnlyResource{
+				Name: pointer.To(name),
+				Properties: &datacollectionruleassociations.DataCollectionRuleAssociation{
+					DataCollectionRuleId: pointer.To(ruleId.ID()),
+					Description:          pointer.To("Association of the Managed Prometheus Data Collection Rule with the Kubernetes Cluster"),
+				},
+			}
+			if _, err := associationsClient.Create(ctx, id, association); err != nil {
+				err = fmt.Errorf("creating %s: %+v", id, err)
+				return r.cleanUpAfterFailedCreate(ctx, metadata, err, &ruleId, &endpointId)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesPrometheusPipelineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+			associationsClient := metadata.Client.Monitor.DataCollectionRuleAssociationsClient
+
+			id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			clusterId, err := commonids.ParseKubernetesClusterIDInsensitively(id.ResourceUri)
+			if err != nil {
+				return err
+			}
+
+			resp, err := associationsClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesPrometheusPipelineModel{
+				Name:                id.DataCollectionRuleAssociationName,
+				KubernetesClusterId: clusterId.ID(),
+			}
+
+			ruleIdRaw := ""
+			if model := resp.Model; model != nil && model.Properties != nil {
+				ruleIdRaw = pointer.From(model.Properties.DataCollectionRuleId)
+			}
+			if ruleIdRaw == "" {
+				// the association has been re-pointed at something other than a Data Collection Rule, so it needs to be recreated
+				return metadata.MarkAsGone(id)
+			}
+
+			ruleId, err := datacollectionrules.ParseDataCollectionRuleIDInsensitively(ruleIdRaw)
+			if err != nil {
+				return err
+			}
+
+			rule, err := rulesClient.Get(ctx, *ruleId)
+			if err != nil {
+				if response.WasNotFound(rule.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *ruleId, err)
+			}
+			state.DataCollectionRuleId = ruleId.ID()
+
+			if model := rule.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.DataCollectionRuleImmutableId = pointer.From(props.ImmutableId)
+
+					if v := pointer.From(props.DataCollectionEndpointId); v != "" {
+						endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointIDInsensitively(v)
+						if err != nil {
+							return err
+						}
+						state.DataCollectionEndpointId = endpointId.ID()
+					}
+
+					workspaceId, err := flattenKubernetesPrometheusPipelineWorkspaceId(props.Destinations)
+					if err != nil {
+						return err
+					}
+					state.MonitorWorkspaceId = workspaceId
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesPrometheusPipelineResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			endpointsClient := metadata.Client.Monitor.DataCollectionEndpointsClient
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+
+			id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesPrometheusPipelineModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointID(model.DataCollectionEndpointId)
+				if err != nil {
+					return err
+				}
+				if _, err := endpointsClient.Update(ctx, *endpointId, datacollectionendpoints.ResourceForUpdate{Tags: pointer.To(model.Tags)}); err != nil {
+					return fmt.Errorf("updating %s for %s: %+v", *endpointId, *id, err)
+				}
+
+				ruleId, err := datacollectionrules.ParseDataCollectionRuleID(model.DataCollectionRuleId)
+				if err != nil {
+					return err
+				}
+				if _, err := rulesClient.Update(ctx, *ruleId, datacollectionrules.ResourceForUpdate{Tags: pointer.To(model.Tags)}); err != nil {
+					return fmt.Errorf("updating %s for %s: %+v", *ruleId, *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesPrometheusPipelineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			endpointsClient := metadata.Client.Monitor.DataCollectionEndpointsClient
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+			associationsClient := metadata.Client.Monitor.DataCollectionRuleAssociationsClient
+
+			id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesPrometheusPipelineModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the association must go first, since a Data Collection Rule can't be deleted whilst it's in use
+			if resp, err := associationsClient.Delete(ctx, *id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if model.DataCollectionRuleId != "" {
+				ruleId, err := datacollectionrules.ParseDataCollectionRuleID(model.DataCollectionRuleId)
+				if err != nil {
+					return err
+				}
+				if resp, err := rulesClient.Delete(ctx, *ruleId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s for %s: %+v", *ruleId, *id, err)
+				}
+			}
+
+			if model.DataCollectionEndpointId != "" {
+				endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointID(model.DataCollectionEndpointId)
+				if err != nil {
+					return err
+				}
+				if resp, err := endpointsClient.Delete(ctx, *endpointId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s for %s: %+v", *endpointId, *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// cleanUpAfterFailedCreate removes the Data Collection Rule and Endpoint created by a failed Create, since these
+// aren't tracked in the state they'd otherwise be left behind - any errors are returned alongside the original error.
+func (r KubernetesPrometheusPipelineResource) cleanUpAfterFailedCreate(ctx context.Context, metadata sdk.ResourceMetaData, createErr error, ruleId *datacollectionrules.DataCollectionRuleId, endpointId *datacollectionendpoints.DataCollectionEndpointId) error {
+	errs := []string{createErr.Error()}
+
+	if ruleId != nil {
+		if resp, err := metadata.Client.Monitor.DataCollectionRulesClient.Delete(ctx, *ruleId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			errs = append(errs, fmt.Sprintf("cleaning up %s: %+v", *ruleId, err))
+		}
+	}
+
+	if endpointId != nil {
+		if resp, err := metadata.Client.Monitor.DataCollectionEndpointsClient.Delete(ctx, *endpointId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			errs = append(errs, fmt.Sprintf("cleaning up %s: %+v", *endpointId, err))
+		}
+	}
+
+	return fmt.Errorf("%s", strings.Join(errs, "\n"))
+}
+
+func expandKubernetesPrometheusPipelineDataCollectionRule(location string, endpointId datacollectionendpoints.DataCollectionEndpointId, workspaceId azuremonitorworkspaces.AccountId, tags map[string]string) datacollectionrules.DataCollectionRuleResource {
+	return datacollectionrules.DataCollectionRuleResource{
+		Kind:     pointer.To(datacollectionrules.KnownDataCollectionRuleResourceKindLinux),
+		Location: location,
+		Properties: &datacollectionrules.DataCollectionRule{
+			DataCollectionEndpointId: pointer.To(endpointId.ID()),
+			DataFlows: &[]datacollectionrules.DataFlow{
+				{
+					Destinations: &[]string{kubernetesPrometheusPipelineDestinationName},
+					Streams: &[]datacollectionrules.KnownDataFlowStreams{
+						datacollectionrules.KnownDataFlowStreams(datacollectionrules.KnownPrometheusForwarderDataSourceStreamsMicrosoftNegativePrometheusMetrics),
+					},
+				},
+			},
+			DataSources: &datacollectionrules.DataSourcesSpec{
+				PrometheusForwarder: &[]datacollectionrules.PrometheusForwarderDataSource{
+					{
+						Name: pointer.To(kubernetesPrometheusPipelineDataSourceName),
+						Streams: &[]datacollectionrules.KnownPrometheusForwarderDataSourceStreams{
+							datacollectionrules.KnownPrometheusForwarderDataSourceStreamsMicrosoftNegativePrometheusMetrics,
+						},
+						LabelIncludeFilter: &map[string]string{},
+					},
+				},
+			},
+			Description: pointer.To("Data Collection Rule forwarding Managed Prometheus metrics to an Azure Monitor Workspace"),
+			Destinations: &datacollectionrules.DestinationsSpec{
+				MonitoringAccounts: &[]datacollectionrules.MonitoringAccountDestination{
+					{
+						AccountResourceId: pointer.To(workspaceId.ID()),
+						Name:              pointer.To(kubernetesPrometheusPipelineDestinationName),
+					},
+				},
+			},
+		},
+		Tags: pointer.To(tags),
+	}
+}
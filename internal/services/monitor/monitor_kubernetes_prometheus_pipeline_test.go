@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionrules"
+)
+
+func TestKubernetesPrometheusPipelineDefaultName(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Location    string
+		ClusterName string
+		Expected    string
+	}{
+		{
+			Name:        "Short Name",
+			Location:    "westeurope",
+			ClusterName: "aks1",
+			Expected:    "MSProm-westeurope-aks1",
+		},
+		{
+			Name:        "Truncated Name",
+			Location:    "australiasoutheast",
+			ClusterName: "my-production-kubernetes-cluster",
+			Expected:    "MSProm-australiasoutheast-my-production-kube",
+		},
+		{
+			Name:        "Truncated On A Dash",
+			Location:    "westeurope",
+			ClusterName: "production-cluster-westeu-x1",
+			Expected:    "MSProm-westeurope-production-cluster-westeu",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := kubernetesPrometheusPipelineDefaultName(v.Location, v.ClusterName)
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+		if len(actual) > kubernetesPrometheusPipelineMaxNameLength {
+			t.Fatalf("expected %q to be at most %d characters", actual, kubernetesPrometheusPipelineMaxNameLength)
+		}
+	}
+}
+
+func TestFlattenKubernetesPrometheusPipelineWorkspaceId(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    *datacollectionrules.DestinationsSpec
+		Expected string
+	}{
+		{
+			Name:     "Nil Destinations",
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Name: "Other Destination Only",
+			Input: &datacollectionrules.DestinationsSpec{
+				MonitoringAccounts: &[]datacollectionrules.MonitoringAccountDestination{
+					{
+						AccountResourceId: pointer.To("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/rg1/providers/Microsoft.Monitor/accounts/other"),
+						Name:              pointer.To("other"),
+					},
+				},
+			},
+			Expected: "",
+		},
+		{
+			Name: "Lower Cased Workspace Id",
+			Input: &datacollectionrules.DestinationsSpec{
+				MonitoringAccounts: &[]datacollectionrules.MonitoringAccountDestination{
+					{
+						AccountResourceId: pointer.To("/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/rg1/providers/microsoft.monitor/accounts/workspace1"),
+						Name:              pointer.To(kubernetesPrometheusPipelineDestinationName),
+					},
+				},
+			},
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/rg1/providers/Microsoft.Monitor/accounts/workspace1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := flattenKubernetesPrometheusPipelineWorkspaceId(v.Input)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
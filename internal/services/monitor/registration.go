@@ -34,6 +34,7 @@ func (r Registration) Resources() []sdk.Resource {
 		DataCollectionEndpointResource{},
 		DataCollectionRuleAssociationResource{},
 		DataCollectionRuleResource{},
+		KubernetesPrometheusPipelineResource{},
 		ScheduledQueryRulesAlertV2Resource{},
 		AlertPrometheusRuleGroupResource{},
 		WorkspaceResource{},
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type BackupPoliciesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewBackupPoliciesClientWithBaseURI(sdkApi environments.Api) (*BackupPoliciesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "backuppolicies", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating BackupPoliciesClient: %+v", err)
+	}
+
+	return &BackupPoliciesClient{
+		Client: client,
+	}, nil
+}
+
+// Get ...
+func (c BackupPoliciesClient) Get(ctx context.Context, id BackupPolicyId) (GetOperationResponse[BackupPolicy], error) {
+	return get[BackupPolicy](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c BackupPoliciesClient) CreateOrUpdateThenPoll(ctx context.Context, id BackupPolicyId, input BackupPolicy) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// UpdateThenPoll performs Update then polls until it's completed
+func (c BackupPoliciesClient) UpdateThenPoll(ctx context.Context, id BackupPolicyId, input BackupPolicyPatch) error {
+	return updateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c BackupPoliciesClient) DeleteThenPoll(ctx context.Context, id BackupPolicyId) error {
+	return deleteThenPoll(ctx, c.Client, id.ID())
+}
+
+type BackupPolicy struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   string                 `json:"location"`
+	Name       *string                `json:"name,omitempty"`
+	Properties BackupPolicyProperties `json:"properties"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type BackupPolicyProperties struct {
+	BackupPolicyId       *string `json:"backupPolicyId,omitempty"`
+	DailyBackupsToKeep   *int64  `json:"dailyBackupsToKeep,omitempty"`
+	Enabled              *bool   `json:"enabled,omitempty"`
+	MonthlyBackupsToKeep *int64  `json:"monthlyBackupsToKeep,omitempty"`
+	ProvisioningState    *string `json:"provisioningState,omitempty"`
+	VolumesAssigned      *int64  `json:"volumesAssigned,omitempty"`
+	WeeklyBackupsToKeep  *int64  `json:"weeklyBackupsToKeep,omitempty"`
+}
+
+type BackupPolicyPatch struct {
+	Properties *BackupPolicyProperties `json:"properties,omitempty"`
+	Tags       *map[string]string      `json:"tags,omitempty"`
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type BackupVaultsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewBackupVaultsClientWithBaseURI(sdkApi environments.Api) (*BackupVaultsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "backupvaults", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating BackupVaultsClient: %+v", err)
+	}
+
+	return &BackupVaultsClient{
+		Client: client,
+	}, nil
+}
+
+// Get ...
+func (c BackupVaultsClient) Get(ctx context.Context, id BackupVaultId) (GetOperationResponse[BackupVault], error) {
+	return get[BackupVault](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c BackupVaultsClient) CreateOrUpdateThenPoll(ctx context.Context, id BackupVaultId, input BackupVault) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// UpdateThenPoll performs Update then polls until it's completed
+func (c BackupVaultsClient) UpdateThenPoll(ctx context.Context, id BackupVaultId, input BackupVaultPatch) error {
+	return updateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c BackupVaultsClient) DeleteThenPoll(ctx context.Context, id BackupVaultId) error {
+	return deleteThenPoll(ctx, c.Client, id.ID())
+}
+
+type BackupVault struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   string                 `json:"location"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *BackupVaultProperties `json:"properties,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type BackupVaultProperties struct {
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+type BackupVaultPatch struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
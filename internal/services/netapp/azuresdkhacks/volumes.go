@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumes"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// VolumesClient only exposes the parts of the Volumes API which aren't available in the vendored API version,
+// the Volume itself should otherwise be managed using the vendored SDK
+type VolumesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewVolumesClientWithBaseURI(sdkApi environments.Api) (*VolumesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "volumes", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating VolumesClient: %+v", err)
+	}
+
+	return &VolumesClient{
+		Client: client,
+	}, nil
+}
+
+// Get retrieves the Volume, only unmarshalling the Backup related properties
+func (c VolumesClient) Get(ctx context.Context, id volumes.VolumeId) (GetOperationResponse[Volume], error) {
+	return get[Volume](ctx, c.Client, id.ID())
+}
+
+// CreateOrUpdateThenPoll creates the Volume using the vendored model, which is required when restoring a Backup
+// from a Backup Vault since the `backupId` is only interpreted as a Backup Vault Backup from API version `2023-11-01`
+func (c VolumesClient) CreateOrUpdateThenPoll(ctx context.Context, id volumes.VolumeId, input volumes.Volume) error {
+	return createOrUpdateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+// UpdateThenPoll performs Update then polls until it's completed
+func (c VolumesClient) UpdateThenPoll(ctx context.Context, id volumes.VolumeId, input VolumePatch) error {
+	return updateThenPoll(ctx, c.Client, id.ID(), input)
+}
+
+type Volume struct {
+	Id         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties VolumeProperties `json:"properties"`
+}
+
+type VolumeProperties struct {
+	BackupId       *string                         `json:"backupId,omitempty"`
+	DataProtection *VolumePropertiesDataProtection `json:"dataProtection,omitempty"`
+}
+
+type VolumePropertiesDataProtection struct {
+	Backup *VolumeBackupProperties `json:"backup,omitempty"`
+}
+
+type VolumeBackupProperties struct {
+	BackupPolicyId *string `json:"backupPolicyId,omitempty"`
+	BackupVaultId  *string `json:"backupVaultId,omitempty"`
+	PolicyEnforced *bool   `json:"policyEnforced,omitempty"`
+}
+
+type VolumePatch struct {
+	Properties *VolumePatchProperties `json:"properties,omitempty"`
+}
+
+type VolumePatchProperties struct {
+	DataProtection *VolumePropertiesDataProtection `json:"dataProtection,omitempty"`
+}
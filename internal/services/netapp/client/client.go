@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumesreplication"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/azuresdkhacks"
 )
 
 type Client struct {
@@ -26,6 +27,9 @@ type Client struct {
 	VolumeQuotaRules        *volumequotarules.VolumeQuotaRulesClient
 	SnapshotClient          *snapshots.SnapshotsClient
 	SnapshotPoliciesClient  *snapshotpolicy.SnapshotPolicyClient
+	BackupVaultsClient      *azuresdkhacks.BackupVaultsClient
+	BackupPoliciesClient    *azuresdkhacks.BackupPoliciesClient
+	VolumeBackupsClient     *azuresdkhacks.VolumesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -77,6 +81,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		return nil, fmt.Errorf("building SnapshotPoliciesClient client: %+v", err)
 	}
 
+	backupVaultsClient, err := azuresdkhacks.NewBackupVaultsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building BackupVaultsClient client: %+v", err)
+	}
+	o.Configure(backupVaultsClient.Client, o.Authorizers.ResourceManager)
+
+	backupPoliciesClient, err := azuresdkhacks.NewBackupPoliciesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building BackupPoliciesClient client: %+v", err)
+	}
+	o.Configure(backupPoliciesClient.Client, o.Authorizers.ResourceManager)
+
+	volumeBackupsClient, err := azuresdkhacks.NewVolumesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building VolumeBackupsClient client: %+v", err)
+	}
+	o.Configure(volumeBackupsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		AccountClient:           accountClient,
 		PoolClient:              poolClient,
@@ -86,5 +108,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		VolumeQuotaRules:        volumeQuotaRuleClient,
 		SnapshotClient:          snapshotClient,
 		SnapshotPoliciesClient:  snapshotPoliciesClient,
+		BackupVaultsClient:      backupVaultsClient,
+		BackupPoliciesClient:    backupPoliciesClient,
+		VolumeBackupsClient:     volumeBackupsClient,
 	}, nil
 }
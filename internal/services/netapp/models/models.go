@@ -116,3 +116,23 @@ type NetAppVolumeQuotaRuleDataSourceModel struct {
 	QuotaSizeInKiB int64  `tfschema:"quota_size_in_kib"`
 	QuotaType      string `tfschema:"quota_type"`
 }
+
+type NetAppBackupVaultModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	AccountName       string            `tfschema:"account_name"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+type NetAppBackupPolicyModel struct {
+	Name                 string            `tfschema:"name"`
+	ResourceGroupName    string            `tfschema:"resource_group_name"`
+	Location             string            `tfschema:"location"`
+	AccountName          string            `tfschema:"account_name"`
+	DailyBackupsToKeep   int64             `tfschema:"daily_backups_to_keep"`
+	WeeklyBackupsToKeep  int64             `tfschema:"weekly_backups_to_keep"`
+	MonthlyBackupsToKeep int64             `tfschema:"monthly_backups_to_keep"`
+	Enabled              bool              `tfschema:"enabled"`
+	Tags                 map[string]string `tfschema:"tags"`
+}
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package netapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/azuresdkhacks"
+	netAppModels "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/models"
+	netAppValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NetAppBackupPolicyResource struct{}
+
+var (
+	_ sdk.Resource           = NetAppBackupPolicyResource{}
+	_ sdk.ResourceWithUpdate = NetAppBackupPolicyResource{}
+)
+
+func (r NetAppBackupPolicyResource) ModelObject() interface{} {
+	return &netAppModels.NetAppBackupPolicyModel{}
+}
+
+func (r NetAppBackupPolicyResource) ResourceType() string {
+	return "azurerm_netapp_backup_policy"
+}
+
+func (r NetAppBackupPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateBackupPolicyID
+}
+
+func (r NetAppBackupPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: netAppValidate.BackupPolicyName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: netAppValidate.AccountName,
+		},
+
+		// the combined number of daily, weekly and monthly backups to keep can't exceed 1019
+		"daily_backups_to_keep": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      2,
+			ValidateFunc: validation.IntBetween(2, 1019),
+		},
+
+		"weekly_backups_to_keep": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 1019),
+		},
+
+		"monthly_backups_to_keep": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 1019),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r NetAppBackupPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetAppBackupPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.NetApp.BackupPoliciesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model netAppModels.NetAppBackupPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := azuresdkhacks.NewBackupPolicyID(subscriptionId, model.ResourceGroupName, model.AccountName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if total := model.DailyBackupsToKeep + model.WeeklyBackupsToKeep + model.MonthlyBackupsToKeep; total > 1019 {
+				return fmt.Errorf("the combined number of daily, weekly and monthly backups to keep cannot exceed 1019 but got %d", total)
+			}
+
+			parameters := azuresdkhacks.BackupPolicy{
+				Location: location.Normalize(model.Location),
+				Properties: azuresdkhacks.BackupPolicyProperties{
+					DailyBackupsToKeep:   pointer.To(model.DailyBackupsToKeep),
+					WeeklyBackupsToKeep:  pointer.To(model.WeeklyBackupsToKeep),
+					MonthlyBackupsToKeep: pointer.To(model.MonthlyBackupsToKeep),
+					Enabled:              pointer.To(model.Enabled),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetAppBackupPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.NetApp.BackupPoliciesClient
+
+			id, err := azuresdkhacks.ParseBackupPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := netAppModels.NetAppBackupPolicyModel{
+				Name:              id.BackupPolicyName,
+				ResourceGroupName: id.ResourceGroupName,
+				AccountName:       id.NetAppAccountName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				props := model.Properties
+				state.DailyBackupsToKeep = pointer.From(props.DailyBackupsToKeep)
+				state.WeeklyBackupsToKeep = pointer.From(props.WeeklyBackupsToKeep)
+				state.MonthlyBackupsToKeep = pointer.From(props.MonthlyBackupsToKeep)
+				state.Enabled = pointer.From(props.Enabled)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetAppBackupPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.NetApp.BackupPoliciesClient
+
+			id, err := azuresdkhacks.ParseBackupPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model netAppModels.NetAppBackupPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if total := model.DailyBackupsToKeep + model.WeeklyBackupsToKeep + model.MonthlyBackupsToKeep; total > 1019 {
+				return fmt.Errorf("the combined number of daily, weekly and monthly backups to keep cannot exceed 1019 but got %d", total)
+			}
+
+			update := azuresdkhacks.BackupPolicyPatch{
+				Properties: &azuresdkhacks.BackupPolicyProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("daily_backups_to_keep") {
+				update.Properties.DailyBackupsToKeep = pointer.To(model.DailyBackupsToKeep)
+			}
+
+			if metadata.ResourceData.HasChange("weekly_backups_to_keep") {
+				update.Properties.WeeklyBackupsToKeep = pointer.To(model.WeeklyBackupsToKeep)
+			}
+
+			if metadata.ResourceData.HasChange("monthly_backups_to_keep") {
+				update.Properties.MonthlyBackupsToKeep = pointer.To(model.MonthlyBackupsToKeep)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				update.Properties.Enabled = pointer.To(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				update.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, update); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetAppBackupPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.NetApp.BackupPoliciesClient
+
+			id, err := azuresdkhacks.ParseBackupPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
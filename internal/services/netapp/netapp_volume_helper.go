@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumesreplication"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/azuresdkhacks"
 	netAppModels "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/models"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -273,6 +274,32 @@ func expandNetAppVolumeDataProtectionSnapshotPolicyPatch(input []interface{}) *v
 	}
 }
 
+func expandNetAppVolumeDataProtectionBackupPolicy(input []interface{}) *azuresdkhacks.VolumePropertiesDataProtection {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	backupRaw := input[0].(map[string]interface{})
+
+	return &azuresdkhacks.VolumePropertiesDataProtection{
+		Backup: &azuresdkhacks.VolumeBackupProperties{
+			BackupVaultId:  utils.String(backupRaw["backup_vault_id"].(string)),
+			BackupPolicyId: utils.String(backupRaw["backup_policy_id"].(string)),
+			PolicyEnforced: utils.Bool(backupRaw["policy_enabled"].(bool)),
+		},
+	}
+}
+
+// disableNetAppVolumeDataProtectionBackupPolicy returns the patch used to stop a Backup Policy from being applied to
+// a Volume - since the Volume remains associated with the Backup Vault (and Backup Policy) only enforcement is disabled
+func disableNetAppVolumeDataProtectionBackupPolicy() *azuresdkhacks.VolumePropertiesDataProtection {
+	return &azuresdkhacks.VolumePropertiesDataProtection{
+		Backup: &azuresdkhacks.VolumeBackupProperties{
+			PolicyEnforced: utils.Bool(false),
+		},
+	}
+}
+
 func flattenNetAppVolumeGroupVolumes(ctx context.Context, input *[]volumegroups.VolumeGroupVolumeProperties, metadata sdk.ResourceMetaData) ([]netAppModels.NetAppVolumeGroupVolume, error) {
 	results := make([]netAppModels.NetAppVolumeGroupVolume, 0)
 
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package netapp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestExpandNetAppVolumeDataProtectionBackupPolicy(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected string
+	}{
+		{
+			Name:     "block removed",
+			Input:    []interface{}{},
+			Expected: `null`,
+		},
+		{
+			Name: "policy enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"backup_vault_id":  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NetApp/netAppAccounts/account1/backupVaults/vault1",
+					"backup_policy_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NetApp/netAppAccounts/account1/backupPolicies/policy1",
+					"policy_enabled":   true,
+				},
+			},
+			Expected: `{"backup":{"backupPolicyId":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NetApp/netAppAccounts/account1/backupPolicies/policy1","backupVaultId":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NetApp/netAppAccounts/account1/backupVaults/vault1","policyEnforced":true}}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := json.Marshal(expandNetAppVolumeDataProtectionBackupPolicy(v.Input))
+		if err != nil {
+			t.Fatalf("marshaling: %+v", err)
+		}
+		if string(actual) != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, string(actual))
+		}
+	}
+}
+
+func TestDisableNetAppVolumeDataProtectionBackupPolicy(t *testing.T) {
+	// the Backup Policy must be disabled explicitly, rather than by sending an empty `backupPolicyId`
+	expected := `{"backup":{"policyEnforced":false}}`
+
+	actual, err := json.Marshal(disableNetAppVolumeDataProtectionBackupPolicy())
+	if err != nil {
+		t.Fatalf("marshaling: %+v", err)
+	}
+	if string(actual) != expected {
+		t.Fatalf("expected %s but got %s", expected, string(actual))
+	}
+}
+
+func TestFlattenNetAppVolumeDataProtectionBackupPolicy(t *testing.T) {
+	enabled := &azuresdkhacks.VolumePropertiesDataProtection{
+		Backup: &azuresdkhacks.VolumeBackupProperties{
+			BackupVaultId:  utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NetApp/netAppAccounts/account1/backupVaults/vault1"),
+			BackupPolicyId: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NetApp/netAppAccounts/account1/backupPolicies/policy1"),
+			PolicyEnforced: utils.Bool(true),
+		},
+	}
+	disabled := &azuresdkhacks.VolumePropertiesDataProtection{
+		Backup: &azuresdkhacks.VolumeBackupProperties{
+			BackupVaultId:  utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NetApp/netAppAccounts/account1/backupVaults/vault1"),
+			BackupPolicyId: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.NetApp/netAppAccounts/account1/backupPolicies/policy1"),
+			PolicyEnforced: utils.Bool(false),
+		},
+	}
+
+	testData := []struct {
+		Name       string
+		Input      *azuresdkhacks.VolumePropertiesDataProtection
+		Configured bool
+		Expected   int
+	}{
+		{
+			Name:     "no backup policy",
+			Input:    &azuresdkhacks.VolumePropertiesDataProtection{},
+			Expected: 0,
+		},
+		{
+			Name:     "policy enabled",
+			Input:    enabled,
+			Expected: 1,
+		},
+		{
+			Name:     "policy disabled",
+			Input:    disabled,
+			Expected: 0,
+		},
+		{
+			Name:       "policy disabled and configured",
+			Input:      disabled,
+			Configured: true,
+			Expected:   1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := flattenNetAppVolumeDataProtectionBackupPolicy(v.Input, v.Configured); len(actual) != v.Expected {
+			t.Fatalf("expected %d blocks but got %d", v.Expected, len(actual))
+		}
+	}
+}
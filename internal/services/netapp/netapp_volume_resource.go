@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/azuresdkhacks"
 	netAppValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -108,6 +109,14 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 				ValidateFunc: snapshots.ValidateSnapshotID,
 			},
 
+			"create_from_backup_resource_id": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  azuresdkhacks.ValidateBackupID,
+				ConflictsWith: []string{"create_from_snapshot_resource_id"},
+			},
+
 			"network_features": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -278,6 +287,33 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 				},
 			},
 
+			"data_protection_backup_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"backup_vault_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azuresdkhacks.ValidateBackupVaultID,
+						},
+
+						"backup_policy_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azuresdkhacks.ValidateBackupPolicyID,
+						},
+
+						"policy_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
 			"azure_vmware_data_store_enabled": {
 				Type:     pluginsdk.TypeBool,
 				ForceNew: true,
@@ -493,8 +529,16 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		parameters.Properties.KeyVaultPrivateEndpointResourceId = pointer.To(keyVaultPrivateEndpointID.(string))
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
-		return fmt.Errorf("creating %s: %+v", id, err)
+	if backupResourceID, ok := d.GetOk("create_from_backup_resource_id"); ok {
+		// Restoring from a Backup within a Backup Vault requires a newer API version than is vendored
+		parameters.Properties.BackupId = utils.String(backupResourceID.(string))
+		if err := meta.(*clients.Client).NetApp.VolumeBackupsClient.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+			return fmt.Errorf("creating %s from Backup %q: %+v", id, backupResourceID.(string), err)
+		}
+	} else {
+		if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
 	}
 
 	// Waiting for volume be completely provisioned
@@ -502,6 +546,21 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
+	if dataProtectionBackupPolicyRaw := d.Get("data_protection_backup_policy").([]interface{}); len(dataProtectionBackupPolicyRaw) > 0 {
+		update := azuresdkhacks.VolumePatch{
+			Properties: &azuresdkhacks.VolumePatchProperties{
+				DataProtection: expandNetAppVolumeDataProtectionBackupPolicy(dataProtectionBackupPolicyRaw),
+			},
+		}
+		if err := meta.(*clients.Client).NetApp.VolumeBackupsClient.UpdateThenPoll(ctx, id, update); err != nil {
+			return fmt.Errorf("setting `data_protection_backup_policy` for %s: %+v", id, err)
+		}
+
+		if err := waitForVolumeCreateOrUpdate(ctx, client, id); err != nil {
+			return err
+		}
+	}
+
 	// If this is a data replication secondary volume, authorize replication on primary volume
 	if authorizeReplication {
 		replicationClient := meta.(*clients.Client).NetApp.VolumeReplicationClient
@@ -599,6 +658,27 @@ func resourceNetAppVolumeUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.HasChange("data_protection_backup_policy") {
+		dataProtection := expandNetAppVolumeDataProtectionBackupPolicy(d.Get("data_protection_backup_policy").([]interface{}))
+		if dataProtection == nil {
+			// the Volume can't be removed from the Backup Vault, so removing the block explicitly disables the Backup Policy
+			dataProtection = disableNetAppVolumeDataProtectionBackupPolicy()
+		}
+
+		update := azuresdkhacks.VolumePatch{
+			Properties: &azuresdkhacks.VolumePatchProperties{
+				DataProtection: dataProtection,
+			},
+		}
+		if err := meta.(*clients.Client).NetApp.VolumeBackupsClient.UpdateThenPoll(ctx, *id, update); err != nil {
+			return fmt.Errorf("updating `data_protection_backup_policy` for %s: %+v", id, err)
+		}
+
+		if err := waitForVolumeCreateOrUpdate(ctx, client, *id); err != nil {
+			return err
+		}
+	}
+
 	return resourceNetAppVolumeRead(d, meta)
 }
 
@@ -670,6 +750,20 @@ func resourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) error
 			return fmt.Errorf("setting `data_protection_snapshot_policy`: %+v", err)
 		}
 
+		// the Backup properties are only available in a newer API version than is vendored
+		backupResp, err := meta.(*clients.Client).NetApp.VolumeBackupsClient.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving Backup properties for %s: %+v", *id, err)
+		}
+		var dataProtectionBackup *azuresdkhacks.VolumePropertiesDataProtection
+		if backupModel := backupResp.Model; backupModel != nil {
+			dataProtectionBackup = backupModel.Properties.DataProtection
+		}
+		configured := len(d.Get("data_protection_backup_policy").([]interface{})) > 0
+		if err := d.Set("data_protection_backup_policy", flattenNetAppVolumeDataProtectionBackupPolicy(dataProtectionBackup, configured)); err != nil {
+			return fmt.Errorf("setting `data_protection_backup_policy`: %+v", err)
+		}
+
 		return tags.FlattenAndSet(d, model.Tags)
 	}
 	return nil
@@ -971,3 +1065,33 @@ func flattenNetAppVolumeDataProtectionSnapshotPolicy(input *volumes.VolumeProper
 		},
 	}
 }
+
+// flattenNetAppVolumeDataProtectionBackupPolicy flattens the Backup Policy for the Volume - since the Volume remains
+// associated with the Backup Vault once the Backup Policy has been disabled (e.g. when the block has been removed), a
+// disabled Backup Policy is flattened as an empty block unless the block is `configured`
+func flattenNetAppVolumeDataProtectionBackupPolicy(input *azuresdkhacks.VolumePropertiesDataProtection, configured bool) []interface{} {
+	if input == nil || input.Backup == nil || input.Backup.BackupPolicyId == nil || *input.Backup.BackupPolicyId == "" {
+		return []interface{}{}
+	}
+	if !pointer.From(input.Backup.PolicyEnforced) && !configured {
+		return []interface{}{}
+	}
+
+	backupVaultId := pointer.From(input.Backup.BackupVaultId)
+	if id, err := azuresdkhacks.ParseBackupVaultIDInsensitively(backupVaultId); err == nil {
+		backupVaultId = id.ID()
+	}
+
+	backupPolicyId := *input.Backup.BackupPolicyId
+	if id, err := azuresdkhacks.ParseBackupPolicyIDInsensitively(*input.Backup.BackupPolicyId); err == nil {
+		backupPolicyId = id.ID()
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"backup_vault_id":  backupVaultId,
+			"backup_policy_id": backupPolicyId,
+			"policy_enabled":   pointer.From(input.Backup.PolicyEnforced),
+		},
+	}
+}
@@ -64,5 +64,7 @@ func (r Registration) Resources() []sdk.Resource {
 		NetAppVolumeGroupSapHanaResource{},
 		NetAppVolumeQuotaRuleResource{},
 		NetAppAccountEncryptionResource{},
+		NetAppBackupVaultResource{},
+		NetAppBackupPolicyResource{},
 	}
 }
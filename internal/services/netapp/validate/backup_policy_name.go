@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func BackupPolicyName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[\da-zA-Z][-_\da-zA-Z]{0,63}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 64 characters in length and start with letters or numbers and contains only letters, numbers, underscore or hyphens.", k))
+	}
+
+	return warnings, errors
+}
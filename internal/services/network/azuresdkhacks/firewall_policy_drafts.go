@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/firewallpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// TODO: remove this once the Network SDK has been updated to `2023-11-01` or later
+// Firewall Policy Drafts (and the Deploy operation) are only available from API version `2023-11-01`, which isn't
+// vendored - as such the Client for these is defined here until the SDK has been updated.
+const firewallPolicyDraftsApiVersion = "2023-11-01"
+
+type FirewallPolicyDraftsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewFirewallPolicyDraftsClientWithBaseURI(sdkApi environments.Api) (*FirewallPolicyDraftsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "firewallpolicydrafts", firewallPolicyDraftsApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating FirewallPolicyDraftsClient: %+v", err)
+	}
+
+	return &FirewallPolicyDraftsClient{
+		Client: client,
+	}, nil
+}
+
+type FirewallPolicyDraft struct {
+	Id         *string                        `json:"id,omitempty"`
+	Location   *string                        `json:"location,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *FirewallPolicyDraftProperties `json:"properties,omitempty"`
+	Tags       *map[string]string             `json:"tags,omitempty"`
+}
+
+// FirewallPolicyDraftProperties has the same shape as the Firewall Policy's properties, less the read-only fields
+type FirewallPolicyDraftProperties struct {
+	BasePolicy           *firewallpolicies.SubResource                        `json:"basePolicy,omitempty"`
+	DnsSettings          *firewallpolicies.DnsSettings                        `json:"dnsSettings,omitempty"`
+	ExplicitProxy        *firewallpolicies.ExplicitProxy                      `json:"explicitProxy,omitempty"`
+	Insights             *firewallpolicies.FirewallPolicyInsights             `json:"insights,omitempty"`
+	IntrusionDetection   *firewallpolicies.FirewallPolicyIntrusionDetection   `json:"intrusionDetection,omitempty"`
+	Snat                 *firewallpolicies.FirewallPolicySNAT                 `json:"snat,omitempty"`
+	Sql                  *firewallpolicies.FirewallPolicySQL                  `json:"sql,omitempty"`
+	ThreatIntelMode      *firewallpolicies.AzureFirewallThreatIntelMode       `json:"threatIntelMode,omitempty"`
+	ThreatIntelWhitelist *firewallpolicies.FirewallPolicyThreatIntelWhitelist `json:"threatIntelWhitelist,omitempty"`
+}
+
+// FirewallPolicyRuleCollectionGroupDraft has the same shape as a Rule Collection Group, so the vendored
+// properties (which handle the discriminated Rule Collections) are reused here
+type FirewallPolicyRuleCollectionGroupDraft struct {
+	Id         *string                                                                         `json:"id,omitempty"`
+	Name       *string                                                                         `json:"name,omitempty"`
+	Properties *firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollectionGroupProperties `json:"properties,omitempty"`
+}
+
+type GetFirewallPolicyDraftOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *FirewallPolicyDraft
+}
+
+type GetFirewallPolicyRuleCollectionGroupDraftOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *FirewallPolicyRuleCollectionGroupDraft
+}
+
+// GetPolicyDraft retrieves the Draft of the specified Firewall Policy
+func (c FirewallPolicyDraftsClient) GetPolicyDraft(ctx context.Context, id firewallpolicies.FirewallPolicyId) (result GetFirewallPolicyDraftOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, policyDraftPath(id), nil, []int{http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	err = resp.Unmarshal(&result.Model)
+	return
+}
+
+// CreateOrUpdatePolicyDraft creates or updates the Draft of the specified Firewall Policy
+func (c FirewallPolicyDraftsClient) CreateOrUpdatePolicyDraft(ctx context.Context, id firewallpolicies.FirewallPolicyId, input FirewallPolicyDraft) error {
+	if _, err := c.execute(ctx, http.MethodPut, policyDraftPath(id), input, []int{http.StatusCreated, http.StatusOK}); err != nil {
+		return fmt.Errorf("performing CreateOrUpdatePolicyDraft: %+v", err)
+	}
+	return nil
+}
+
+// DeletePolicyDraft discards the Draft of the specified Firewall Policy
+func (c FirewallPolicyDraftsClient) DeletePolicyDraft(ctx context.Context, id firewallpolicies.FirewallPolicyId) error {
+	if _, err := c.execute(ctx, http.MethodDelete, policyDraftPath(id), nil, []int{http.StatusNoContent, http.StatusOK}); err != nil {
+		return fmt.Errorf("performing DeletePolicyDraft: %+v", err)
+	}
+	return nil
+}
+
+// GetRuleCollectionGroupDraft retrieves the Draft of the specified Rule Collection Group
+func (c FirewallPolicyDraftsClient) GetRuleCollectionGroupDraft(ctx context.Context, id firewallpolicyrulecollectiongroups.RuleCollectionGroupId) (result GetFirewallPolicyRuleCollectionGroupDraftOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, ruleCollectionGroupDraftPath(id), nil, []int{http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	err = resp.Unmarshal(&result.Model)
+	return
+}
+
+// CreateOrUpdateRuleCollectionGroupDraft creates or updates the Draft of the specified Rule Collection Group
+func (c FirewallPolicyDraftsClient) CreateOrUpdateRuleCollectionGroupDraft(ctx context.Context, id firewallpolicyrulecollectiongroups.RuleCollectionGroupId, input FirewallPolicyRuleCollectionGroupDraft) error {
+	if _, err := c.execute(ctx, http.MethodPut, ruleCollectionGroupDraftPath(id), input, []int{http.StatusCreated, http.StatusOK}); err != nil {
+		return fmt.Errorf("performing CreateOrUpdateRuleCollectionGroupDraft: %+v", err)
+	}
+	return nil
+}
+
+// DeleteRuleCollectionGroupDraft discards the Draft of the specified Rule Collection Group
+func (c FirewallPolicyDraftsClient) DeleteRuleCollectionGroupDraft(ctx context.Context, id firewallpolicyrulecollectiongroups.RuleCollectionGroupId) error {
+	if _, err := c.execute(ctx, http.MethodDelete, ruleCollectionGroupDraftPath(id), nil, []int{http.StatusNoContent, http.StatusOK}); err != nil {
+		return fmt.Errorf("performing DeleteRuleCollectionGroupDraft: %+v", err)
+	}
+	return nil
+}
+
+// DeployThenPoll deploys the Drafts of the Firewall Policy (and its Rule Collection Groups) in a single
+// operation, then polls until it's completed
+func (c FirewallPolicyDraftsClient) DeployThenPoll(ctx context.Context, id firewallpolicies.FirewallPolicyId) error {
+	resp, err := c.execute(ctx, http.MethodPost, fmt.Sprintf("%s/deploy", id.ID()), nil, []int{http.StatusAccepted, http.StatusOK})
+	if err != nil {
+		return fmt.Errorf("performing Deploy: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return fmt.Errorf("building poller: %+v", err)
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Deploy: %+v", err)
+	}
+
+	return nil
+}
+
+func (c FirewallPolicyDraftsClient) execute(ctx context.Context, method string, path string, input interface{}, expectedStatusCodes []int) (*client.Response, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: expectedStatusCodes,
+		HttpMethod:          method,
+		Path:                path,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	if input != nil {
+		if err := req.Marshal(input); err != nil {
+			return nil, fmt.Errorf("marshaling request: %+v", err)
+		}
+	}
+
+	return req.Execute(ctx)
+}
+
+func policyDraftPath(id firewallpolicies.FirewallPolicyId) string {
+	return fmt.Sprintf("%s/firewallPolicyDrafts/default", id.ID())
+}
+
+func ruleCollectionGroupDraftPath(id firewallpolicyrulecollectiongroups.RuleCollectionGroupId) string {
+	return fmt.Sprintf("%s/ruleCollectionGroupDrafts/default", id.ID())
+}
@@ -9,12 +9,16 @@ import (
 	network_2023_06_01 "github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
 	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
 
 type Client struct {
 	*network_2023_06_01.Client
 
+	// FirewallPolicyDraftsClient is only available in a newer API version than is vendored
+	FirewallPolicyDraftsClient *azuresdkhacks.FirewallPolicyDraftsClient
+
 	// Usages of the clients below use `Azure/azure-sdk-for-go` and should be updated
 	// to use `hashicorp/go-azure-sdk` (available above).
 	ApplicationGatewaysClient              *network.ApplicationGatewaysClient
@@ -183,8 +187,15 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		return nil, fmt.Errorf("building clients for Network: %+v", err)
 	}
 
+	firewallPolicyDraftsClient, err := azuresdkhacks.NewFirewallPolicyDraftsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building FirewallPolicyDrafts client: %+v", err)
+	}
+	o.Configure(firewallPolicyDraftsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		Client: client,
+		Client:                     client,
+		FirewallPolicyDraftsClient: firewallPolicyDraftsClient,
 
 		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
 		CustomIPPrefixesClient:                 &customIpPrefixesClient,
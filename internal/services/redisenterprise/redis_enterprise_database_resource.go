@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -43,7 +44,8 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 			return err
 		}),
 
-		// Since update is not currently supported all attribute have to be marked as FORCE NEW
+		// Since update is not currently supported all attribute (other than the linked databases, which
+		// are linked and unlinked in-place) have to be marked as FORCE NEW
 		// until support for Update comes online in the near future
 		Schema: redisEnterpriseDatabaseSchema(),
 	}
@@ -275,6 +277,12 @@ func resourceRedisEnterpriseDatabaseCreate(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("setting module error: %+v", err)
 	}
 
+	if isGeoEnabled {
+		if err := validateGeoLinkedDatabasesAreCompatible(ctx, client, id, *linkedDatabase.LinkedDatabases, evictionPolicy, module); err != nil {
+			return err
+		}
+	}
+
 	parameters := databases.Database{
 		Properties: &databases.DatabaseProperties{
 			ClientProtocol:   &protocol,
@@ -411,14 +419,6 @@ func resourceRedisEnterpriseDatabaseUpdate(d *pluginsdk.ResourceData, meta inter
 	evictionPolicy := databases.EvictionPolicy(d.Get("eviction_policy").(string))
 	protocol := databases.Protocol(d.Get("client_protocol").(string))
 
-	oldItems, newItems := d.GetChange("linked_database_id")
-	isForceUnlink, data := forceUnlinkItems(oldItems.(*pluginsdk.Set).List(), newItems.(*pluginsdk.Set).List())
-	if isForceUnlink {
-		if err := forceUnlinkDatabase(d, meta, *data); err != nil {
-			return fmt.Errorf("unlinking database error: %+v", err)
-		}
-	}
-
 	linkedDatabase, err := expandArmGeoLinkedDatabase(d.Get("linked_database_id").(*pluginsdk.Set).List(), id.ID(), d.Get("linked_database_group_nickname").(string))
 	if err != nil {
 		return fmt.Errorf("Setting geo database for database %s error: %+v", id.ID(), err)
@@ -433,20 +433,50 @@ func resourceRedisEnterpriseDatabaseUpdate(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("setting module error: %+v", err)
 	}
 
-	parameters := databases.Database{
-		Properties: &databases.DatabaseProperties{
-			ClientProtocol:   &protocol,
-			ClusteringPolicy: &clusteringPolicy,
-			EvictionPolicy:   &evictionPolicy,
-			Modules:          module,
-			// Persistence:      expandArmDatabasePersistence(d.Get("persistence").([]interface{})),
-			GeoReplication: linkedDatabase,
-			Port:           utils.Int64(int64(d.Get("port").(int))),
-		},
+	if isGeoEnabled {
+		if err := validateGeoLinkedDatabasesAreCompatible(ctx, client, id, *linkedDatabase.LinkedDatabases, evictionPolicy, module); err != nil {
+			return err
+		}
 	}
 
-	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
-		return fmt.Errorf("updatig %s: %+v", id, err)
+	// the geo-replication group is changed in-place: any databases removed from the list are force-unlinked and
+	// any databases added to the list are linked, rather than recreating each of the databases in the group
+	oldItems, newItems := d.GetChange("linked_database_id")
+	isForceUnlink, data := forceUnlinkItems(oldItems.(*pluginsdk.Set).List(), newItems.(*pluginsdk.Set).List())
+	if isForceUnlink {
+		if err := forceUnlinkDatabase(d, meta, *data); err != nil {
+			return fmt.Errorf("unlinking database error: %+v", err)
+		}
+	}
+
+	// the items in the new list which aren't in the old list are the databases being linked
+	_, linkItems := forceUnlinkItems(newItems.(*pluginsdk.Set).List(), oldItems.(*pluginsdk.Set).List())
+	requiresLink := false
+	if linkItems != nil {
+		requiresLink, err = geoLinkedDatabasesRequireLink(ctx, client, id, *linkItems)
+		if err != nil {
+			return err
+		}
+	}
+
+	// databases which already exist in the geo-replication group have been linked when they were created, so
+	// the database only needs to be updated when linking a database which doesn't exist yet
+	if requiresLink {
+		parameters := databases.Database{
+			Properties: &databases.DatabaseProperties{
+				ClientProtocol:   &protocol,
+				ClusteringPolicy: &clusteringPolicy,
+				EvictionPolicy:   &evictionPolicy,
+				Modules:          module,
+				// Persistence:      expandArmDatabasePersistence(d.Get("persistence").([]interface{})),
+				GeoReplication: linkedDatabase,
+				Port:           utils.Int64(int64(d.Get("port").(int))),
+			},
+		}
+
+		if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+			return fmt.Errorf("linking databases to %s: %+v", id, err)
+		}
 	}
 
 	d.SetId(id.ID())
@@ -638,8 +668,34 @@ func forceUnlinkDatabase(d *pluginsdk.ResourceData, meta interface{}, unlinkedDb
 		return err
 	}
 
+	// the databases may already have been unlinked from another database in the group, in which case
+	// there's nothing to do
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	currentlyLinked := make(map[string]bool)
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.GeoReplication != nil {
+		for _, linkedId := range flattenArmGeoLinkedDatabase(model.Properties.GeoReplication.LinkedDatabases) {
+			currentlyLinked[strings.ToLower(linkedId)] = true
+		}
+	}
+
+	idsToUnlink := make([]string, 0)
+	for _, unlinkedId := range unlinkedDbRaw {
+		if currentlyLinked[strings.ToLower(unlinkedId)] {
+			idsToUnlink = append(idsToUnlink, unlinkedId)
+		}
+	}
+
+	if len(idsToUnlink) == 0 {
+		log.Printf("[DEBUG] the databases to unlink from %s have already been unlinked", *id)
+		return nil
+	}
+
 	parameters := databases.ForceUnlinkParameters{
-		Ids: unlinkedDbRaw,
+		Ids: idsToUnlink,
 	}
 
 	if err := client.ForceUnlinkThenPoll(ctx, *id, parameters); err != nil {
@@ -649,6 +705,105 @@ func forceUnlinkDatabase(d *pluginsdk.ResourceData, meta interface{}, unlinkedDb
 	return nil
 }
 
+// geoLinkedDatabasesRequireLink returns whether any of the databases being added to the geo-replication group
+// of the specified database don't exist yet (and so need to be linked), a database which already exists has to
+// already be a member of the geo-replication group since existing databases can't be added to a group
+func geoLinkedDatabasesRequireLink(ctx context.Context, client *databases.DatabasesClient, id databases.DatabaseId, linkItems []string) (bool, error) {
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	currentlyLinked := make(map[string]bool)
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.GeoReplication != nil {
+		for _, linkedId := range flattenArmGeoLinkedDatabase(model.Properties.GeoReplication.LinkedDatabases) {
+			currentlyLinked[strings.ToLower(linkedId)] = true
+		}
+	}
+
+	requiresLink := false
+	for _, item := range linkItems {
+		if currentlyLinked[strings.ToLower(item)] {
+			continue
+		}
+
+		linkedId, err := databases.ParseDatabaseID(item)
+		if err != nil {
+			return false, err
+		}
+
+		resp, err := client.Get(ctx, *linkedId)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				requiresLink = true
+				continue
+			}
+			return false, fmt.Errorf("retrieving linked %s: %+v", *linkedId, err)
+		}
+
+		return false, fmt.Errorf("%s can't be linked to %s since it already exists and isn't a member of the geo-replication group - only newly created databases can be added to an existing geo-replication group", *linkedId, id)
+	}
+
+	return requiresLink, nil
+}
+
+// validateGeoLinkedDatabasesAreCompatible checks that each of the existing databases in the geo-replication group
+// uses the same eviction policy and modules as the specified database, since the geo-replication group can't be
+// linked otherwise
+func validateGeoLinkedDatabasesAreCompatible(ctx context.Context, client *databases.DatabasesClient, id databases.DatabaseId, linkedDatabases []databases.LinkedDatabase, evictionPolicy databases.EvictionPolicy, modules *[]databases.Module) error {
+	moduleNames := redisEnterpriseDatabaseModuleNames(modules)
+
+	for _, item := range linkedDatabases {
+		if item.Id == nil || strings.EqualFold(*item.Id, id.ID()) {
+			continue
+		}
+
+		linkedId, err := databases.ParseDatabaseID(*item.Id)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, *linkedId)
+		if err != nil {
+			// databases which don't exist yet are created when they're linked
+			if response.WasNotFound(resp.HttpResponse) {
+				continue
+			}
+			return fmt.Errorf("retrieving linked %s: %+v", *linkedId, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			continue
+		}
+		props := resp.Model.Properties
+
+		if props.EvictionPolicy != nil && !strings.EqualFold(string(*props.EvictionPolicy), string(evictionPolicy)) {
+			return fmt.Errorf("the `eviction_policy` of all linked databases must be the same but %s uses %q and the linked %s uses %q", id, string(evictionPolicy), *linkedId, string(*props.EvictionPolicy))
+		}
+
+		linkedModuleNames := redisEnterpriseDatabaseModuleNames(props.Modules)
+		if strings.Join(linkedModuleNames, ",") != strings.Join(moduleNames, ",") {
+			return fmt.Errorf("the `module`s of all linked databases must be the same but %s uses [%s] and the linked %s uses [%s]", id, strings.Join(moduleNames, ", "), *linkedId, strings.Join(linkedModuleNames, ", "))
+		}
+	}
+
+	return nil
+}
+
+func redisEnterpriseDatabaseModuleNames(input *[]databases.Module) []string {
+	names := make([]string, 0)
+	if input == nil {
+		return names
+	}
+
+	for _, item := range *input {
+		names = append(names, item.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 // Persistence is currently preview and does not return from the RP but will be fully supported in the near future
 // func flattenArmDatabasePersistence(input *redisenterprise.Persistence) []interface{} {
 // 	if input == nil {
@@ -138,6 +138,29 @@ func TestAccRedisEnterpriseDatabase_unlinkDatabase(t *testing.T) {
 	})
 }
 
+func TestAccRedisEnterpriseDatabase_linkDatabase(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_enterprise_database", "test")
+	r := RedisEnterpriseDatabaseResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unlinkDatabase(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_database_id.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.geoDatabase(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_database_id.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r RedisEnterpriseDatabaseResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := databases.ParseDatabaseID(state.ID)
 	if err != nil {
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// NOTE: this workaround client exists since the Resource Graph API isn't available in the vendored
+// version of `hashicorp/go-azure-sdk` - this can be removed once the SDK package is available.
+const resourceGraphApiVersion = "2022-10-01"
+
+type ResourceGraphClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewResourceGraphClientWithBaseURI(sdkApi environments.Api) (*ResourceGraphClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "resourcegraph", resourceGraphApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ResourceGraphClient: %+v", err)
+	}
+
+	return &ResourceGraphClient{
+		Client: client,
+	}, nil
+}
+
+type ResourcesOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *QueryResponse
+}
+
+// Resources runs a single page of a Resource Graph query
+func (c ResourceGraphClient) Resources(ctx context.Context, input QueryRequest) (result ResourcesOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPost,
+		Path:       "/providers/Microsoft.ResourceGraph/resources",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type QueryRequest struct {
+	ManagementGroups *[]string            `json:"managementGroups,omitempty"`
+	Options          *QueryRequestOptions `json:"options,omitempty"`
+	Query            string               `json:"query"`
+	Subscriptions    *[]string            `json:"subscriptions,omitempty"`
+}
+
+type QueryRequestOptions struct {
+	AllowPartialScopes *bool         `json:"allowPartialScopes,omitempty"`
+	ResultFormat       *ResultFormat `json:"resultFormat,omitempty"`
+	Skip               *int64        `json:"$skip,omitempty"`
+	SkipToken          *string       `json:"$skipToken,omitempty"`
+	Top                *int64        `json:"$top,omitempty"`
+}
+
+type QueryResponse struct {
+	Count           int64             `json:"count"`
+	Data            []json.RawMessage `json:"data"`
+	ResultTruncated ResultTruncated   `json:"resultTruncated"`
+	SkipToken       *string           `json:"$skipToken,omitempty"`
+	TotalRecords    int64             `json:"totalRecords"`
+}
+
+type ResultFormat string
+
+const (
+	ResultFormatObjectArray ResultFormat = "objectArray"
+	ResultFormatTable       ResultFormat = "table"
+)
+
+type ResultTruncated string
+
+const (
+	ResultTruncatedFalse ResultTruncated = "false"
+	ResultTruncatedTrue  ResultTruncated = "true"
+)
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/azuresdkhacks"
 )
 
 type Client struct {
@@ -24,6 +25,7 @@ type Client struct {
 	FeaturesClient                      *features.FeaturesClient
 	LocksClient                         *managementlocks.ManagementLocksClient
 	PrivateLinkAssociationClient        *privatelinkassociation.PrivateLinkAssociationClient
+	ResourceGraphClient                 *azuresdkhacks.ResourceGraphClient
 	ResourceGroupsClient                *resourcegroups.ResourceGroupsClient
 	ResourceManagementPrivateLinkClient *resourcemanagementprivatelink.ResourceManagementPrivateLinkClient
 	ResourceProvidersClient             *providers.ProvidersClient
@@ -59,6 +61,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(resourceGroupsClient.Client, o.Authorizers.ResourceManager)
 
+	resourceGraphClient, err := azuresdkhacks.NewResourceGraphClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ResourceGraph client: %+v", err)
+	}
+	o.Configure(resourceGraphClient.Client, o.Authorizers.ResourceManager)
+
 	locksClient, err := managementlocks.NewManagementLocksClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building ManagementLocks client: %+v", err)
@@ -111,6 +119,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		LocksClient:                         locksClient,
 		PrivateLinkAssociationClient:        privateLinkAssociationClient,
 		ResourceManagementPrivateLinkClient: resourceManagementPrivateLinkClient,
+		ResourceGraphClient:                 resourceGraphClient,
 		ResourceGroupsClient:                resourceGroupsClient,
 		ResourceProvidersClient:             resourceProvidersClient,
 		TemplateSpecsVersionsClient:         templateSpecsVersionsClient,
@@ -34,6 +34,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_resources":                            dataSourceResources(),
 		"azurerm_resource_group":                       dataSourceResourceGroup(),
+		"azurerm_resource_graph_query":                 dataSourceResourceGraphQuery(),
 		"azurerm_template_spec_version":                dataSourceTemplateSpecVersion(),
 		"azurerm_management_group_template_deployment": dataSourceManagementGroupTemplateDeployment(),
 		"azurerm_resource_group_template_deployment":   dataSourceResourceGroupTemplateDeployment(),
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/azuresdkhacks"
+)
+
+// resourceGraphQueryPageSize is the maximum number of rows the Resource Graph API returns in a single page
+const resourceGraphQueryPageSize = 1000
+
+type resourceGraphQueryPageFunc func(ctx context.Context, input azuresdkhacks.QueryRequest) (*azuresdkhacks.QueryResponse, error)
+
+type resourceGraphQueryResult struct {
+	Rows         []string
+	TotalRecords int64
+	Truncated    bool
+}
+
+// runResourceGraphQuery pages through the results of a Resource Graph query using the skip token returned by the
+// API, stopping once `maxResults` rows have been retrieved - in which case the result is marked as truncated.
+func runResourceGraphQuery(ctx context.Context, fetch resourceGraphQueryPageFunc, input azuresdkhacks.QueryRequest, maxResults int) (*resourceGraphQueryResult, error) {
+	result := &resourceGraphQueryResult{
+		Rows: make([]string, 0),
+	}
+
+	options := azuresdkhacks.QueryRequestOptions{}
+	if input.Options != nil {
+		options = *input.Options
+	}
+	options.ResultFormat = pointer.To(azuresdkhacks.ResultFormatObjectArray)
+	input.Options = &options
+
+	for {
+		remaining := maxResults - len(result.Rows)
+		pageSize := resourceGraphQueryPageSize
+		if remaining < pageSize {
+			pageSize = remaining
+		}
+		input.Options.Top = pointer.To(int64(pageSize))
+
+		page, err := fetch(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if page == nil {
+			return nil, fmt.Errorf("the query response was nil")
+		}
+
+		result.TotalRecords = page.TotalRecords
+		for _, row := range page.Data {
+			if len(result.Rows) == maxResults {
+				result.Truncated = true
+				break
+			}
+			result.Rows = append(result.Rows, string(row))
+		}
+
+		if page.ResultTruncated == azuresdkhacks.ResultTruncatedTrue {
+			// the API truncates results which can't be paged (e.g. when the query doesn't project the `id` column)
+			result.Truncated = true
+		}
+
+		if page.SkipToken == nil || *page.SkipToken == "" {
+			break
+		}
+
+		if len(result.Rows) >= maxResults {
+			result.Truncated = true
+			break
+		}
+
+		input.Options.SkipToken = page.SkipToken
+		// `$skip` only applies to the first page, subsequent pages are retrieved using the skip token
+		input.Options.Skip = nil
+	}
+
+	return result, nil
+}
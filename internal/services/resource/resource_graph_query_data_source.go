@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceResourceGraphQuery() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceResourceGraphQueryRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"query": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"subscription_ids": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"management_group_ids"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"management_group_ids": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"subscription_ids"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: managementGroupValidate.ManagementGroupName,
+				},
+			},
+
+			"allow_partial_scopes": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"max_results": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntBetween(1, 50000),
+			},
+
+			"skip": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"results": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"result_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"total_records": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"truncated": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourceGraphQueryRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.ResourceGraphClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	input := azuresdkhacks.QueryRequest{
+		Query: d.Get("query").(string),
+		Options: &azuresdkhacks.QueryRequestOptions{
+			AllowPartialScopes: pointer.To(d.Get("allow_partial_scopes").(bool)),
+		},
+	}
+
+	if v := d.Get("skip").(int); v > 0 {
+		input.Options.Skip = pointer.To(int64(v))
+	}
+
+	// the query is scoped to the subscription the provider is configured for unless another scope is specified
+	scope := commonids.NewSubscriptionID(subscriptionId).ID()
+	if v := d.Get("management_group_ids").([]interface{}); len(v) > 0 {
+		managementGroups := expandResourceGraphQueryScopes(v)
+		input.ManagementGroups = pointer.To(managementGroups)
+		scope = fmt.Sprintf("Management Groups %q", strings.Join(managementGroups, ", "))
+	} else if v := d.Get("subscription_ids").([]interface{}); len(v) > 0 {
+		subscriptions := expandResourceGraphQueryScopes(v)
+		input.Subscriptions = pointer.To(subscriptions)
+		scope = fmt.Sprintf("Subscriptions %q", strings.Join(subscriptions, ", "))
+	} else {
+		input.Subscriptions = pointer.To([]string{subscriptionId})
+	}
+
+	fetch := func(ctx context.Context, input azuresdkhacks.QueryRequest) (*azuresdkhacks.QueryResponse, error) {
+		resp, err := client.Resources(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Model, nil
+	}
+
+	result, err := runResourceGraphQuery(ctx, fetch, input, d.Get("max_results").(int))
+	if err != nil {
+		return fmt.Errorf("running Resource Graph query against %s: %+v", scope, err)
+	}
+
+	d.SetId(fmt.Sprintf("resourceGraphQuery/%d", pluginsdk.HashString(fmt.Sprintf("%s|%s", scope, input.Query))))
+
+	if err := d.Set("results", result.Rows); err != nil {
+		return fmt.Errorf("setting `results`: %+v", err)
+	}
+	d.Set("result_count", len(result.Rows))
+	d.Set("total_records", int(result.TotalRecords))
+	d.Set("truncated", result.Truncated)
+
+	return nil
+}
+
+func expandResourceGraphQueryScopes(input []interface{}) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		output = append(output, v.(string))
+	}
+	return output
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceGraphQueryDataSource struct{}
+
+func TestAccDataSourceResourceGraphQuery_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results.#").HasValue("1"),
+				check.That(data.ResourceName).Key("result_count").HasValue("1"),
+				check.That(data.ResourceName).Key("truncated").HasValue("false"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceGraphQuery_maxResults(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.maxResults(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results.#").HasValue("1"),
+				check.That(data.ResourceName).Key("total_records").HasValue("2"),
+				check.That(data.ResourceName).Key("truncated").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceGraphQuery_subscriptionIds(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.subscriptionIds(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+// NOTE: the Resource Graph is eventually consistent, so the resources are provisioned in a separate step
+func (ResourceGraphQueryDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGraphQueryDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_graph_query" "test" {
+  query = <<QUERY
+Resources
+| where type =~ 'Microsoft.Network/virtualNetworks' and resourceGroup =~ '${azurerm_resource_group.test.name}'
+| project id, name, addressPrefixes = properties.addressSpace.addressPrefixes
+QUERY
+}
+`, r.template(data))
+}
+
+func (r ResourceGraphQueryDataSource) maxResults(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_graph_query" "test" {
+  query       = "Resources | where resourceGroup =~ '${azurerm_resource_group.test.name}' | project id, name, type | order by name asc"
+  max_results = 1
+}
+`, r.template(data))
+}
+
+func (r ResourceGraphQueryDataSource) subscriptionIds(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_resource_graph_query" "test" {
+  query            = "Resources | where resourceGroup =~ '${azurerm_resource_group.test.name}' | project id, name, type"
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`, r.template(data))
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/azuresdkhacks"
+)
+
+func TestRunResourceGraphQuery(t *testing.T) {
+	testData := []struct {
+		Name              string
+		Pages             []azuresdkhacks.QueryResponse
+		MaxResults        int
+		ExpectedRows      []string
+		ExpectedTruncated bool
+		ExpectedRequests  int
+		ExpectedTop       []int64
+	}{
+		{
+			Name: "Single Page",
+			Pages: []azuresdkhacks.QueryResponse{
+				{TotalRecords: 2, Data: rawRows(`{"id":"a"}`, `{"id":"b"}`)},
+			},
+			MaxResults:        1000,
+			ExpectedRows:      []string{`{"id":"a"}`, `{"id":"b"}`},
+			ExpectedTruncated: false,
+			ExpectedRequests:  1,
+			ExpectedTop:       []int64{1000},
+		},
+		{
+			Name: "Multiple Pages",
+			Pages: []azuresdkhacks.QueryResponse{
+				{TotalRecords: 3, Data: rawRows(`{"id":"a"}`, `{"id":"b"}`), SkipToken: pointer.To("token1")},
+				{TotalRecords: 3, Data: rawRows(`{"id":"c"}`)},
+			},
+			MaxResults:        1001,
+			ExpectedRows:      []string{`{"id":"a"}`, `{"id":"b"}`, `{"id":"c"}`},
+			ExpectedTruncated: false,
+			ExpectedRequests:  2,
+			ExpectedTop:       []int64{1000, 999},
+		},
+		{
+			Name: "Capped By Max Results",
+			Pages: []azuresdkhacks.QueryResponse{
+				{TotalRecords: 4, Data: rawRows(`{"id":"a"}`, `{"id":"b"}`), SkipToken: pointer.To("token1")},
+				{TotalRecords: 4, Data: rawRows(`{"id":"c"}`, `{"id":"d"}`)},
+			},
+			MaxResults:        2,
+			ExpectedRows:      []string{`{"id":"a"}`, `{"id":"b"}`},
+			ExpectedTruncated: true,
+			ExpectedRequests:  1,
+			ExpectedTop:       []int64{2},
+		},
+		{
+			Name: "Truncated By The API",
+			Pages: []azuresdkhacks.QueryResponse{
+				{TotalRecords: 5, Data: rawRows(`{"count_":5}`), ResultTruncated: azuresdkhacks.ResultTruncatedTrue},
+			},
+			MaxResults:        1000,
+			ExpectedRows:      []string{`{"count_":5}`},
+			ExpectedTruncated: true,
+			ExpectedRequests:  1,
+			ExpectedTop:       []int64{1000},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		requests := make([]azuresdkhacks.QueryRequest, 0)
+		fetch := func(ctx context.Context, input azuresdkhacks.QueryRequest) (*azuresdkhacks.QueryResponse, error) {
+			if len(requests) >= len(v.Pages) {
+				return nil, fmt.Errorf("unexpected request %d", len(requests)+1)
+			}
+
+			options := *input.Options
+			input.Options = &options
+			requests = append(requests, input)
+			return &v.Pages[len(requests)-1], nil
+		}
+
+		input := azuresdkhacks.QueryRequest{
+			Query: "Resources",
+			Options: &azuresdkhacks.QueryRequestOptions{
+				Skip: pointer.To(int64(0)),
+			},
+		}
+		actual, err := runResourceGraphQuery(context.TODO(), fetch, input, v.MaxResults)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if !reflect.DeepEqual(actual.Rows, v.ExpectedRows) {
+			t.Fatalf("expected rows %+v but got %+v", v.ExpectedRows, actual.Rows)
+		}
+
+		if actual.Truncated != v.ExpectedTruncated {
+			t.Fatalf("expected truncated to be %t but got %t", v.ExpectedTruncated, actual.Truncated)
+		}
+
+		if len(requests) != v.ExpectedRequests {
+			t.Fatalf("expected %d requests but got %d", v.ExpectedRequests, len(requests))
+		}
+
+		for i, request := range requests {
+			if top := pointer.From(request.Options.Top); top != v.ExpectedTop[i] {
+				t.Fatalf("expected request %d to have a `$top` of %d but got %d", i, v.ExpectedTop[i], top)
+			}
+
+			if pointer.From(request.Options.ResultFormat) != azuresdkhacks.ResultFormatObjectArray {
+				t.Fatalf("expected request %d to use the `objectArray` result format", i)
+			}
+
+			if i > 0 {
+				if request.Options.SkipToken == nil || *request.Options.SkipToken != *v.Pages[i-1].SkipToken {
+					t.Fatalf("expected request %d to use the skip token from the previous page", i)
+				}
+				if request.Options.Skip != nil {
+					t.Fatalf("expected request %d not to specify `$skip`", i)
+				}
+			}
+		}
+	}
+}
+
+func rawRows(input ...string) []json.RawMessage {
+	output := make([]json.RawMessage, 0)
+	for _, v := range input {
+		output = append(output, json.RawMessage(v))
+	}
+	return output
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/querykeys"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/sharedprivatelinkresources"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
 )
 
 type Client struct {
@@ -18,6 +20,51 @@ type Client struct {
 	QueryKeysClient                       *querykeys.QueryKeysClient
 	ServicesClient                        *services.ServicesClient
 	SearchSharedPrivateLinkResourceClient *sharedprivatelinkresources.SharedPrivateLinkResourcesClient
+
+	authorizerFunc  common.ApiAuthorizerFunc
+	environmentName string
+	userAgent       string
+}
+
+// DataPlaneEndpoint returns the data-plane endpoint for the Search Service with the specified name
+func (c *Client) DataPlaneEndpoint(searchServiceName string) string {
+	domainSuffix := "search.windows.net"
+	switch c.environmentName {
+	case environments.AzureChinaCloud:
+		domainSuffix = "search.azure.cn"
+	case environments.AzureUSGovernmentCloud:
+		domainSuffix = "search.azure.us"
+	}
+
+	return fmt.Sprintf("https://%s.%s", searchServiceName, domainSuffix)
+}
+
+// DataPlaneClientWithAdminKey returns a data-plane client which authenticates using the specified Admin Key
+func (c *Client) DataPlaneClientWithAdminKey(searchServiceName, adminKey string) *dataplane.Client {
+	client := dataplane.NewClientWithAdminKey(c.DataPlaneEndpoint(searchServiceName), adminKey)
+	client.Client.SetUserAgent(c.userAgent)
+	return client
+}
+
+// DataPlaneClientWithAzureAD returns a data-plane client which authenticates using an Entra ID token
+func (c *Client) DataPlaneClientWithAzureAD(searchServiceName string) (*dataplane.Client, error) {
+	audience := "https://search.azure.com"
+	switch c.environmentName {
+	case environments.AzureChinaCloud:
+		audience = "https://search.azure.cn"
+	case environments.AzureUSGovernmentCloud:
+		audience = "https://search.azure.us"
+	}
+
+	api := environments.NewApiEndpoint("Search", audience, nil)
+	authorizer, err := c.authorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", audience, err)
+	}
+
+	client := dataplane.NewClientWithAuthorizer(c.DataPlaneEndpoint(searchServiceName), authorizer)
+	client.Client.SetUserAgent(c.userAgent)
+	return client, nil
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -50,5 +97,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		QueryKeysClient:                       queryKeysClient,
 		ServicesClient:                        servicesClient,
 		SearchSharedPrivateLinkResourceClient: searchSharedPrivateLinkResourceClient,
+
+		authorizerFunc:  o.Authorizers.AuthorizerFunc,
+		environmentName: o.Environment.Name,
+		userAgent:       servicesClient.Client.GetUserAgent(),
 	}, nil
 }
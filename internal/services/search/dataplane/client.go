@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const apiVersion = "2023-11-01"
+
+// Client is a minimal client for the Azure AI Search data-plane REST API, which isn't
+// available in hashicorp/go-azure-sdk - it covers Indexes, Data Sources, Indexers and Skillsets.
+type Client struct {
+	Client *client.Client
+}
+
+// NewClientWithAuthorizer returns a Client for the Search Service at `endpoint` which authenticates using
+// an Entra ID token scoped to the Search data-plane
+func NewClientWithAuthorizer(endpoint string, authorizer auth.Authorizer) *Client {
+	baseClient := client.NewClient(endpoint, "searchdataplane", apiVersion)
+	baseClient.Authorizer = authorizer
+
+	return &Client{
+		Client: baseClient,
+	}
+}
+
+// NewClientWithAdminKey returns a Client for the Search Service at `endpoint` which authenticates using
+// the specified Admin Key
+func NewClientWithAdminKey(endpoint string, adminKey string) *Client {
+	baseClient := client.NewClient(endpoint, "searchdataplane", apiVersion)
+	baseClient.AuthorizeRequest = func(_ context.Context, req *http.Request, _ auth.Authorizer) error {
+		req.Header.Set("api-key", adminKey)
+		return nil
+	}
+
+	return &Client{
+		Client: baseClient,
+	}
+}
+
+func (c Client) newRequest(ctx context.Context, input client.RequestOptions, query url.Values) (*client.Request, error) {
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("pre-validating request payload: %+v", err)
+	}
+
+	req, err := c.Client.NewRequest(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("building %s request: %+v", input.HttpMethod, err)
+	}
+
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("api-version", apiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	return req, nil
+}
+
+func (c Client) get(ctx context.Context, path string, model interface{}) (*http.Response, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       path,
+	}
+
+	req, err := c.newRequest(ctx, opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp == nil {
+		return nil, err
+	}
+	if err != nil {
+		return resp.Response, err
+	}
+
+	if err := resp.Unmarshal(model); err != nil {
+		return resp.Response, err
+	}
+
+	return resp.Response, nil
+}
+
+func (c Client) createOrUpdate(ctx context.Context, path string, input interface{}, query url.Values) (*http.Response, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       path,
+	}
+
+	req, err := c.newRequest(ctx, opts, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API otherwise returns the full representation of the object, which we don't need since we Read afterwards
+	req.Header.Set("Prefer", "return=minimal")
+
+	if err := req.Marshal(input); err != nil {
+		return nil, fmt.Errorf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp == nil {
+		return nil, err
+	}
+
+	return resp.Response, err
+}
+
+func (c Client) delete(ctx context.Context, path string) (*http.Response, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusNotFound,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       path,
+	}
+
+	req, err := c.newRequest(ctx, opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp == nil {
+		return nil, err
+	}
+
+	return resp.Response, err
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type GetIndexResponse struct {
+	HttpResponse *http.Response
+	Model        *Index
+}
+
+type CreateOrUpdateIndexOptions struct {
+	// AllowIndexDowntime allows new analyzers, tokenizers, token filters or char filters to be added to an index
+	// by taking the index offline for at least a few seconds
+	AllowIndexDowntime bool
+}
+
+func (c Client) GetIndex(ctx context.Context, name string) (result GetIndexResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, fmt.Sprintf("/indexes('%s')", url.PathEscape(name)), &result.Model)
+	return
+}
+
+func (c Client) CreateOrUpdateIndex(ctx context.Context, input Index, options CreateOrUpdateIndexOptions) (*http.Response, error) {
+	query := url.Values{}
+	if options.AllowIndexDowntime {
+		query.Set("allowIndexDowntime", "true")
+	}
+	return c.createOrUpdate(ctx, fmt.Sprintf("/indexes('%s')", url.PathEscape(input.Name)), input, query)
+}
+
+func (c Client) DeleteIndex(ctx context.Context, name string) (*http.Response, error) {
+	return c.delete(ctx, fmt.Sprintf("/indexes('%s')", url.PathEscape(name)))
+}
+
+type GetDataSourceResponse struct {
+	HttpResponse *http.Response
+	Model        *DataSource
+}
+
+func (c Client) GetDataSource(ctx context.Context, name string) (result GetDataSourceResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, fmt.Sprintf("/datasources('%s')", url.PathEscape(name)), &result.Model)
+	return
+}
+
+func (c Client) CreateOrUpdateDataSource(ctx context.Context, input DataSource) (*http.Response, error) {
+	return c.createOrUpdate(ctx, fmt.Sprintf("/datasources('%s')", url.PathEscape(input.Name)), input, nil)
+}
+
+func (c Client) DeleteDataSource(ctx context.Context, name string) (*http.Response, error) {
+	return c.delete(ctx, fmt.Sprintf("/datasources('%s')", url.PathEscape(name)))
+}
+
+type GetIndexerResponse struct {
+	HttpResponse *http.Response
+	Model        *Indexer
+}
+
+func (c Client) GetIndexer(ctx context.Context, name string) (result GetIndexerResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, fmt.Sprintf("/indexers('%s')", url.PathEscape(name)), &result.Model)
+	return
+}
+
+func (c Client) CreateOrUpdateIndexer(ctx context.Context, input Indexer) (*http.Response, error) {
+	return c.createOrUpdate(ctx, fmt.Sprintf("/indexers('%s')", url.PathEscape(input.Name)), input, nil)
+}
+
+func (c Client) DeleteIndexer(ctx context.Context, name string) (*http.Response, error) {
+	return c.delete(ctx, fmt.Sprintf("/indexers('%s')", url.PathEscape(name)))
+}
+
+type GetSkillsetResponse struct {
+	HttpResponse *http.Response
+	Model        *Skillset
+}
+
+func (c Client) GetSkillset(ctx context.Context, name string) (result GetSkillsetResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, fmt.Sprintf("/skillsets('%s')", url.PathEscape(name)), &result.Model)
+	return
+}
+
+func (c Client) CreateOrUpdateSkillset(ctx context.Context, input Skillset) (*http.Response, error) {
+	return c.createOrUpdate(ctx, fmt.Sprintf("/skillsets('%s')", url.PathEscape(input.Name)), input, nil)
+}
+
+func (c Client) DeleteSkillset(ctx context.Context, name string) (*http.Response, error) {
+	return c.delete(ctx, fmt.Sprintf("/skillsets('%s')", url.PathEscape(name)))
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+import "encoding/json"
+
+type Index struct {
+	Name        string       `json:"name"`
+	Fields      []Field      `json:"fields"`
+	Suggesters  *[]Suggester `json:"suggesters,omitempty"`
+	CorsOptions *CorsOptions `json:"corsOptions,omitempty"`
+	ETag        *string      `json:"@odata.etag,omitempty"`
+}
+
+type Field struct {
+	Name           string    `json:"name"`
+	Type           string    `json:"type"`
+	Key            *bool     `json:"key,omitempty"`
+	Retrievable    *bool     `json:"retrievable,omitempty"`
+	Searchable     *bool     `json:"searchable,omitempty"`
+	Filterable     *bool     `json:"filterable,omitempty"`
+	Sortable       *bool     `json:"sortable,omitempty"`
+	Facetable      *bool     `json:"facetable,omitempty"`
+	Analyzer       *string   `json:"analyzer,omitempty"`
+	SearchAnalyzer *string   `json:"searchAnalyzer,omitempty"`
+	IndexAnalyzer  *string   `json:"indexAnalyzer,omitempty"`
+	SynonymMaps    *[]string `json:"synonymMaps,omitempty"`
+}
+
+type Suggester struct {
+	Name         string   `json:"name"`
+	SearchMode   string   `json:"searchMode"`
+	SourceFields []string `json:"sourceFields"`
+}
+
+type CorsOptions struct {
+	AllowedOrigins  []string `json:"allowedOrigins"`
+	MaxAgeInSeconds *int64   `json:"maxAgeInSeconds,omitempty"`
+}
+
+type DataSource struct {
+	Name                      string                     `json:"name"`
+	Description               *string                    `json:"description,omitempty"`
+	Type                      string                     `json:"type"`
+	Credentials               DataSourceCredentials      `json:"credentials"`
+	Container                 DataContainer              `json:"container"`
+	DataChangeDetectionPolicy *DataChangeDetectionPolicy `json:"dataChangeDetectionPolicy,omitempty"`
+	ETag                      *string                    `json:"@odata.etag,omitempty"`
+}
+
+type DataSourceCredentials struct {
+	// ConnectionString is never returned by the API, instead this is returned as `null`
+	ConnectionString *string `json:"connectionString"`
+}
+
+type DataContainer struct {
+	Name  string  `json:"name"`
+	Query *string `json:"query,omitempty"`
+}
+
+const DataChangeDetectionPolicyTypeHighWaterMark = "#Microsoft.Azure.Search.HighWaterMarkChangeDetectionPolicy"
+
+type DataChangeDetectionPolicy struct {
+	ODataType           string  `json:"@odata.type"`
+	HighWaterMarkColumn *string `json:"highWaterMarkColumnName,omitempty"`
+}
+
+type Indexer struct {
+	Name            string              `json:"name"`
+	Description     *string             `json:"description,omitempty"`
+	DataSourceName  string              `json:"dataSourceName"`
+	TargetIndexName string              `json:"targetIndexName"`
+	SkillsetName    *string             `json:"skillsetName,omitempty"`
+	Schedule        *IndexingSchedule   `json:"schedule,omitempty"`
+	Parameters      *IndexingParameters `json:"parameters,omitempty"`
+	FieldMappings   *[]FieldMapping     `json:"fieldMappings,omitempty"`
+	Disabled        *bool               `json:"disabled,omitempty"`
+	ETag            *string             `json:"@odata.etag,omitempty"`
+}
+
+type IndexingSchedule struct {
+	Interval  string  `json:"interval"`
+	StartTime *string `json:"startTime,omitempty"`
+}
+
+type IndexingParameters struct {
+	BatchSize              *int64 `json:"batchSize,omitempty"`
+	MaxFailedItems         *int64 `json:"maxFailedItems,omitempty"`
+	MaxFailedItemsPerBatch *int64 `json:"maxFailedItemsPerBatch,omitempty"`
+}
+
+type FieldMapping struct {
+	SourceFieldName string                `json:"sourceFieldName"`
+	TargetFieldName *string               `json:"targetFieldName,omitempty"`
+	MappingFunction *FieldMappingFunction `json:"mappingFunction,omitempty"`
+}
+
+type FieldMappingFunction struct {
+	Name string `json:"name"`
+}
+
+type Skillset struct {
+	Name              string             `json:"name"`
+	Description       *string            `json:"description,omitempty"`
+	Skills            []json.RawMessage  `json:"skills"`
+	CognitiveServices *CognitiveServices `json:"cognitiveServices,omitempty"`
+	ETag              *string            `json:"@odata.etag,omitempty"`
+}
+
+const (
+	CognitiveServicesTypeByKey   = "#Microsoft.Azure.Search.CognitiveServicesByKey"
+	CognitiveServicesTypeDefault = "#Microsoft.Azure.Search.DefaultCognitiveServices"
+)
+
+type CognitiveServices struct {
+	ODataType string `json:"@odata.type"`
+	// Key is returned masked by the API
+	Key *string `json:"key,omitempty"`
+}
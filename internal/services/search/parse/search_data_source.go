@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SearchDataSourceId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	SearchServiceName string
+	DataSourceName    string
+}
+
+func NewSearchDataSourceID(subscriptionId, resourceGroup, searchServiceName, dataSourceName string) SearchDataSourceId {
+	return SearchDataSourceId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		SearchServiceName: searchServiceName,
+		DataSourceName:    dataSourceName,
+	}
+}
+
+func (id SearchDataSourceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Data Source Name %q", id.DataSourceName),
+		fmt.Sprintf("Search Service Name %q", id.SearchServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Search Data Source", segmentsStr)
+}
+
+func (id SearchDataSourceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s/dataSources/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SearchServiceName, id.DataSourceName)
+}
+
+// SearchDataSourceID parses a SearchDataSource ID into an SearchDataSourceId struct
+func SearchDataSourceID(input string) (*SearchDataSourceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an SearchDataSource ID: %+v", input, err)
+	}
+
+	resourceId := SearchDataSourceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SearchServiceName, err = id.PopSegment("searchServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.DataSourceName, err = id.PopSegment("dataSources"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SearchSkillsetId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	SearchServiceName string
+	SkillsetName      string
+}
+
+func NewSearchSkillsetID(subscriptionId, resourceGroup, searchServiceName, skillsetName string) SearchSkillsetId {
+	return SearchSkillsetId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		SearchServiceName: searchServiceName,
+		SkillsetName:      skillsetName,
+	}
+}
+
+func (id SearchSkillsetId) String() string {
+	segments := []string{
+		fmt.Sprintf("Skillset Name %q", id.SkillsetName),
+		fmt.Sprintf("Search Service Name %q", id.SearchServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Search Skillset", segmentsStr)
+}
+
+func (id SearchSkillsetId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s/skillsets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SearchServiceName, id.SkillsetName)
+}
+
+// SearchSkillsetID parses a SearchSkillset ID into an SearchSkillsetId struct
+func SearchSkillsetID(input string) (*SearchSkillsetId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an SearchSkillset ID: %+v", input, err)
+	}
+
+	resourceId := SearchSkillsetId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SearchServiceName, err = id.PopSegment("searchServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.SkillsetName, err = id.PopSegment("skillsets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
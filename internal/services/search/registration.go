@@ -33,6 +33,10 @@ func (r Registration) WebsiteCategories() []string {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		SearchDataSourceResource{},
+		SearchIndexResource{},
+		SearchIndexerResource{},
+		SearchSkillsetResource{},
 		SharedPrivateLinkServiceResource{},
 	}
 }
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/adminkeys"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+)
+
+// dataPlaneClientForSearchService returns a data-plane client for the specified Search Service.
+//
+// When local authentication is enabled for the Search Service the Primary Admin Key is used, otherwise
+// (e.g. when the Search Service is in RBAC-only mode) an Entra ID token is used - which requires that
+// the authenticating principal has been assigned the `Search Service Contributor` role.
+func dataPlaneClientForSearchService(ctx context.Context, client *client.Client, id services.SearchServiceId) (*dataplane.Client, error) {
+	resp, err := client.ServicesClient.Get(ctx, id, services.GetOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	localAuthDisabled := false
+	if model := resp.Model; model != nil && model.Properties != nil {
+		localAuthDisabled = pointer.From(model.Properties.DisableLocalAuth)
+	}
+
+	if localAuthDisabled {
+		return client.DataPlaneClientWithAzureAD(id.SearchServiceName)
+	}
+
+	adminKeysId := adminkeys.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+	keys, err := client.AdminKeysClient.Get(ctx, adminKeysId, adminkeys.GetOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Admin Keys for %s: %+v", id, err)
+	}
+	if keys.Model == nil || keys.Model.PrimaryKey == nil {
+		return nil, fmt.Errorf("retrieving Admin Keys for %s: `primaryKey` was nil", id)
+	}
+
+	return client.DataPlaneClientWithAdminKey(id.SearchServiceName, *keys.Model.PrimaryKey), nil
+}
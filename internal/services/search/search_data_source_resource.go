@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchDataSourceResource struct{}
+
+var _ sdk.ResourceWithUpdate = SearchDataSourceResource{}
+
+type SearchDataSourceModel struct {
+	Name                    string `tfschema:"name"`
+	SearchServiceId         string `tfschema:"search_service_id"`
+	Type                    string `tfschema:"type"`
+	ConnectionString        string `tfschema:"connection_string"`
+	ContainerName           string `tfschema:"container_name"`
+	ContainerQuery          string `tfschema:"container_query"`
+	Description             string `tfschema:"description"`
+	HighWaterMarkColumnName string `tfschema:"high_water_mark_column_name"`
+	ETag                    string `tfschema:"etag"`
+}
+
+func (r SearchDataSourceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"adlsgen2",
+				"azureblob",
+				"azuresql",
+				"azuretable",
+				"cosmosdb",
+				"mysql",
+			}, false),
+		},
+
+		"connection_string": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_query": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"high_water_mark_column_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SearchDataSourceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SearchDataSourceResource) ModelObject() interface{} {
+	return &SearchDataSourceModel{}
+}
+
+func (r SearchDataSourceResource) ResourceType() string {
+	return "azurerm_search_data_source"
+}
+
+func (r SearchDataSourceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchDataSourceID
+}
+
+func (r SearchDataSourceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchDataSourceID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, *searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetDataSource(ctx, id.DataSourceName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdateDataSource(ctx, expandSearchDataSource(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SearchDataSourceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetDataSource(ctx, id.DataSourceName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SearchDataSourceModel{
+				Name:            id.DataSourceName,
+				SearchServiceId: searchServiceId.ID(),
+				// the connection string isn't returned by the API, so we pull this from the config/state
+				ConnectionString: metadata.ResourceData.Get("connection_string").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Type = model.Type
+				state.Description = pointer.From(model.Description)
+				state.ContainerName = model.Container.Name
+				state.ContainerQuery = pointer.From(model.Container.Query)
+				state.ETag = pointer.From(model.ETag)
+
+				if policy := model.DataChangeDetectionPolicy; policy != nil && policy.ODataType == dataplane.DataChangeDetectionPolicyTypeHighWaterMark {
+					state.HighWaterMarkColumnName = pointer.From(policy.HighWaterMarkColumn)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SearchDataSourceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdateDataSource(ctx, expandSearchDataSource(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SearchDataSourceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.DeleteDataSource(ctx, id.DataSourceName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSearchDataSource(input SearchDataSourceModel) dataplane.DataSource {
+	output := dataplane.DataSource{
+		Name: input.Name,
+		Type: input.Type,
+		Credentials: dataplane.DataSourceCredentials{
+			ConnectionString: pointer.To(input.ConnectionString),
+		},
+		Container: dataplane.DataContainer{
+			Name: input.ContainerName,
+		},
+	}
+
+	if input.ContainerQuery != "" {
+		output.Container.Query = pointer.To(input.ContainerQuery)
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	if input.HighWaterMarkColumnName != "" {
+		output.DataChangeDetectionPolicy = &dataplane.DataChangeDetectionPolicy{
+			ODataType:           dataplane.DataChangeDetectionPolicyTypeHighWaterMark,
+			HighWaterMarkColumn: pointer.To(input.HighWaterMarkColumnName),
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SearchDataSourceResource struct{}
+
+func TestAccSearchDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func TestAccSearchDataSource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchDataSource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func (r SearchDataSourceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchDataSourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := testSearchDataPlaneClient(ctx, clients, id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetDataSource(ctx, id.DataSourceName)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SearchDataSourceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctest-ds-%d"
+  search_service_id = azurerm_search_service.test.id
+  type              = "azureblob"
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchDataSourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "import" {
+  name              = azurerm_search_data_source.test.name
+  search_service_id = azurerm_search_data_source.test.search_service_id
+  type              = azurerm_search_data_source.test.type
+  connection_string = azurerm_search_data_source.test.connection_string
+  container_name    = azurerm_search_data_source.test.container_name
+}
+`, r.basic(data))
+}
+
+func (r SearchDataSourceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctest-ds-%d"
+  search_service_id = azurerm_search_service.test.id
+  type              = "azureblob"
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  container_query   = "hotels"
+  description       = "Hotel documents"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (SearchDataSourceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "documents"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, SearchIndexResource{}.template(data), data.RandomString)
+}
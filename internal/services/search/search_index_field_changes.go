@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// searchIndexFieldRebuildReasons returns the reasons (if any) why changing the fields of a Search Index from
+// `old` to `new` requires the Index to be rebuilt.
+//
+// The Search API only supports adding new fields to an existing Index and changing the `retrievable`,
+// `search_analyzer` and `synonym_map_names` properties of existing fields - any other change (such as removing
+// a field, or changing its type or whether it's searchable) requires the Index to be dropped and re-created.
+func searchIndexFieldRebuildReasons(old, new []SearchIndexFieldModel) []string {
+	reasons := make([]string, 0)
+
+	newFields := make(map[string]SearchIndexFieldModel)
+	for _, v := range new {
+		newFields[v.Name] = v
+	}
+
+	for _, o := range old {
+		n, ok := newFields[o.Name]
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("field %q was removed", o.Name))
+			continue
+		}
+
+		changed := make([]string, 0)
+		if o.Type != n.Type {
+			changed = append(changed, "type")
+		}
+		if o.Key != n.Key {
+			changed = append(changed, "key")
+		}
+		if o.Searchable != n.Searchable {
+			changed = append(changed, "searchable")
+		}
+		if o.Filterable != n.Filterable {
+			changed = append(changed, "filterable")
+		}
+		if o.Sortable != n.Sortable {
+			changed = append(changed, "sortable")
+		}
+		if o.Facetable != n.Facetable {
+			changed = append(changed, "facetable")
+		}
+		if o.Analyzer != n.Analyzer {
+			changed = append(changed, "analyzer")
+		}
+		if o.IndexAnalyzer != n.IndexAnalyzer {
+			changed = append(changed, "index_analyzer")
+		}
+
+		if len(changed) > 0 {
+			reasons = append(reasons, fmt.Sprintf("field %q changed %s", o.Name, strings.Join(changed, ", ")))
+		}
+	}
+
+	return reasons
+}
+
+// searchIndexSuggesterRebuildReasons returns the reasons (if any) why changing the suggesters of a Search Index from
+// `old` to `new` requires the Index to be rebuilt - a suggester can only be added to an existing Index when all of its
+// source fields are new fields, and existing suggesters can't be changed.
+func searchIndexSuggesterRebuildReasons(oldFields []SearchIndexFieldModel, old, new []SearchIndexSuggesterModel) []string {
+	reasons := make([]string, 0)
+
+	existingFields := make(map[string]struct{})
+	for _, v := range oldFields {
+		existingFields[v.Name] = struct{}{}
+	}
+
+	oldSuggesters := make(map[string]SearchIndexSuggesterModel)
+	for _, v := range old {
+		oldSuggesters[v.Name] = v
+	}
+
+	newSuggesters := make(map[string]struct{})
+	for _, n := range new {
+		newSuggesters[n.Name] = struct{}{}
+
+		if o, ok := oldSuggesters[n.Name]; ok {
+			if !reflect.DeepEqual(o.SourceFields, n.SourceFields) {
+				reasons = append(reasons, fmt.Sprintf("the source fields for suggester %q changed", n.Name))
+			}
+			continue
+		}
+
+		for _, field := range n.SourceFields {
+			if _, ok := existingFields[field]; ok {
+				reasons = append(reasons, fmt.Sprintf("suggester %q uses the existing field %q", n.Name, field))
+			}
+		}
+	}
+
+	for _, o := range old {
+		if _, ok := newSuggesters[o.Name]; !ok {
+			reasons = append(reasons, fmt.Sprintf("suggester %q was removed", o.Name))
+		}
+	}
+
+	return reasons
+}
+
+// validateSearchIndexFields validates the combination of fields within a Search Index, which can't be validated
+// at the schema level
+func validateSearchIndexFields(input []SearchIndexFieldModel) error {
+	keys := make([]string, 0)
+	names := make(map[string]struct{})
+	allKnown := true
+	for _, v := range input {
+		if v.Name == "" || v.Type == "" {
+			// the value isn't known yet
+			allKnown = false
+			continue
+		}
+
+		if _, ok := names[v.Name]; ok {
+			return fmt.Errorf("the field %q is defined more than once", v.Name)
+		}
+		names[v.Name] = struct{}{}
+
+		if v.Key {
+			if v.Type != "Edm.String" {
+				return fmt.Errorf("the key field %q must be of type `Edm.String` but got %q", v.Name, v.Type)
+			}
+			keys = append(keys, v.Name)
+		}
+
+		isString := v.Type == "Edm.String" || v.Type == "Collection(Edm.String)"
+		if v.Searchable && !isString {
+			return fmt.Errorf("the field %q can only be `searchable` when it's of type `Edm.String` or `Collection(Edm.String)`", v.Name)
+		}
+		if (v.Analyzer != "" || v.SearchAnalyzer != "" || v.IndexAnalyzer != "") && !v.Searchable {
+			return fmt.Errorf("an analyzer can only be specified for the field %q when it's `searchable`", v.Name)
+		}
+		if v.Analyzer != "" && (v.SearchAnalyzer != "" || v.IndexAnalyzer != "") {
+			return fmt.Errorf("`analyzer` cannot be specified for the field %q when `search_analyzer` or `index_analyzer` are specified", v.Name)
+		}
+		if (v.SearchAnalyzer != "") != (v.IndexAnalyzer != "") {
+			return fmt.Errorf("`search_analyzer` and `index_analyzer` must be specified together for the field %q", v.Name)
+		}
+		if v.Sortable && strings.HasPrefix(v.Type, "Collection(") {
+			return fmt.Errorf("the field %q cannot be `sortable` since it's a collection", v.Name)
+		}
+	}
+
+	if allKnown && len(keys) != 1 {
+		return fmt.Errorf("exactly one field must be marked as the `key` but got %d", len(keys))
+	}
+
+	return nil
+}
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"testing"
+)
+
+func TestSearchIndexFieldRebuildReasons(t *testing.T) {
+	existing := []SearchIndexFieldModel{
+		{
+			Name:        "id",
+			Type:        "Edm.String",
+			Key:         true,
+			Retrievable: true,
+		},
+		{
+			Name:        "description",
+			Type:        "Edm.String",
+			Retrievable: true,
+			Searchable:  true,
+			Analyzer:    "en.lucene",
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		New      []SearchIndexFieldModel
+		Expected int
+	}{
+		{
+			Name:     "No Changes",
+			New:      existing,
+			Expected: 0,
+		},
+		{
+			Name: "Field Added",
+			New: append(existing, SearchIndexFieldModel{
+				Name:       "rating",
+				Type:       "Edm.Double",
+				Filterable: true,
+			}),
+			Expected: 0,
+		},
+		{
+			Name: "Retrievable and Synonym Maps Changed",
+			New: []SearchIndexFieldModel{
+				existing[0],
+				{
+					Name:            "description",
+					Type:            "Edm.String",
+					Retrievable:     false,
+					Searchable:      true,
+					Analyzer:        "en.lucene",
+					SynonymMapNames: []string{"synonyms"},
+				},
+			},
+			Expected: 0,
+		},
+		{
+			Name: "Field Removed",
+			New: []SearchIndexFieldModel{
+				existing[0],
+			},
+			Expected: 1,
+		},
+		{
+			Name: "Field Type and Filterable Changed",
+			New: []SearchIndexFieldModel{
+				existing[0],
+				{
+					Name:        "description",
+					Type:        "Collection(Edm.String)",
+					Retrievable: true,
+					Searchable:  true,
+					Filterable:  true,
+					Analyzer:    "en.lucene",
+				},
+			},
+			Expected: 1,
+		},
+		{
+			Name: "Analyzer Changed and Key Moved",
+			New: []SearchIndexFieldModel{
+				{
+					Name:        "id",
+					Type:        "Edm.String",
+					Retrievable: true,
+				},
+				{
+					Name:        "description",
+					Type:        "Edm.String",
+					Key:         true,
+					Retrievable: true,
+					Searchable:  true,
+					Analyzer:    "fr.lucene",
+				},
+			},
+			Expected: 2,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := searchIndexFieldRebuildReasons(existing, v.New)
+		if len(actual) != v.Expected {
+			t.Fatalf("expected %d reasons but got %d: %+v", v.Expected, len(actual), actual)
+		}
+	}
+}
+
+func TestSearchIndexSuggesterRebuildReasons(t *testing.T) {
+	fields := []SearchIndexFieldModel{
+		{
+			Name: "id",
+			Type: "Edm.String",
+		},
+		{
+			Name: "name",
+			Type: "Edm.String",
+		},
+	}
+	existing := []SearchIndexSuggesterModel{
+		{
+			Name:         "sg",
+			SourceFields: []string{"name"},
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Old      []SearchIndexSuggesterModel
+		New      []SearchIndexSuggesterModel
+		Expected int
+	}{
+		{
+			Name:     "No Changes",
+			Old:      existing,
+			New:      existing,
+			Expected: 0,
+		},
+		{
+			Name: "Suggester Added using New Field",
+			Old:  []SearchIndexSuggesterModel{},
+			New: []SearchIndexSuggesterModel{
+				{
+					Name:         "sg",
+					SourceFields: []string{"title"},
+				},
+			},
+			Expected: 0,
+		},
+		{
+			Name:     "Suggester Added using Existing Field",
+			Old:      []SearchIndexSuggesterModel{},
+			New:      existing,
+			Expected: 1,
+		},
+		{
+			Name:     "Suggester Removed",
+			Old:      existing,
+			New:      []SearchIndexSuggesterModel{},
+			Expected: 1,
+		},
+		{
+			Name: "Suggester Source Fields Changed",
+			Old:  existing,
+			New: []SearchIndexSuggesterModel{
+				{
+					Name:         "sg",
+					SourceFields: []string{"name", "title"},
+				},
+			},
+			Expected: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := searchIndexSuggesterRebuildReasons(fields, v.Old, v.New)
+		if len(actual) != v.Expected {
+			t.Fatalf("expected %d reasons but got %d: %+v", v.Expected, len(actual), actual)
+		}
+	}
+}
+
+func TestValidateSearchIndexFields(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input []SearchIndexFieldModel
+		Valid bool
+	}{
+		{
+			Name: "Valid",
+			Input: []SearchIndexFieldModel{
+				{
+					Name: "id",
+					Type: "Edm.String",
+					Key:  true,
+				},
+				{
+					Name:       "description",
+					Type:       "Edm.String",
+					Searchable: true,
+					Analyzer:   "en.lucene",
+				},
+			},
+			Valid: true,
+		},
+		{
+			Name: "No Key",
+			Input: []SearchIndexFieldModel{
+				{
+					Name: "id",
+					Type: "Edm.String",
+				},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Unknown Values",
+			Input: []SearchIndexFieldModel{
+				{
+					Name: "",
+					Type: "Edm.String",
+					Key:  true,
+				},
+			},
+			Valid: true,
+		},
+		{
+			Name: "Multiple Keys",
+			Input: []SearchIndexFieldModel{
+				{
+					Name: "id",
+					Type: "Edm.String",
+					Key:  true,
+				},
+				{
+					Name: "id2",
+					Type: "Edm.String",
+					Key:  true,
+				},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Non-String Key",
+			Input: []SearchIndexFieldModel{
+				{
+					Name: "id",
+					Type: "Edm.Int32",
+					Key:  true,
+				},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Duplicate Field",
+			Input: []SearchIndexFieldModel{
+				{
+					Name: "id",
+					Type: "Edm.String",
+					Key:  true,
+				},
+				{
+					Name: "id",
+					Type: "Edm.Int32",
+				},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Searchable Number",
+			Input: []SearchIndexFieldModel{
+				{
+					Name: "id",
+					Type: "Edm.String",
+					Key:  true,
+				},
+				{
+					Name:       "rating",
+					Type:       "Edm.Double",
+					Searchable: true,
+				},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Analyzer and Search Analyzer",
+			Input: []SearchIndexFieldModel{
+				{
+					Name: "id",
+					Type: "Edm.String",
+					Key:  true,
+				},
+				{
+					Name:           "description",
+					Type:           "Edm.String",
+					Searchable:     true,
+					Analyzer:       "en.lucene",
+					SearchAnalyzer: "en.lucene",
+					IndexAnalyzer:  "en.lucene",
+				},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Sortable Collection",
+			Input: []SearchIndexFieldModel{
+				{
+					Name: "id",
+					Type: "Edm.String",
+					Key:  true,
+				},
+				{
+					Name:     "tags",
+					Type:     "Collection(Edm.String)",
+					Sortable: true,
+				},
+			},
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateSearchIndexFields(v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
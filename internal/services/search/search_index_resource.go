@@ -0,0 +1,588 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchIndexResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = SearchIndexResource{}
+	_ sdk.ResourceWithCustomizeDiff = SearchIndexResource{}
+)
+
+type SearchIndexModel struct {
+	Name            string                      `tfschema:"name"`
+	SearchServiceId string                      `tfschema:"search_service_id"`
+	Field           []SearchIndexFieldModel     `tfschema:"field"`
+	Suggester       []SearchIndexSuggesterModel `tfschema:"suggester"`
+	Cors            []SearchIndexCorsModel      `tfschema:"cors"`
+	ETag            string                      `tfschema:"etag"`
+}
+
+type SearchIndexFieldModel struct {
+	Name            string   `tfschema:"name"`
+	Type            string   `tfschema:"type"`
+	Key             bool     `tfschema:"key"`
+	Retrievable     bool     `tfschema:"retrievable"`
+	Searchable      bool     `tfschema:"searchable"`
+	Filterable      bool     `tfschema:"filterable"`
+	Sortable        bool     `tfschema:"sortable"`
+	Facetable       bool     `tfschema:"facetable"`
+	Analyzer        string   `tfschema:"analyzer"`
+	SearchAnalyzer  string   `tfschema:"search_analyzer"`
+	IndexAnalyzer   string   `tfschema:"index_analyzer"`
+	SynonymMapNames []string `tfschema:"synonym_map_names"`
+}
+
+type SearchIndexSuggesterModel struct {
+	Name         string   `tfschema:"name"`
+	SourceFields []string `tfschema:"source_fields"`
+}
+
+type SearchIndexCorsModel struct {
+	AllowedOrigins  []string `tfschema:"allowed_origins"`
+	MaxAgeInSeconds int64    `tfschema:"max_age_in_seconds"`
+}
+
+func possibleValuesForSearchIndexFieldType() []string {
+	primitives := []string{
+		"Edm.Boolean",
+		"Edm.DateTimeOffset",
+		"Edm.Double",
+		"Edm.GeographyPoint",
+		"Edm.Int32",
+		"Edm.Int64",
+		"Edm.String",
+	}
+
+	out := make([]string, 0)
+	for _, v := range primitives {
+		out = append(out, v, fmt.Sprintf("Collection(%s)", v))
+	}
+	return out
+}
+
+func (r SearchIndexResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"field": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(possibleValuesForSearchIndexFieldType(), false),
+					},
+
+					"key": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"retrievable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"searchable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"filterable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"sortable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"facetable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"analyzer": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"search_analyzer": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"index_analyzer": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"synonym_map_names": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"suggester": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"source_fields": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"cors": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"allowed_origins": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"max_age_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      300,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r SearchIndexResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SearchIndexResource) ModelObject() interface{} {
+	return &SearchIndexModel{}
+}
+
+func (r SearchIndexResource) ResourceType() string {
+	return "azurerm_search_index"
+}
+
+func (r SearchIndexResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchIndexID
+}
+
+func (r SearchIndexResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchIndexModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchIndexID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, *searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetIndex(ctx, id.IndexName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdateIndex(ctx, expandSearchIndex(model), dataplane.CreateOrUpdateIndexOptions{}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SearchIndexResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetIndex(ctx, id.IndexName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SearchIndexModel{
+				Name:            id.IndexName,
+				SearchServiceId: searchServiceId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Field = flattenSearchIndexFields(model.Fields)
+				state.Suggester = flattenSearchIndexSuggesters(model.Suggesters)
+				state.Cors = flattenSearchIndexCors(model.CorsOptions)
+				state.ETag = pointer.From(model.ETag)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SearchIndexResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchIndexModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			// changes which require the Index to be rebuilt are caught in the CustomizeDiff, so the remaining
+			// changes can be applied in-place by sending the full definition
+			if _, err := client.CreateOrUpdateIndex(ctx, expandSearchIndex(model), dataplane.CreateOrUpdateIndexOptions{}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SearchIndexResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.DeleteIndex(ctx, id.IndexName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SearchIndexResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			newFields := expandSearchIndexFieldsFromDiff(rd.Get("field").([]interface{}))
+			if err := validateSearchIndexFields(newFields); err != nil {
+				return err
+			}
+
+			if rd.Id() == "" {
+				return nil
+			}
+
+			if rd.HasChange("field") {
+				oldRaw, newRaw := rd.GetChange("field")
+				reasons := searchIndexFieldRebuildReasons(expandSearchIndexFieldsFromDiff(oldRaw.([]interface{})), expandSearchIndexFieldsFromDiff(newRaw.([]interface{})))
+				if len(reasons) > 0 {
+					metadata.Logger.Infof("the Search Index must be rebuilt since: %s", strings.Join(reasons, ", "))
+					if err := rd.ForceNew("field"); err != nil {
+						return err
+					}
+				}
+			}
+
+			if rd.HasChange("suggester") {
+				oldFieldsRaw, _ := rd.GetChange("field")
+				oldSuggestersRaw, newSuggestersRaw := rd.GetChange("suggester")
+				reasons := searchIndexSuggesterRebuildReasons(expandSearchIndexFieldsFromDiff(oldFieldsRaw.([]interface{})), expandSearchIndexSuggestersFromDiff(oldSuggestersRaw.([]interface{})), expandSearchIndexSuggestersFromDiff(newSuggestersRaw.([]interface{})))
+				if len(reasons) > 0 {
+					metadata.Logger.Infof("the Search Index must be rebuilt since: %s", strings.Join(reasons, ", "))
+					if err := rd.ForceNew("suggester"); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSearchIndex(input SearchIndexModel) dataplane.Index {
+	fields := make([]dataplane.Field, 0)
+	for _, v := range input.Field {
+		field := dataplane.Field{
+			Name:        v.Name,
+			Type:        v.Type,
+			Key:         pointer.To(v.Key),
+			Retrievable: pointer.To(v.Retrievable),
+			Searchable:  pointer.To(v.Searchable),
+			Filterable:  pointer.To(v.Filterable),
+			Sortable:    pointer.To(v.Sortable),
+			Facetable:   pointer.To(v.Facetable),
+		}
+
+		if v.Analyzer != "" {
+			field.Analyzer = pointer.To(v.Analyzer)
+		}
+		if v.SearchAnalyzer != "" {
+			field.SearchAnalyzer = pointer.To(v.SearchAnalyzer)
+		}
+		if v.IndexAnalyzer != "" {
+			field.IndexAnalyzer = pointer.To(v.IndexAnalyzer)
+		}
+		if len(v.SynonymMapNames) > 0 {
+			field.SynonymMaps = pointer.To(v.SynonymMapNames)
+		}
+
+		fields = append(fields, field)
+	}
+
+	suggesters := make([]dataplane.Suggester, 0)
+	for _, v := range input.Suggester {
+		suggesters = append(suggesters, dataplane.Suggester{
+			Name: v.Name,
+			// this is the only supported value
+			SearchMode:   "analyzingInfixMatching",
+			SourceFields: v.SourceFields,
+		})
+	}
+
+	output := dataplane.Index{
+		Name:       input.Name,
+		Fields:     fields,
+		Suggesters: &suggesters,
+	}
+
+	if len(input.Cors) > 0 {
+		output.CorsOptions = &dataplane.CorsOptions{
+			AllowedOrigins:  input.Cors[0].AllowedOrigins,
+			MaxAgeInSeconds: pointer.To(input.Cors[0].MaxAgeInSeconds),
+		}
+	}
+
+	return output
+}
+
+func flattenSearchIndexFields(input []dataplane.Field) []SearchIndexFieldModel {
+	output := make([]SearchIndexFieldModel, 0)
+	for _, v := range input {
+		output = append(output, SearchIndexFieldModel{
+			Name:            v.Name,
+			Type:            v.Type,
+			Key:             pointer.From(v.Key),
+			Retrievable:     pointer.From(v.Retrievable),
+			Searchable:      pointer.From(v.Searchable),
+			Filterable:      pointer.From(v.Filterable),
+			Sortable:        pointer.From(v.Sortable),
+			Facetable:       pointer.From(v.Facetable),
+			Analyzer:        pointer.From(v.Analyzer),
+			SearchAnalyzer:  pointer.From(v.SearchAnalyzer),
+			IndexAnalyzer:   pointer.From(v.IndexAnalyzer),
+			SynonymMapNames: pointer.From(v.SynonymMaps),
+		})
+	}
+	return output
+}
+
+func flattenSearchIndexSuggesters(input *[]dataplane.Suggester) []SearchIndexSuggesterModel {
+	output := make([]SearchIndexSuggesterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, SearchIndexSuggesterModel{
+			Name:         v.Name,
+			SourceFields: v.SourceFields,
+		})
+	}
+	return output
+}
+
+func flattenSearchIndexCors(input *dataplane.CorsOptions) []SearchIndexCorsModel {
+	if input == nil {
+		return []SearchIndexCorsModel{}
+	}
+
+	return []SearchIndexCorsModel{
+		{
+			AllowedOrigins:  input.AllowedOrigins,
+			MaxAgeInSeconds: pointer.From(input.MaxAgeInSeconds),
+		},
+	}
+}
+
+func expandSearchIndexFieldsFromDiff(input []interface{}) []SearchIndexFieldModel {
+	output := make([]SearchIndexFieldModel, 0)
+	for _, item := range input {
+		v, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		synonymMapNames := make([]string, 0)
+		for _, name := range v["synonym_map_names"].([]interface{}) {
+			synonymMapNames = append(synonymMapNames, name.(string))
+		}
+
+		output = append(output, SearchIndexFieldModel{
+			Name:            v["name"].(string),
+			Type:            v["type"].(string),
+			Key:             v["key"].(bool),
+			Retrievable:     v["retrievable"].(bool),
+			Searchable:      v["searchable"].(bool),
+			Filterable:      v["filterable"].(bool),
+			Sortable:        v["sortable"].(bool),
+			Facetable:       v["facetable"].(bool),
+			Analyzer:        v["analyzer"].(string),
+			SearchAnalyzer:  v["search_analyzer"].(string),
+			IndexAnalyzer:   v["index_analyzer"].(string),
+			SynonymMapNames: synonymMapNames,
+		})
+	}
+	return output
+}
+
+func expandSearchIndexSuggestersFromDiff(input []interface{}) []SearchIndexSuggesterModel {
+	output := make([]SearchIndexSuggesterModel, 0)
+	for _, item := range input {
+		v, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		sourceFields := make([]string, 0)
+		for _, name := range v["source_fields"].([]interface{}) {
+			sourceFields = append(sourceFields, name.(string))
+		}
+
+		output = append(output, SearchIndexSuggesterModel{
+			Name:         v["name"].(string),
+			SourceFields: sourceFields,
+		})
+	}
+	return output
+}
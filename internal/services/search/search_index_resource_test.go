@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/adminkeys"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SearchIndexResource struct{}
+
+func TestAccSearchIndex_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndex_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchIndex_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndex_updateInPlace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("etag").IsSet(),
+			),
+		},
+		data.ImportStep(),
+		{
+			// adding fields, suggesters over new fields and CORS can be done in-place
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndex_updateRequiresRebuild(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// making an existing field filterable requires the index to be rebuilt
+			Config: r.filterable(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("field.1.filterable").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SearchIndexResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchIndexID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := testSearchDataPlaneClient(ctx, clients, id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetIndex(ctx, id.IndexName)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+// testSearchDataPlaneClient returns a data-plane client for the Search Service, authenticated using the Primary Admin Key
+func testSearchDataPlaneClient(ctx context.Context, clients *clients.Client, subscriptionId, resourceGroupName, searchServiceName string) (*dataplane.Client, error) {
+	searchServiceId := adminkeys.NewSearchServiceID(subscriptionId, resourceGroupName, searchServiceName)
+	keys, err := clients.Search.AdminKeysClient.Get(ctx, searchServiceId, adminkeys.GetOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Admin Keys for %s: %+v", searchServiceId, err)
+	}
+	if keys.Model == nil || keys.Model.PrimaryKey == nil {
+		return nil, fmt.Errorf("retrieving Admin Keys for %s: `primaryKey` was nil", searchServiceId)
+	}
+
+	return clients.Search.DataPlaneClientWithAdminKey(searchServiceName, *keys.Model.PrimaryKey), nil
+}
+
+func (r SearchIndexResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctest-index-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+    analyzer   = "en.lucene"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "import" {
+  name              = azurerm_search_index.test.name
+  search_service_id = azurerm_search_index.test.search_service_id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+    analyzer   = "en.lucene"
+  }
+}
+`, r.basic(data))
+}
+
+func (r SearchIndexResource) filterable(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctest-index-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+    filterable = true
+    analyzer   = "en.lucene"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctest-index-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name        = "description"
+    type        = "Edm.String"
+    retrievable = false
+    searchable  = true
+    analyzer    = "en.lucene"
+  }
+
+  field {
+    name       = "hotelName"
+    type       = "Edm.String"
+    searchable = true
+    sortable   = true
+  }
+
+  field {
+    name       = "rating"
+    type       = "Edm.Double"
+    filterable = true
+    sortable   = true
+    facetable  = true
+  }
+
+  field {
+    name       = "tags"
+    type       = "Collection(Edm.String)"
+    searchable = true
+    filterable = true
+    facetable  = true
+  }
+
+  suggester {
+    name          = "sg"
+    source_fields = ["hotelName"]
+  }
+
+  cors {
+    allowed_origins    = ["https://example.com"]
+    max_age_in_seconds = 60
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (SearchIndexResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-search-%d"
+  location = "%s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                = "acctestsearchservice%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
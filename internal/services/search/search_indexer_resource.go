@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchIndexerResource struct{}
+
+var _ sdk.ResourceWithUpdate = SearchIndexerResource{}
+
+type SearchIndexerModel struct {
+	Name            string                           `tfschema:"name"`
+	SearchServiceId string                           `tfschema:"search_service_id"`
+	DataSourceName  string                           `tfschema:"data_source_name"`
+	TargetIndexName string                           `tfschema:"target_index_name"`
+	SkillsetName    string                           `tfschema:"skillset_name"`
+	Description     string                           `tfschema:"description"`
+	Enabled         bool                             `tfschema:"enabled"`
+	Schedule        []SearchIndexerScheduleModel     `tfschema:"schedule"`
+	Parameters      []SearchIndexerParametersModel   `tfschema:"parameters"`
+	FieldMapping    []SearchIndexerFieldMappingModel `tfschema:"field_mapping"`
+	ETag            string                           `tfschema:"etag"`
+}
+
+type SearchIndexerScheduleModel struct {
+	Interval  string `tfschema:"interval"`
+	StartTime string `tfschema:"start_time"`
+}
+
+type SearchIndexerParametersModel struct {
+	BatchSize              int64 `tfschema:"batch_size"`
+	MaxFailedItems         int64 `tfschema:"max_failed_items"`
+	MaxFailedItemsPerBatch int64 `tfschema:"max_failed_items_per_batch"`
+}
+
+type SearchIndexerFieldMappingModel struct {
+	SourceFieldName     string `tfschema:"source_field_name"`
+	TargetFieldName     string `tfschema:"target_field_name"`
+	MappingFunctionName string `tfschema:"mapping_function_name"`
+}
+
+func (r SearchIndexerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"data_source_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"target_index_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"skillset_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"interval": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azValidate.ISO8601DurationBetween("PT5M", "P1D"),
+					},
+
+					"start_time": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
+
+		"parameters": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"batch_size": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"max_failed_items": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(-1),
+					},
+
+					"max_failed_items_per_batch": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(-1),
+					},
+				},
+			},
+		},
+
+		"field_mapping": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_field_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"target_field_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"mapping_function_name": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"base64Decode",
+							"base64Encode",
+							"extractTokenAtPosition",
+							"jsonArrayToStringCollection",
+							"urlDecode",
+							"urlEncode",
+						}, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r SearchIndexerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SearchIndexerResource) ModelObject() interface{} {
+	return &SearchIndexerModel{}
+}
+
+func (r SearchIndexerResource) ResourceType() string {
+	return "azurerm_search_indexer"
+}
+
+func (r SearchIndexerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchIndexerID
+}
+
+func (r SearchIndexerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchIndexerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchIndexerID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, *searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetIndexer(ctx, id.IndexerName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdateIndexer(ctx, expandSearchIndexer(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SearchIndexerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetIndexer(ctx, id.IndexerName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SearchIndexerModel{
+				Name:            id.IndexerName,
+				SearchServiceId: searchServiceId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.DataSourceName = model.DataSourceName
+				state.TargetIndexName = model.TargetIndexName
+				state.SkillsetName = pointer.From(model.SkillsetName)
+				state.Description = pointer.From(model.Description)
+				state.Enabled = !pointer.From(model.Disabled)
+				state.Schedule = flattenSearchIndexerSchedule(model.Schedule)
+				state.Parameters = flattenSearchIndexerParameters(model.Parameters)
+				state.FieldMapping = flattenSearchIndexerFieldMappings(model.FieldMappings)
+				state.ETag = pointer.From(model.ETag)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SearchIndexerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchIndexerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdateIndexer(ctx, expandSearchIndexer(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SearchIndexerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.DeleteIndexer(ctx, id.IndexerName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSearchIndexer(input SearchIndexerModel) dataplane.Indexer {
+	output := dataplane.Indexer{
+		Name:            input.Name,
+		DataSourceName:  input.DataSourceName,
+		TargetIndexName: input.TargetIndexName,
+		Disabled:        pointer.To(!input.Enabled),
+	}
+
+	if input.SkillsetName != "" {
+		output.SkillsetName = pointer.To(input.SkillsetName)
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	if len(input.Schedule) > 0 {
+		schedule := input.Schedule[0]
+		output.Schedule = &dataplane.IndexingSchedule{
+			Interval: schedule.Interval,
+		}
+		if schedule.StartTime != "" {
+			output.Schedule.StartTime = pointer.To(schedule.StartTime)
+		}
+	}
+
+	if len(input.Parameters) > 0 {
+		parameters := input.Parameters[0]
+		output.Parameters = &dataplane.IndexingParameters{
+			MaxFailedItems:         pointer.To(parameters.MaxFailedItems),
+			MaxFailedItemsPerBatch: pointer.To(parameters.MaxFailedItemsPerBatch),
+		}
+		if parameters.BatchSize > 0 {
+			output.Parameters.BatchSize = pointer.To(parameters.BatchSize)
+		}
+	}
+
+	fieldMappings := make([]dataplane.FieldMapping, 0)
+	for _, v := range input.FieldMapping {
+		mapping := dataplane.FieldMapping{
+			SourceFieldName: v.SourceFieldName,
+		}
+		if v.TargetFieldName != "" {
+			mapping.TargetFieldName = pointer.To(v.TargetFieldName)
+		}
+		if v.MappingFunctionName != "" {
+			mapping.MappingFunction = &dataplane.FieldMappingFunction{
+				Name: v.MappingFunctionName,
+			}
+		}
+		fieldMappings = append(fieldMappings, mapping)
+	}
+	output.FieldMappings = &fieldMappings
+
+	return output
+}
+
+func flattenSearchIndexerSchedule(input *dataplane.IndexingSchedule) []SearchIndexerScheduleModel {
+	if input == nil {
+		return []SearchIndexerScheduleModel{}
+	}
+
+	return []SearchIndexerScheduleModel{
+		{
+			Interval:  input.Interval,
+			StartTime: pointer.From(input.StartTime),
+		},
+	}
+}
+
+func flattenSearchIndexerParameters(input *dataplane.IndexingParameters) []SearchIndexerParametersModel {
+	if input == nil || (input.BatchSize == nil && input.MaxFailedItems == nil && input.MaxFailedItemsPerBatch == nil) {
+		return []SearchIndexerParametersModel{}
+	}
+
+	return []SearchIndexerParametersModel{
+		{
+			BatchSize:              pointer.From(input.BatchSize),
+			MaxFailedItems:         pointer.From(input.MaxFailedItems),
+			MaxFailedItemsPerBatch: pointer.From(input.MaxFailedItemsPerBatch),
+		},
+	}
+}
+
+func flattenSearchIndexerFieldMappings(input *[]dataplane.FieldMapping) []SearchIndexerFieldMappingModel {
+	output := make([]SearchIndexerFieldMappingModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		mapping := SearchIndexerFieldMappingModel{
+			SourceFieldName: v.SourceFieldName,
+			TargetFieldName: pointer.From(v.TargetFieldName),
+		}
+		if v.MappingFunction != nil {
+			mapping.MappingFunctionName = v.MappingFunction.Name
+		}
+		output = append(output, mapping)
+	}
+	return output
+}
@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchSkillsetResource struct{}
+
+var _ sdk.ResourceWithUpdate = SearchSkillsetResource{}
+
+type SearchSkillsetModel struct {
+	Name                 string `tfschema:"name"`
+	SearchServiceId      string `tfschema:"search_service_id"`
+	SkillsJson           string `tfschema:"skills_json"`
+	Description          string `tfschema:"description"`
+	CognitiveServicesKey string `tfschema:"cognitive_services_key"`
+	ETag                 string `tfschema:"etag"`
+}
+
+func (r SearchSkillsetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"skills_json": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validateSearchSkillsJson,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cognitive_services_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SearchSkillsetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SearchSkillsetResource) ModelObject() interface{} {
+	return &SearchSkillsetModel{}
+}
+
+func (r SearchSkillsetResource) ResourceType() string {
+	return "azurerm_search_skillset"
+}
+
+func (r SearchSkillsetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchSkillsetID
+}
+
+func (r SearchSkillsetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchSkillsetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchSkillsetID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, *searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetSkillset(ctx, id.SkillsetName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandSearchSkillset(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdateSkillset(ctx, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SearchSkillsetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchSkillsetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetSkillset(ctx, id.SkillsetName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SearchSkillsetModel{
+				Name:            id.SkillsetName,
+				SearchServiceId: searchServiceId.ID(),
+				// the key for the Cognitive Services account is returned masked, so we pull this from the config/state
+				CognitiveServicesKey: metadata.ResourceData.Get("cognitive_services_key").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Description = pointer.From(model.Description)
+				state.ETag = pointer.From(model.ETag)
+
+				skills, err := flattenSearchSkills(model.Skills)
+				if err != nil {
+					return fmt.Errorf("flattening `skills_json`: %+v", err)
+				}
+				state.SkillsJson = *skills
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SearchSkillsetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchSkillsetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchSkillsetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			payload, err := expandSearchSkillset(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdateSkillset(ctx, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SearchSkillsetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchSkillsetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := dataPlaneClientForSearchService(ctx, metadata.Client.Search, searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.DeleteSkillset(ctx, id.SkillsetName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSearchSkillset(input SearchSkillsetModel) (*dataplane.Skillset, error) {
+	skills := make([]json.RawMessage, 0)
+	if err := json.Unmarshal([]byte(input.SkillsJson), &skills); err != nil {
+		return nil, fmt.Errorf("deserializing `skills_json`: %+v", err)
+	}
+
+	output := dataplane.Skillset{
+		Name:   input.Name,
+		Skills: skills,
+		CognitiveServices: &dataplane.CognitiveServices{
+			ODataType: dataplane.CognitiveServicesTypeDefault,
+		},
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	if input.CognitiveServicesKey != "" {
+		output.CognitiveServices = &dataplane.CognitiveServices{
+			ODataType: dataplane.CognitiveServicesTypeByKey,
+			Key:       pointer.To(input.CognitiveServicesKey),
+		}
+	}
+
+	return &output, nil
+}
+
+// flattenSearchSkills serializes the skills returned from the API, removing any properties with a `null` value
+// since the API returns these for any optional properties which weren't specified, which would otherwise cause a diff
+func flattenSearchSkills(input []json.RawMessage) (*string, error) {
+	skills := make([]interface{}, 0)
+	for _, v := range input {
+		var skill interface{}
+		if err := json.Unmarshal(v, &skill); err != nil {
+			return nil, err
+		}
+		skills = append(skills, removeNullJsonValues(skill))
+	}
+
+	out, err := json.Marshal(skills)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(string(out)), nil
+}
+
+func removeNullJsonValues(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{})
+		for key, val := range v {
+			if val == nil {
+				continue
+			}
+			out[key] = removeNullJsonValues(val)
+		}
+		return out
+
+	case []interface{}:
+		out := make([]interface{}, 0)
+		for _, val := range v {
+			out = append(out, removeNullJsonValues(val))
+		}
+		return out
+	}
+
+	return input
+}
+
+// validateSearchSkillsJson validates that `skills_json` is a JSON array of skills, each of which specifies an `@odata.type`
+func validateSearchSkillsJson(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	skills := make([]map[string]interface{}, 0)
+	if err := json.Unmarshal([]byte(v), &skills); err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a JSON array of objects: %+v", k, err))
+		return
+	}
+
+	if len(skills) == 0 {
+		errors = append(errors, fmt.Errorf("expected %q to contain at least one skill", k))
+	}
+
+	for i, skill := range skills {
+		if odataType, ok := skill["@odata.type"].(string); !ok || odataType == "" {
+			errors = append(errors, fmt.Errorf("expected skill %d in %q to specify an `@odata.type`", i, k))
+		}
+	}
+
+	return
+}
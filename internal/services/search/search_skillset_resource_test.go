@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SearchSkillsetResource struct{}
+
+func TestAccSearchSkillset_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_skillset", "test")
+	r := SearchSkillsetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchSkillset_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_skillset", "test")
+	r := SearchSkillsetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchSkillset_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_skillset", "test")
+	r := SearchSkillsetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("cognitive_services_key"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SearchSkillsetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchSkillsetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := testSearchDataPlaneClient(ctx, clients, id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSkillset(ctx, id.SkillsetName)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SearchSkillsetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_skillset" "test" {
+  name              = "acctest-skillset-%d"
+  search_service_id = azurerm_search_service.test.id
+  skills_json = jsonencode([
+    {
+      "@odata.type" = "#Microsoft.Skills.Text.SplitSkill"
+      name          = "split"
+      context       = "/document"
+      textSplitMode = "pages"
+      inputs = [
+        {
+          name   = "text"
+          source = "/document/content"
+        }
+      ]
+      outputs = [
+        {
+          name       = "textItems"
+          targetName = "pages"
+        }
+      ]
+    }
+  ])
+}
+`, SearchIndexResource{}.template(data), data.RandomInteger)
+}
+
+func (r SearchSkillsetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_skillset" "import" {
+  name              = azurerm_search_skillset.test.name
+  search_service_id = azurerm_search_skillset.test.search_service_id
+  skills_json       = azurerm_search_skillset.test.skills_json
+}
+`, r.basic(data))
+}
+
+func (r SearchSkillsetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account" "test" {
+  name                = "acctestcogacc-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  kind                = "CognitiveServices"
+  sku_name            = "S0"
+}
+
+resource "azurerm_search_skillset" "test" {
+  name                   = "acctest-skillset-%[2]d"
+  search_service_id      = azurerm_search_service.test.id
+  description            = "Splits and detects the language of documents"
+  cognitive_services_key = azurerm_cognitive_account.test.primary_access_key
+  skills_json = jsonencode([
+    {
+      "@odata.type" = "#Microsoft.Skills.Text.SplitSkill"
+      name          = "split"
+      context       = "/document"
+      textSplitMode = "pages"
+      inputs = [
+        {
+          name   = "text"
+          source = "/document/content"
+        }
+      ]
+      outputs = [
+        {
+          name       = "textItems"
+          targetName = "pages"
+        }
+      ]
+    },
+    {
+      "@odata.type" = "#Microsoft.Skills.Text.LanguageDetectionSkill"
+      name          = "language"
+      context       = "/document"
+      inputs = [
+        {
+          name   = "text"
+          source = "/document/content"
+        }
+      ]
+      outputs = [
+        {
+          name       = "languageCode"
+          targetName = "language"
+        }
+      ]
+    }
+  ])
+}
+`, SearchIndexResource{}.template(data), data.RandomInteger)
+}
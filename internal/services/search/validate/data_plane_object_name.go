@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DataPlaneObjectName validates the name of a Search Index, Data Source, Indexer or Skillset
+func DataPlaneObjectName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if matched := regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,126}[a-z0-9])?$`).MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain lowercase letters, numbers and dashes, must start and end with a letter or number and must be between 1 and 128 characters", k))
+	}
+
+	if strings.Contains(value, "--") {
+		errors = append(errors, fmt.Errorf("%q must not contain consecutive dashes", k))
+	}
+
+	return warnings, errors
+}
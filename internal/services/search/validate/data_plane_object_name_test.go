@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDataPlaneObjectName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hotels-sample-index",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1hotels",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Hotels",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hotels_sample",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-hotels",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hotels-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hotels--sample",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 128),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 129),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := DataPlaneObjectName(tc.Value, "name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("expected %d errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/datalakestore/paths"
+)
+
+// NOTE: the Data Lake Gen2 Paths client within Giovanni doesn't support setting ACLs recursively, as such
+// this is a workaround until it's available in the SDK.
+
+type SetAccessControlRecursiveMode string
+
+const (
+	SetAccessControlRecursiveModeModify SetAccessControlRecursiveMode = "modify"
+	SetAccessControlRecursiveModeRemove SetAccessControlRecursiveMode = "remove"
+	SetAccessControlRecursiveModeSet    SetAccessControlRecursiveMode = "set"
+)
+
+type SetAccessControlRecursiveInput struct {
+	Mode SetAccessControlRecursiveMode
+	ACL  string
+
+	// Optional - the continuation token returned from a previous request, used to resume the operation
+	Continuation *string
+
+	// Optional - whether the operation should continue when the ACL can't be updated on a child Path
+	ForceFlag *bool
+
+	// Optional - the maximum number of Paths which should be processed within a single batch (between 1 and 2000)
+	MaxRecords *int64
+}
+
+type SetAccessControlRecursiveResult struct {
+	autorest.Response `json:"-"`
+
+	// Continuation is the token which should be used to process the next batch, this is nil once all Paths have been processed
+	Continuation *string `json:"-"`
+
+	DirectoriesSuccessful *int64                             `json:"directoriesSuccessful,omitempty"`
+	FilesSuccessful       *int64                             `json:"filesSuccessful,omitempty"`
+	FailureCount          *int64                             `json:"failureCount,omitempty"`
+	FailedEntries         *[]SetAccessControlRecursiveFailed `json:"failedEntries,omitempty"`
+}
+
+type SetAccessControlRecursiveFailed struct {
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Type         *string `json:"type,omitempty"`
+}
+
+// SetAccessControlRecursive sets, modifies or removes the ACL for a single batch of Paths beneath (and including)
+// the specified Data Lake Gen2 Path - the returned Continuation token should be used to process the next batch.
+func SetAccessControlRecursive(ctx context.Context, client *paths.Client, accountName string, fileSystemName string, path string, input SetAccessControlRecursiveInput) (result SetAccessControlRecursiveResult, err error) {
+	if accountName == "" {
+		return result, validation.NewError("datalakestore.Client", "SetAccessControlRecursive", "`accountName` cannot be an empty string.")
+	}
+	if fileSystemName == "" {
+		return result, validation.NewError("datalakestore.Client", "SetAccessControlRecursive", "`fileSystemName` cannot be an empty string.")
+	}
+	if input.ACL == "" {
+		return result, validation.NewError("datalakestore.Client", "SetAccessControlRecursive", "`input.ACL` cannot be an empty string.")
+	}
+
+	req, err := setAccessControlRecursivePreparer(ctx, client, accountName, fileSystemName, path, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datalakestore.Client", "SetAccessControlRecursive", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "datalakestore.Client", "SetAccessControlRecursive", resp, "Failure sending request")
+		return
+	}
+
+	result, err = setAccessControlRecursiveResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datalakestore.Client", "SetAccessControlRecursive", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func setAccessControlRecursivePreparer(ctx context.Context, client *paths.Client, accountName string, fileSystemName string, path string, input SetAccessControlRecursiveInput) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"fileSystemName": autorest.Encode("path", fileSystemName),
+		"path":           autorest.Encode("path", path),
+	}
+
+	queryParameters := map[string]interface{}{
+		"action": autorest.Encode("query", "setAccessControlRecursive"),
+		"mode":   autorest.Encode("query", string(input.Mode)),
+	}
+	if input.Continuation != nil {
+		queryParameters["continuation"] = autorest.Encode("query", *input.Continuation)
+	}
+	if input.ForceFlag != nil {
+		queryParameters["forceFlag"] = autorest.Encode("query", *input.ForceFlag)
+	}
+	if input.MaxRecords != nil {
+		queryParameters["maxRecords"] = autorest.Encode("query", *input.MaxRecords)
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": paths.APIVersion,
+		"x-ms-acl":     input.ACL,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPatch(),
+		autorest.WithBaseURL(fmt.Sprintf("https://%s.dfs.%s", accountName, client.BaseURI)),
+		autorest.WithPathParameters("/{fileSystemName}/{path}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func setAccessControlRecursiveResponder(resp *http.Response) (result SetAccessControlRecursiveResult, err error) {
+	if resp != nil && resp.Header != nil {
+		if v := resp.Header.Get("x-ms-continuation"); v != "" {
+			result.Continuation = &v
+		}
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -80,5 +80,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		LocalUserResource{},
+		AccountQueuePropertiesResource{},
+		AccountStaticWebsiteResource{},
+		AccountFailoverResource{},
+		ContainerImmutabilityPolicyResource{},
+		ContainerLegalHoldResource{},
+		DataLakeGen2PathAclRecursiveResource{},
 	}
 }
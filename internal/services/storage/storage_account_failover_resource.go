@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	storageAccountFailoverTypePlanned   = "Planned"
+	storageAccountFailoverTypeUnplanned = "Unplanned"
+)
+
+type AccountFailoverResource struct{}
+
+var (
+	_ sdk.Resource                   = AccountFailoverResource{}
+	_ sdk.ResourceWithCustomImporter = AccountFailoverResource{}
+)
+
+type AccountFailoverModel struct {
+	StorageAccountId            string                                    `tfschema:"storage_account_id"`
+	FailoverType                string                                    `tfschema:"failover_type"`
+	MaxDataLossInMinutes        int64                                     `tfschema:"max_data_loss_in_minutes"`
+	RestoreGeoRedundancyEnabled bool                                      `tfschema:"restore_geo_redundancy_enabled"`
+	Triggers                    map[string]string                         `tfschema:"triggers"`
+	GeoReplicationStats         []AccountFailoverGeoReplicationStatsModel `tfschema:"geo_replication_stats"`
+	LastFailoverTime            string                                    `tfschema:"last_failover_time"`
+	PrimaryLocation             string                                    `tfschema:"primary_location"`
+	SecondaryLocation           string                                    `tfschema:"secondary_location"`
+}
+
+type AccountFailoverGeoReplicationStatsModel struct {
+	CanFailover                   bool   `tfschema:"can_failover"`
+	CanPlannedFailover            bool   `tfschema:"can_planned_failover"`
+	LastSyncTime                  string `tfschema:"last_sync_time"`
+	PostFailoverRedundancy        string `tfschema:"post_failover_redundancy"`
+	PostPlannedFailoverRedundancy string `tfschema:"post_planned_failover_redundancy"`
+	Status                        string `tfschema:"status"`
+}
+
+func (r AccountFailoverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"failover_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				storageAccountFailoverTypePlanned,
+				storageAccountFailoverTypeUnplanned,
+			}, false),
+		},
+
+		"max_data_loss_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"restore_geo_redundancy_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r AccountFailoverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"geo_replication_stats": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"can_failover": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"can_planned_failover": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"last_sync_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"post_failover_redundancy": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"post_planned_failover_redundancy": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"last_failover_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"primary_location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"secondary_location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r AccountFailoverResource) ResourceType() string {
+	return "azurerm_storage_account_failover"
+}
+
+func (r AccountFailoverResource) ModelObject() interface{} {
+	return &AccountFailoverModel{}
+}
+
+func (r AccountFailoverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+// CustomImporter rejects the import, since the failover arguments can't be retrieved from the API and importing
+// with an empty `failover_type` would cause the next plan to replace the resource, failing over the Storage Account again
+func (r AccountFailoverResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`%s` performs a one-off failover of a Storage Account and doesn't support being imported", r.ResourceType())
+	}
+}
+
+func (r AccountFailoverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// a failover can take a considerable amount of time, and converting back to geo-redundant storage longer still
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageAccounts
+
+			var config AccountFailoverModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(config.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			existing, err := client.GetProperties(ctx, *id, storageaccounts.GetPropertiesOperationOptions{
+				Expand: pointer.To(storageaccounts.StorageAccountExpandGeoReplicationStats),
+			})
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Sku == nil {
+				return fmt.Errorf("retrieving %s: `model.sku` was nil", *id)
+			}
+			originalSku := existing.Model.Sku.Name
+
+			var stats *storageaccounts.GeoReplicationStats
+			if props := existing.Model.Properties; props != nil {
+				stats = props.GeoReplicationStats
+			}
+			if stats == nil {
+				return fmt.Errorf("geo-replication stats for %s are unavailable - only geo-redundant Storage Accounts can be failed over", *id)
+			}
+
+			options := storageaccounts.DefaultFailoverOperationOptions()
+			if config.FailoverType == storageAccountFailoverTypePlanned {
+				if !pointer.From(stats.CanPlannedFailover) {
+					return fmt.Errorf("a planned failover isn't currently possible for %s", *id)
+				}
+				options.FailoverType = pointer.To(storageaccounts.FailoverTypePlanned)
+			} else {
+				if !pointer.From(stats.CanFailover) {
+					return fmt.Errorf("a failover isn't currently possible for %s", *id)
+				}
+
+				// an unplanned failover loses any writes which haven't been replicated to the secondary region yet
+				if config.MaxDataLossInMinutes > 0 {
+					lastSyncTime, err := stats.GetLastSyncTimeAsTime()
+					if err != nil {
+						return fmt.Errorf("parsing `last_sync_time` for %s: %+v", *id, err)
+					}
+					if lastSyncTime == nil {
+						return fmt.Errorf("refusing to failover %s since the last sync time is unavailable so the potential data loss can't be determined", *id)
+					}
+
+					maxDataLoss := time.Duration(config.MaxDataLossInMinutes) * time.Minute
+					if dataLoss := time.Since(*lastSyncTime); dataLoss > maxDataLoss {
+						return fmt.Errorf("refusing to failover %s since the last sync time was %s - the potential data loss of %s exceeds `max_data_loss_in_minutes` (%d)", *id, lastSyncTime.Format(time.RFC3339), dataLoss.Truncate(time.Second), config.MaxDataLossInMinutes)
+					}
+				}
+			}
+
+			if err := client.FailoverThenPoll(ctx, *id, options); err != nil {
+				return fmt.Errorf("failing over %s: %+v", *id, err)
+			}
+
+			if config.RestoreGeoRedundancyEnabled {
+				resp, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				// an unplanned failover converts the Storage Account to locally-redundant (or zone-redundant) storage
+				if resp.Model != nil && resp.Model.Sku != nil && resp.Model.Sku.Name != originalSku {
+					payload := storageaccounts.StorageAccountUpdateParameters{
+						Sku: &storageaccounts.Sku{
+							Name: originalSku,
+						},
+					}
+					if _, err := client.Update(ctx, *id, payload); err != nil {
+						return fmt.Errorf("restoring the SKU of %s to %q: %+v", *id, string(originalSku), err)
+					}
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AccountFailoverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageAccounts
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state AccountFailoverModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.GetProperties(ctx, *id, storageaccounts.GetPropertiesOperationOptions{
+				Expand: pointer.To(storageaccounts.StorageAccountExpandGeoReplicationStats),
+			})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the failover itself can't be retrieved from the API, so the arguments are persisted from the state
+			model := AccountFailoverModel{
+				StorageAccountId:            id.ID(),
+				FailoverType:                state.FailoverType,
+				MaxDataLossInMinutes:        state.MaxDataLossInMinutes,
+				RestoreGeoRedundancyEnabled: state.RestoreGeoRedundancyEnabled,
+				Triggers:                    state.Triggers,
+			}
+
+			if resp.Model != nil && resp.Model.Properties != nil {
+				props := resp.Model.Properties
+				model.GeoReplicationStats = r.flattenGeoReplicationStats(props.GeoReplicationStats)
+				model.LastFailoverTime = pointer.From(props.LastGeoFailoverTime)
+				model.PrimaryLocation = pointer.From(props.PrimaryLocation)
+				model.SecondaryLocation = pointer.From(props.SecondaryLocation)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r AccountFailoverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// a failover can't be undone, the Storage Account remains in the new primary region
+			return nil
+		},
+	}
+}
+
+func (r AccountFailoverResource) flattenGeoReplicationStats(input *storageaccounts.GeoReplicationStats) []AccountFailoverGeoReplicationStatsModel {
+	if input == nil {
+		return []AccountFailoverGeoReplicationStatsModel{}
+	}
+
+	output := AccountFailoverGeoReplicationStatsModel{
+		CanFailover:        pointer.From(input.CanFailover),
+		CanPlannedFailover: pointer.From(input.CanPlannedFailover),
+		LastSyncTime:       pointer.From(input.LastSyncTime),
+	}
+	if input.PostFailoverRedundancy != nil {
+		output.PostFailoverRedundancy = string(*input.PostFailoverRedundancy)
+	}
+	if input.PostPlannedFailoverRedundancy != nil {
+		output.PostPlannedFailoverRedundancy = string(*input.PostPlannedFailoverRedundancy)
+	}
+	if input.Status != nil {
+		output.Status = string(*input.Status)
+	}
+
+	return []AccountFailoverGeoReplicationStatsModel{output}
+}
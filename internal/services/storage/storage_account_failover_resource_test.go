@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AccountFailoverResource struct{}
+
+func TestAccStorageAccountFailover_planned(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_failover", "test")
+	r := AccountFailoverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.planned(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_location").Exists(),
+				check.That(data.ResourceName).Key("last_failover_time").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccStorageAccountFailover_unplanned(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_failover", "test")
+	r := AccountFailoverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unplanned(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("geo_replication_stats.#").HasValue("1"),
+				check.That(data.ResourceName).Key("last_failover_time").Exists(),
+			),
+		},
+	})
+}
+
+func (r AccountFailoverResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseStorageAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Storage.ResourceManager.StorageAccounts.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the failover has happened once the last failover time is set
+	return pointer.To(resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.LastGeoFailoverTime != nil), nil
+}
+
+func (r AccountFailoverResource) planned(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_failover" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  failover_type      = "Planned"
+}
+`, r.template(data))
+}
+
+func (r AccountFailoverResource) unplanned(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_failover" "test" {
+  storage_account_id             = azurerm_storage_account.test.id
+  failover_type                  = "Unplanned"
+  max_data_loss_in_minutes       = 60
+  restore_geo_redundancy_enabled = true
+}
+`, r.template(data))
+}
+
+func (r AccountFailoverResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "RAGRS"
+
+  lifecycle {
+    ignore_changes = [location, account_replication_type]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
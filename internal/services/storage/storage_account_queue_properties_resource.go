@@ -0,0 +1,525 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/queueserviceproperties"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/queue/queues"
+)
+
+type AccountQueuePropertiesResource struct{}
+
+var _ sdk.ResourceWithUpdate = AccountQueuePropertiesResource{}
+
+type AccountQueuePropertiesModel struct {
+	StorageAccountId string                                `tfschema:"storage_account_id"`
+	CorsRule         []AccountQueuePropertiesCorsRuleModel `tfschema:"cors_rule"`
+	Logging          []AccountQueuePropertiesLoggingModel  `tfschema:"logging"`
+	HourMetrics      []AccountQueuePropertiesMetricsModel  `tfschema:"hour_metrics"`
+	MinuteMetrics    []AccountQueuePropertiesMetricsModel  `tfschema:"minute_metrics"`
+}
+
+type AccountQueuePropertiesCorsRuleModel struct {
+	AllowedOrigins  []string `tfschema:"allowed_origins"`
+	ExposedHeaders  []string `tfschema:"exposed_headers"`
+	AllowedHeaders  []string `tfschema:"allowed_headers"`
+	AllowedMethods  []string `tfschema:"allowed_methods"`
+	MaxAgeInSeconds int64    `tfschema:"max_age_in_seconds"`
+}
+
+type AccountQueuePropertiesLoggingModel struct {
+	Version             string `tfschema:"version"`
+	Delete              bool   `tfschema:"delete"`
+	Read                bool   `tfschema:"read"`
+	Write               bool   `tfschema:"write"`
+	RetentionPolicyDays int64  `tfschema:"retention_policy_days"`
+}
+
+type AccountQueuePropertiesMetricsModel struct {
+	Version             string `tfschema:"version"`
+	IncludeApis         bool   `tfschema:"include_apis"`
+	RetentionPolicyDays int64  `tfschema:"retention_policy_days"`
+}
+
+func (r AccountQueuePropertiesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"cors_rule": helpers.SchemaStorageAccountCorsRule(false),
+
+		"logging": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"delete": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"read": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"write": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"retention_policy_days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+
+		"hour_metrics": r.metricsSchema(),
+
+		"minute_metrics": r.metricsSchema(),
+	}
+}
+
+func (r AccountQueuePropertiesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AccountQueuePropertiesResource) ResourceType() string {
+	return "azurerm_storage_account_queue_properties"
+}
+
+func (r AccountQueuePropertiesResource) ModelObject() interface{} {
+	return &AccountQueuePropertiesModel{}
+}
+
+func (r AccountQueuePropertiesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r AccountQueuePropertiesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.QueueServiceProperties
+
+			var plan AccountQueuePropertiesModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate %s", *id)
+			}
+
+			var tier storage.SkuTier
+			if account.Sku != nil {
+				tier = account.Sku.Tier
+			}
+			if !resolveStorageAccountServiceSupportLevel(account.Kind, tier).supportQueue {
+				return fmt.Errorf("queue properties aren't supported for account kind %q in sku tier %q", account.Kind, tier)
+			}
+
+			// the Queue Service is always present on a Storage Account, so there's no requires import check here
+			payload := queueserviceproperties.QueueServiceProperties{
+				Properties: &queueserviceproperties.QueueServicePropertiesProperties{
+					Cors: r.expandCorsRules(plan.CorsRule),
+				},
+			}
+			if _, err := client.QueueServicesSetServiceProperties(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating Queue Service Properties for %s: %+v", *id, err)
+			}
+
+			if metadata.Client.Features.Storage.DataPlaneAvailable || r.hasDataPlaneProperties(plan) {
+				queueClient, err := metadata.Client.Storage.QueuesClient(ctx, *account)
+				if err != nil {
+					return fmt.Errorf("building Queues Client: %+v", err)
+				}
+
+				if err := queueClient.UpdateServiceProperties(ctx, id.ResourceGroupName, id.StorageAccountName, r.expandDataPlaneProperties(plan)); err != nil {
+					return fmt.Errorf("updating Queue Logging and Metrics for %s: %+v", *id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.QueueServiceProperties
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state AccountQueuePropertiesModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.QueueServicesGetServiceProperties(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving Queue Service Properties for %s: %+v", *id, err)
+			}
+
+			model := AccountQueuePropertiesModel{
+				StorageAccountId: id.ID(),
+			}
+			if resp.Model != nil && resp.Model.Properties != nil {
+				model.CorsRule = r.flattenCorsRules(resp.Model.Properties.Cors)
+			}
+
+			// Logging and Metrics are only exposed through the Data Plane, which is skipped when it isn't
+			// available unless these blocks are already being managed by this resource.
+			if metadata.Client.Features.Storage.DataPlaneAvailable || r.hasDataPlaneProperties(state) {
+				queueClient, err := metadata.Client.Storage.QueuesClient(ctx, *account)
+				if err != nil {
+					return fmt.Errorf("building Queues Client: %+v", err)
+				}
+
+				props, err := queueClient.GetServiceProperties(ctx, id.ResourceGroupName, id.StorageAccountName)
+				if err != nil {
+					return fmt.Errorf("retrieving Queue Logging and Metrics for %s: %+v", *id, err)
+				}
+
+				if props != nil {
+					model.Logging = r.flattenLogging(props.Logging)
+					model.HourMetrics = r.flattenMetrics(props.HourMetrics)
+					model.MinuteMetrics = r.flattenMetrics(props.MinuteMetrics)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.QueueServiceProperties
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var plan AccountQueuePropertiesModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			if metadata.ResourceData.HasChange("cors_rule") {
+				payload := queueserviceproperties.QueueServiceProperties{
+					Properties: &queueserviceproperties.QueueServicePropertiesProperties{
+						Cors: r.expandCorsRules(plan.CorsRule),
+					},
+				}
+				if _, err := client.QueueServicesSetServiceProperties(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating Queue Service Properties for %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("logging", "hour_metrics", "minute_metrics") {
+				account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if account == nil {
+					return fmt.Errorf("unable to locate %s", *id)
+				}
+
+				queueClient, err := metadata.Client.Storage.QueuesClient(ctx, *account)
+				if err != nil {
+					return fmt.Errorf("building Queues Client: %+v", err)
+				}
+
+				if err := queueClient.UpdateServiceProperties(ctx, id.ResourceGroupName, id.StorageAccountName, r.expandDataPlaneProperties(plan)); err != nil {
+					return fmt.Errorf("updating Queue Logging and Metrics for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.QueueServiceProperties
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state AccountQueuePropertiesModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				// the Storage Account is gone, and these properties with it
+				return nil
+			}
+
+			// the Queue Service can't be removed, so instead we reset it to the defaults
+			payload := queueserviceproperties.QueueServiceProperties{
+				Properties: &queueserviceproperties.QueueServicePropertiesProperties{
+					Cors: &queueserviceproperties.CorsRules{
+						CorsRules: &[]queueserviceproperties.CorsRule{},
+					},
+				},
+			}
+			if _, err := client.QueueServicesSetServiceProperties(ctx, *id, payload); err != nil {
+				return fmt.Errorf("resetting Queue Service Properties for %s: %+v", *id, err)
+			}
+
+			if r.hasDataPlaneProperties(state) {
+				queueClient, err := metadata.Client.Storage.QueuesClient(ctx, *account)
+				if err != nil {
+					return fmt.Errorf("building Queues Client: %+v", err)
+				}
+
+				if err := queueClient.UpdateServiceProperties(ctx, id.ResourceGroupName, id.StorageAccountName, r.expandDataPlaneProperties(AccountQueuePropertiesModel{})); err != nil {
+					return fmt.Errorf("resetting Queue Logging and Metrics for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) metricsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"version": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"include_apis": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
+
+				"retention_policy_days": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) hasDataPlaneProperties(input AccountQueuePropertiesModel) bool {
+	return len(input.Logging) > 0 || len(input.HourMetrics) > 0 || len(input.MinuteMetrics) > 0
+}
+
+func (r AccountQueuePropertiesResource) expandCorsRules(input []AccountQueuePropertiesCorsRuleModel) *queueserviceproperties.CorsRules {
+	rules := make([]queueserviceproperties.CorsRule, 0)
+	for _, v := range input {
+		methods := make([]queueserviceproperties.AllowedMethods, 0)
+		for _, method := range v.AllowedMethods {
+			methods = append(methods, queueserviceproperties.AllowedMethods(method))
+		}
+
+		rules = append(rules, queueserviceproperties.CorsRule{
+			AllowedHeaders:  v.AllowedHeaders,
+			AllowedMethods:  methods,
+			AllowedOrigins:  v.AllowedOrigins,
+			ExposedHeaders:  v.ExposedHeaders,
+			MaxAgeInSeconds: v.MaxAgeInSeconds,
+		})
+	}
+
+	return &queueserviceproperties.CorsRules{
+		CorsRules: &rules,
+	}
+}
+
+func (r AccountQueuePropertiesResource) flattenCorsRules(input *queueserviceproperties.CorsRules) []AccountQueuePropertiesCorsRuleModel {
+	output := make([]AccountQueuePropertiesCorsRuleModel, 0)
+	if input == nil || input.CorsRules == nil {
+		return output
+	}
+
+	for _, v := range *input.CorsRules {
+		methods := make([]string, 0)
+		for _, method := range v.AllowedMethods {
+			methods = append(methods, string(method))
+		}
+
+		output = append(output, AccountQueuePropertiesCorsRuleModel{
+			AllowedOrigins:  v.AllowedOrigins,
+			ExposedHeaders:  v.ExposedHeaders,
+			AllowedHeaders:  v.AllowedHeaders,
+			AllowedMethods:  methods,
+			MaxAgeInSeconds: v.MaxAgeInSeconds,
+		})
+	}
+
+	return output
+}
+
+// expandDataPlaneProperties builds the Logging and Metrics configuration - CORS is intentionally omitted
+// since it's managed through the Resource Manager API. Logging and Metrics which aren't specified are reset
+// to their defaults (disabled), since Read treats disabled Logging and Metrics as not specified.
+func (r AccountQueuePropertiesResource) expandDataPlaneProperties(input AccountQueuePropertiesModel) queues.StorageServiceProperties {
+	output := queues.StorageServiceProperties{
+		Logging: &queues.LoggingConfig{
+			Version: "1.0",
+		},
+		HourMetrics:   r.expandMetrics(input.HourMetrics),
+		MinuteMetrics: r.expandMetrics(input.MinuteMetrics),
+	}
+
+	if len(input.Logging) > 0 {
+		logging := input.Logging[0]
+		output.Logging = &queues.LoggingConfig{
+			Version: logging.Version,
+			Delete:  logging.Delete,
+			Read:    logging.Read,
+			Write:   logging.Write,
+		}
+		if logging.RetentionPolicyDays > 0 {
+			output.Logging.RetentionPolicy = queues.RetentionPolicy{
+				Enabled: true,
+				Days:    int(logging.RetentionPolicyDays),
+			}
+		}
+	}
+
+	return output
+}
+
+func (r AccountQueuePropertiesResource) expandMetrics(input []AccountQueuePropertiesMetricsModel) *queues.MetricsConfig {
+	if len(input) == 0 {
+		return &queues.MetricsConfig{
+			Version: "1.0",
+			Enabled: false,
+		}
+	}
+
+	metrics := input[0]
+	output := &queues.MetricsConfig{
+		Version:     metrics.Version,
+		Enabled:     true,
+		IncludeAPIs: pointer.To(metrics.IncludeApis),
+	}
+	if metrics.RetentionPolicyDays > 0 {
+		output.RetentionPolicy = queues.RetentionPolicy{
+			Enabled: true,
+			Days:    int(metrics.RetentionPolicyDays),
+		}
+	}
+
+	return output
+}
+
+func (r AccountQueuePropertiesResource) flattenLogging(input *queues.LoggingConfig) []AccountQueuePropertiesLoggingModel {
+	if input == nil || !(input.Delete || input.Read || input.Write) {
+		return []AccountQueuePropertiesLoggingModel{}
+	}
+
+	output := AccountQueuePropertiesLoggingModel{
+		Version: strings.TrimSpace(input.Version),
+		Delete:  input.Delete,
+		Read:    input.Read,
+		Write:   input.Write,
+	}
+	if input.RetentionPolicy.Enabled {
+		output.RetentionPolicyDays = int64(input.RetentionPolicy.Days)
+	}
+
+	return []AccountQueuePropertiesLoggingModel{output}
+}
+
+func (r AccountQueuePropertiesResource) flattenMetrics(input *queues.MetricsConfig) []AccountQueuePropertiesMetricsModel {
+	if input == nil || !input.Enabled {
+		return []AccountQueuePropertiesMetricsModel{}
+	}
+
+	output := AccountQueuePropertiesMetricsModel{
+		Version:     strings.TrimSpace(input.Version),
+		IncludeApis: pointer.From(input.IncludeAPIs),
+	}
+	if input.RetentionPolicy.Enabled {
+		output.RetentionPolicyDays = int64(input.RetentionPolicy.Days)
+	}
+
+	return []AccountQueuePropertiesMetricsModel{output}
+}
@@ -768,10 +768,13 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				},
 			},
 
+			// this is Computed since the Static Website can also be managed using the `azurerm_storage_account_static_website`
+			// resource, which would otherwise be disabled when this block isn't specified
 			// lintignore:XS003
 			"static_website": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
@@ -1574,6 +1577,9 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		if !supportLevel.supportQueue {
 			return fmt.Errorf("`queue_properties` aren't supported for account kind %q in sku tier %q", accountKind, accountTier)
 		}
+		if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+			return fmt.Errorf("`queue_properties` can't be set when the `data_plane_available` feature is disabled - use the `azurerm_storage_account_queue_properties` resource instead")
+		}
 		storageClient := meta.(*clients.Client).Storage
 		account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
 		if err != nil {
@@ -1630,6 +1636,9 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		if !supportLevel.supportStaticWebsite {
 			return fmt.Errorf("`static_website` aren't supported for account kind %q in sku tier %q", accountKind, accountTier)
 		}
+		if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+			return fmt.Errorf("`static_website` can't be set when the `data_plane_available` feature is disabled - use the `azurerm_storage_account_static_website` resource instead")
+		}
 		storageClient := meta.(*clients.Client).Storage
 
 		account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
@@ -2053,6 +2062,9 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		if !supportLevel.supportQueue {
 			return fmt.Errorf("`queue_properties` aren't supported for account kind %q in sku tier %q", accountKind, accountTier)
 		}
+		if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+			return fmt.Errorf("`queue_properties` can't be updated when the `data_plane_available` feature is disabled - use the `azurerm_storage_account_queue_properties` resource instead")
+		}
 
 		storageClient := meta.(*clients.Client).Storage
 		account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
@@ -2107,6 +2119,9 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		if !supportLevel.supportStaticWebsite {
 			return fmt.Errorf("`static_website` aren't supported for account kind %q in sku tier %q", accountKind, accountTier)
 		}
+		if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+			return fmt.Errorf("`static_website` can't be updated when the `data_plane_available` feature is disabled - use the `azurerm_storage_account_static_website` resource instead")
+		}
 
 		storageClient := meta.(*clients.Client).Storage
 
@@ -2403,7 +2418,13 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	if supportLevel.supportQueue {
+	// the Queue Logging/Metrics and Static Website are only available through the Data Plane, which can be
+	// unreachable (e.g. when Shared Key access is disabled or the Network Rules deny access) - these can be
+	// managed using the `azurerm_storage_account_queue_properties` and `azurerm_storage_account_static_website`
+	// resources instead.
+	dataPlaneAvailable := meta.(*clients.Client).Features.Storage.DataPlaneAvailable
+
+	if supportLevel.supportQueue && dataPlaneAvailable {
 		queueClient, err := storageClient.QueuesClient(ctx, *account)
 		if err != nil {
 			return fmt.Errorf("building Queues Client: %s", err)
@@ -2432,7 +2453,7 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	if supportLevel.supportStaticWebsite {
+	if supportLevel.supportStaticWebsite && dataPlaneAvailable {
 		storageClient := meta.(*clients.Client).Storage
 		account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
 		if err != nil {
@@ -756,10 +756,11 @@ func TestAccStorageAccount_staticWebsiteEnabled(t *testing.T) {
 		},
 		data.ImportStep(),
 		{
-			// Disabled
+			// removing the block leaves the Static Website unchanged
 			Config: r.storageV2(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("static_website.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/accounts"
+)
+
+type AccountStaticWebsiteResource struct{}
+
+var _ sdk.ResourceWithUpdate = AccountStaticWebsiteResource{}
+
+type AccountStaticWebsiteModel struct {
+	StorageAccountId string `tfschema:"storage_account_id"`
+	IndexDocument    string `tfschema:"index_document"`
+	Error404Document string `tfschema:"error_404_document"`
+}
+
+func (r AccountStaticWebsiteResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"index_document": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"error_404_document": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r AccountStaticWebsiteResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AccountStaticWebsiteResource) ResourceType() string {
+	return "azurerm_storage_account_static_website"
+}
+
+func (r AccountStaticWebsiteResource) ModelObject() interface{} {
+	return &AccountStaticWebsiteModel{}
+}
+
+func (r AccountStaticWebsiteResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r AccountStaticWebsiteResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var plan AccountStaticWebsiteModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate %s", *id)
+			}
+
+			var tier storage.SkuTier
+			if account.Sku != nil {
+				tier = account.Sku.Tier
+			}
+			if !resolveStorageAccountServiceSupportLevel(account.Kind, tier).supportStaticWebsite {
+				return fmt.Errorf("static websites aren't supported for account kind %q in sku tier %q", account.Kind, tier)
+			}
+
+			client, err := metadata.Client.Storage.AccountsDataPlaneClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %+v", err)
+			}
+
+			existing, err := client.GetServiceProperties(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("checking for presence of an existing Static Website for %s: %+v", *id, err)
+			}
+			if props := existing.StorageServiceProperties; props != nil && props.StaticWebsite != nil && props.StaticWebsite.Enabled {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.SetServiceProperties(ctx, id.StorageAccountName, r.expand(plan, true)); err != nil {
+				return fmt.Errorf("enabling the Static Website for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AccountStaticWebsiteResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			client, err := metadata.Client.Storage.AccountsDataPlaneClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %+v", err)
+			}
+
+			resp, err := client.GetServiceProperties(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving the Static Website for %s: %+v", *id, err)
+			}
+
+			props := resp.StorageServiceProperties
+			if props == nil || props.StaticWebsite == nil || !props.StaticWebsite.Enabled {
+				return metadata.MarkAsGone(id)
+			}
+
+			model := AccountStaticWebsiteModel{
+				StorageAccountId: id.ID(),
+				IndexDocument:    props.StaticWebsite.IndexDocument,
+				Error404Document: props.StaticWebsite.ErrorDocument404Path,
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r AccountStaticWebsiteResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var plan AccountStaticWebsiteModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate %s", *id)
+			}
+
+			client, err := metadata.Client.Storage.AccountsDataPlaneClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %+v", err)
+			}
+
+			if _, err := client.SetServiceProperties(ctx, id.StorageAccountName, r.expand(plan, true)); err != nil {
+				return fmt.Errorf("updating the Static Website for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AccountStaticWebsiteResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				// the Storage Account is gone, and the Static Website with it
+				return nil
+			}
+
+			client, err := metadata.Client.Storage.AccountsDataPlaneClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %+v", err)
+			}
+
+			if _, err := client.SetServiceProperties(ctx, id.StorageAccountName, r.expand(AccountStaticWebsiteModel{}, false)); err != nil {
+				return fmt.Errorf("disabling the Static Website for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AccountStaticWebsiteResource) expand(input AccountStaticWebsiteModel, enabled bool) accounts.StorageServiceProperties {
+	return accounts.StorageServiceProperties{
+		StaticWebsite: &accounts.StaticWebsite{
+			Enabled:              enabled,
+			IndexDocument:        input.IndexDocument,
+			ErrorDocument404Path: input.Error404Document,
+		},
+	}
+}
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerImmutabilityPolicyResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ContainerImmutabilityPolicyResource{}
+	_ sdk.ResourceWithCustomizeDiff = ContainerImmutabilityPolicyResource{}
+)
+
+type ContainerImmutabilityPolicyModel struct {
+	StorageContainerResourceManagerId string `tfschema:"storage_container_resource_manager_id"`
+	ImmutabilityPeriodInDays          int64  `tfschema:"immutability_period_in_days"`
+	Locked                            bool   `tfschema:"locked"`
+	ProtectedAppendWritesAllEnabled   bool   `tfschema:"protected_append_writes_all_enabled"`
+	ProtectedAppendWritesEnabled      bool   `tfschema:"protected_append_writes_enabled"`
+}
+
+func (r ContainerImmutabilityPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_resource_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+		},
+
+		"immutability_period_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 146000),
+		},
+
+		"locked": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"protected_append_writes_all_enabled": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"protected_append_writes_enabled"},
+		},
+
+		"protected_append_writes_enabled": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"protected_append_writes_all_enabled"},
+		},
+	}
+}
+
+func (r ContainerImmutabilityPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerImmutabilityPolicyResource) ResourceType() string {
+	return "azurerm_storage_container_immutability_policy"
+}
+
+func (r ContainerImmutabilityPolicyResource) ModelObject() interface{} {
+	return &ContainerImmutabilityPolicyModel{}
+}
+
+func (r ContainerImmutabilityPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageContainerID
+}
+
+func (r ContainerImmutabilityPolicyResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+			if diff.Id() == "" {
+				return nil
+			}
+
+			// a locked policy can't be unlocked or removed, and the immutability period can only be extended
+			if oldLocked, _ := diff.GetChange("locked"); oldLocked.(bool) {
+				if !diff.Get("locked").(bool) {
+					return fmt.Errorf("`locked` can't be set to `false` once an Immutability Policy has been locked")
+				}
+
+				oldPeriod, newPeriod := diff.GetChange("immutability_period_in_days")
+				if newPeriod.(int) < oldPeriod.(int) {
+					return fmt.Errorf("`immutability_period_in_days` can only be extended once an Immutability Policy has been locked - it can't be reduced from %d to %d", oldPeriod.(int), newPeriod.(int))
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerImmutabilityPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			var plan ContainerImmutabilityPolicyModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageContainerID(plan.StorageContainerResourceManagerId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model != nil && existing.Model.Properties != nil && pointer.From(existing.Model.Properties.HasImmutabilityPolicy) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := blobcontainers.ImmutabilityPolicy{
+				Properties: r.expandProperties(plan),
+			}
+			resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, *id, payload, blobcontainers.CreateOrUpdateImmutabilityPolicyOperationOptions{})
+			if err != nil {
+				return fmt.Errorf("creating Immutability Policy for %s: %+v", *id, err)
+			}
+
+			if plan.Locked {
+				if resp.Model == nil || resp.Model.Etag == nil {
+					return fmt.Errorf("creating Immutability Policy for %s: `etag` was nil", *id)
+				}
+
+				if _, err := client.LockImmutabilityPolicy(ctx, *id, blobcontainers.LockImmutabilityPolicyOperationOptions{IfMatch: resp.Model.Etag}); err != nil {
+					return fmt.Errorf("locking Immutability Policy for %s: %+v", *id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerImmutabilityPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := commonids.ParseStorageContainerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			container, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(container.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if container.Model == nil || container.Model.Properties == nil || !pointer.From(container.Model.Properties.HasImmutabilityPolicy) {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetImmutabilityPolicy(ctx, *id, blobcontainers.GetImmutabilityPolicyOperationOptions{})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving Immutability Policy for %s: %+v", *id, err)
+			}
+
+			state := ContainerImmutabilityPolicyModel{
+				StorageContainerResourceManagerId: id.ID(),
+			}
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.ImmutabilityPeriodInDays = pointer.From(props.ImmutabilityPeriodSinceCreationInDays)
+				state.Locked = pointer.From(props.State) == blobcontainers.ImmutabilityPolicyStateLocked
+				state.ProtectedAppendWritesAllEnabled = pointer.From(props.AllowProtectedAppendWritesAll)
+				state.ProtectedAppendWritesEnabled = pointer.From(props.AllowProtectedAppendWrites)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerImmutabilityPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := commonids.ParseStorageContainerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var plan ContainerImmutabilityPolicyModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.GetImmutabilityPolicy(ctx, *id, blobcontainers.GetImmutabilityPolicyOperationOptions{})
+			if err != nil {
+				return fmt.Errorf("retrieving Immutability Policy for %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Etag == nil {
+				return fmt.Errorf("retrieving Immutability Policy for %s: `etag` was nil", *id)
+			}
+			etag := existing.Model.Etag
+			isLocked := pointer.From(existing.Model.Properties.State) == blobcontainers.ImmutabilityPolicyStateLocked
+
+			if metadata.ResourceData.HasChanges("immutability_period_in_days", "protected_append_writes_all_enabled", "protected_append_writes_enabled") {
+				payload := blobcontainers.ImmutabilityPolicy{
+					Properties: r.expandProperties(plan),
+				}
+
+				var updated *blobcontainers.ImmutabilityPolicy
+				if isLocked {
+					// once locked the only permitted change is extending the policy
+					resp, err := client.ExtendImmutabilityPolicy(ctx, *id, payload, blobcontainers.ExtendImmutabilityPolicyOperationOptions{IfMatch: etag})
+					if err != nil {
+						return fmt.Errorf("extending Immutability Policy for %s: %+v", *id, err)
+					}
+					updated = resp.Model
+				} else {
+					resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, *id, payload, blobcontainers.CreateOrUpdateImmutabilityPolicyOperationOptions{IfMatch: etag})
+					if err != nil {
+						return fmt.Errorf("updating Immutability Policy for %s: %+v", *id, err)
+					}
+					updated = resp.Model
+				}
+
+				if updated == nil || updated.Etag == nil {
+					return fmt.Errorf("updating Immutability Policy for %s: `etag` was nil", *id)
+				}
+				etag = updated.Etag
+			}
+
+			if plan.Locked && !isLocked {
+				if _, err := client.LockImmutabilityPolicy(ctx, *id, blobcontainers.LockImmutabilityPolicyOperationOptions{IfMatch: etag}); err != nil {
+					return fmt.Errorf("locking Immutability Policy for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerImmutabilityPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := commonids.ParseStorageContainerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.GetImmutabilityPolicy(ctx, *id, blobcontainers.GetImmutabilityPolicyOperationOptions{})
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving Immutability Policy for %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Etag == nil {
+				return fmt.Errorf("retrieving Immutability Policy for %s: `etag` was nil", *id)
+			}
+
+			if pointer.From(existing.Model.Properties.State) == blobcontainers.ImmutabilityPolicyStateLocked {
+				// a locked policy can't be deleted, it's removed alongside the Container once the retention period has passed
+				log.Printf("[DEBUG] the Immutability Policy for %s is locked and can't be deleted - removing from the state only", *id)
+				return nil
+			}
+
+			if _, err := client.DeleteImmutabilityPolicy(ctx, *id, blobcontainers.DeleteImmutabilityPolicyOperationOptions{IfMatch: existing.Model.Etag}); err != nil {
+				return fmt.Errorf("deleting Immutability Policy for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerImmutabilityPolicyResource) expandProperties(input ContainerImmutabilityPolicyModel) blobcontainers.ImmutabilityPolicyProperty {
+	return blobcontainers.ImmutabilityPolicyProperty{
+		ImmutabilityPeriodSinceCreationInDays: pointer.To(input.ImmutabilityPeriodInDays),
+		AllowProtectedAppendWrites:            pointer.To(input.ProtectedAppendWritesEnabled),
+		AllowProtectedAppendWritesAll:         pointer.To(input.ProtectedAppendWritesAllEnabled),
+	}
+}
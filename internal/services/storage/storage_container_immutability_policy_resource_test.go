@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerImmutabilityPolicyResource struct{}
+
+func TestAccStorageContainerImmutabilityPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_immutability_policy", "test")
+	r := ContainerImmutabilityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainerImmutabilityPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_immutability_policy", "test")
+	r := ContainerImmutabilityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageContainerImmutabilityPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_immutability_policy", "test")
+	r := ContainerImmutabilityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainerImmutabilityPolicy_locked(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_immutability_policy", "test")
+	r := ContainerImmutabilityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.locked(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("locked").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.locked(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("immutability_period_in_days").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.locked(data, 1),
+			ExpectError: regexp.MustCompile("can only be extended once an Immutability Policy has been locked"),
+		},
+		{
+			Config:      r.basic(data, 2),
+			ExpectError: regexp.MustCompile("can't be set to `false` once an Immutability Policy has been locked"),
+		},
+	})
+}
+
+func (r ContainerImmutabilityPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseStorageContainerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Storage.ResourceManager.BlobContainers.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil && resp.Model.Properties != nil && pointer.From(resp.Model.Properties.HasImmutabilityPolicy)), nil
+}
+
+func (r ContainerImmutabilityPolicyResource) basic(data acceptance.TestData, days int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_immutability_policy" "test" {
+  storage_container_resource_manager_id = azurerm_storage_container.test.resource_manager_id
+  immutability_period_in_days           = %d
+}
+`, r.template(data), days)
+}
+
+func (r ContainerImmutabilityPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_immutability_policy" "import" {
+  storage_container_resource_manager_id = azurerm_storage_container_immutability_policy.test.storage_container_resource_manager_id
+  immutability_period_in_days           = azurerm_storage_container_immutability_policy.test.immutability_period_in_days
+}
+`, r.basic(data, 1))
+}
+
+func (r ContainerImmutabilityPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_immutability_policy" "test" {
+  storage_container_resource_manager_id = azurerm_storage_container.test.resource_manager_id
+  immutability_period_in_days           = 7
+  protected_append_writes_all_enabled   = true
+}
+`, r.template(data))
+}
+
+func (r ContainerImmutabilityPolicyResource) locked(data acceptance.TestData, days int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container_immutability_policy" "test" {
+  storage_container_resource_manager_id = azurerm_storage_container.test.resource_manager_id
+  immutability_period_in_days           = %d
+  locked                                = true
+}
+`, r.template(data), days)
+}
+
+func (r ContainerImmutabilityPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerLegalHoldResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerLegalHoldResource{}
+
+type ContainerLegalHoldModel struct {
+	StorageContainerResourceManagerId string   `tfschema:"storage_container_resource_manager_id"`
+	Tags                              []string `tfschema:"tags"`
+	ProtectedAppendWritesAllEnabled   bool     `tfschema:"protected_append_writes_all_enabled"`
+}
+
+func (r ContainerLegalHoldResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_resource_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 10,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.StorageContainerLegalHoldTag,
+			},
+		},
+
+		"protected_append_writes_all_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ContainerLegalHoldResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerLegalHoldResource) ResourceType() string {
+	return "azurerm_storage_container_legal_hold"
+}
+
+func (r ContainerLegalHoldResource) ModelObject() interface{} {
+	return &ContainerLegalHoldModel{}
+}
+
+func (r ContainerLegalHoldResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageContainerID
+}
+
+func (r ContainerLegalHoldResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			var plan ContainerLegalHoldModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageContainerID(plan.StorageContainerResourceManagerId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model != nil && existing.Model.Properties != nil && pointer.From(existing.Model.Properties.HasLegalHold) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := blobcontainers.LegalHold{
+				Tags:                          plan.Tags,
+				AllowProtectedAppendWritesAll: pointer.To(plan.ProtectedAppendWritesAllEnabled),
+			}
+			if _, err := client.SetLegalHold(ctx, *id, payload); err != nil {
+				return fmt.Errorf("setting Legal Hold for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerLegalHoldResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := commonids.ParseStorageContainerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if resp.Model == nil || resp.Model.Properties == nil || !pointer.From(resp.Model.Properties.HasLegalHold) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ContainerLegalHoldModel{
+				StorageContainerResourceManagerId: id.ID(),
+				Tags:                              make([]string, 0),
+			}
+			if legalHold := resp.Model.Properties.LegalHold; legalHold != nil {
+				if legalHold.Tags != nil {
+					for _, tag := range *legalHold.Tags {
+						// the API normalises the casing of the tags, so keep the casing from the config where it matches
+						state.Tags = append(state.Tags, r.tagFromConfig(metadata, pointer.From(tag.Tag)))
+					}
+				}
+				if history := legalHold.ProtectedAppendWritesHistory; history != nil {
+					state.ProtectedAppendWritesAllEnabled = pointer.From(history.AllowProtectedAppendWritesAll)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerLegalHoldResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := commonids.ParseStorageContainerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var plan ContainerLegalHoldModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			if metadata.ResourceData.HasChange("tags") {
+				oldRaw, newRaw := metadata.ResourceData.GetChange("tags")
+				removed := make([]string, 0)
+				for _, v := range oldRaw.(*pluginsdk.Set).Difference(newRaw.(*pluginsdk.Set)).List() {
+					removed = append(removed, v.(string))
+				}
+
+				if len(removed) > 0 {
+					if _, err := client.ClearLegalHold(ctx, *id, blobcontainers.LegalHold{Tags: removed}); err != nil {
+						return fmt.Errorf("clearing Legal Hold tags %q for %s: %+v", strings.Join(removed, ", "), *id, err)
+					}
+				}
+			}
+
+			// setting a Legal Hold is additive, so re-applying the existing tags is a no-op
+			payload := blobcontainers.LegalHold{
+				Tags:                          plan.Tags,
+				AllowProtectedAppendWritesAll: pointer.To(plan.ProtectedAppendWritesAllEnabled),
+			}
+			if _, err := client.SetLegalHold(ctx, *id, payload); err != nil {
+				return fmt.Errorf("setting Legal Hold for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerLegalHoldResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := commonids.ParseStorageContainerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ContainerLegalHoldModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			if _, err := client.ClearLegalHold(ctx, *id, blobcontainers.LegalHold{Tags: state.Tags}); err != nil {
+				return fmt.Errorf("clearing Legal Hold for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerLegalHoldResource) tagFromConfig(metadata sdk.ResourceMetaData, tag string) string {
+	for _, v := range metadata.ResourceData.Get("tags").(*pluginsdk.Set).List() {
+		if strings.EqualFold(v.(string), tag) {
+			return v.(string)
+		}
+	}
+
+	return tag
+}
@@ -4,10 +4,12 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -44,6 +46,14 @@ func resourceStorageContainer() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			// version-level immutability can't be disabled once it's been enabled on a Container
+			if oldVal, newVal := diff.GetChange("immutable_storage_with_versioning_enabled"); oldVal.(bool) && !newVal.(bool) {
+				return fmt.Errorf("`immutable_storage_with_versioning_enabled` can't be disabled once it's been enabled")
+			}
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -72,7 +82,15 @@ func resourceStorageContainer() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 
-			// TODO: support for ACL's, Legal Holds and Immutability Policies
+			"immutable_storage_with_versioning_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			// TODO: support for ACL's
+			// Legal Holds and Immutability Policies are managed using the `azurerm_storage_container_legal_hold`
+			// and `azurerm_storage_container_immutability_policy` resources
 			"has_immutability_policy": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -137,6 +155,14 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.SetId(id)
+
+	if d.Get("immutable_storage_with_versioning_enabled").(bool) {
+		resourceManagerId := commonids.NewStorageContainerID(meta.(*clients.Client).Account.SubscriptionId, account.ResourceGroup, accountName, containerName)
+		if err := storageClient.ResourceManager.BlobContainers.ObjectLevelWormThenPoll(ctx, resourceManagerId); err != nil {
+			return fmt.Errorf("enabling version-level immutability for %s: %+v", resourceManagerId, err)
+		}
+	}
+
 	return resourceStorageContainerRead(d, meta)
 }
 
@@ -186,6 +212,14 @@ func resourceStorageContainerUpdate(d *pluginsdk.ResourceData, meta interface{})
 		log.Printf("[DEBUG] Updated the MetaData for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
 	}
 
+	if d.HasChange("immutable_storage_with_versioning_enabled") && d.Get("immutable_storage_with_versioning_enabled").(bool) {
+		// this migrates the existing Container, and any existing Blobs within it, to version-level immutability
+		resourceManagerId := commonids.NewStorageContainerID(meta.(*clients.Client).Account.SubscriptionId, account.ResourceGroup, id.AccountName, id.Name)
+		if err := storageClient.ResourceManager.BlobContainers.ObjectLevelWormThenPoll(ctx, resourceManagerId); err != nil {
+			return fmt.Errorf("enabling version-level immutability for %s: %+v", resourceManagerId, err)
+		}
+	}
+
 	return resourceStorageContainerRead(d, meta)
 }
 
@@ -239,6 +273,19 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 	resourceManagerId := commonids.NewStorageContainerID(subscriptionId, account.ResourceGroup, id.AccountName, id.Name)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
+	// version-level immutability isn't exposed by the Data Plane API, so is retrieved from Resource Manager - since
+	// the rest of this resource only needs Data Plane access a failure here is logged rather than returned
+	resp, err := storageClient.ResourceManager.BlobContainers.Get(ctx, resourceManagerId)
+	if err != nil {
+		log.Printf("[WARN] retrieving %s to determine whether version-level immutability is enabled: %+v", resourceManagerId, err)
+	} else {
+		immutableStorageWithVersioningEnabled := false
+		if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ImmutableStorageWithVersioning != nil {
+			immutableStorageWithVersioningEnabled = pointer.From(model.Properties.ImmutableStorageWithVersioning.Enabled)
+		}
+		d.Set("immutable_storage_with_versioning_enabled", immutableStorageWithVersioningEnabled)
+	}
+
 	return nil
 }
 
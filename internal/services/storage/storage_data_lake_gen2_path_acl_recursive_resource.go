@@ -0,0 +1,505 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/datalakestore/paths"
+)
+
+const (
+	// dataLakeGen2PathAclRecursiveMaxAttempts is the number of times a single batch is attempted before giving up
+	dataLakeGen2PathAclRecursiveMaxAttempts = 3
+
+	// dataLakeGen2PathAclRecursiveMaxFailedEntries caps the number of failed Paths stored in the state
+	dataLakeGen2PathAclRecursiveMaxFailedEntries = 100
+)
+
+type DataLakeGen2PathAclRecursiveResource struct{}
+
+var _ sdk.ResourceWithUpdate = DataLakeGen2PathAclRecursiveResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = DataLakeGen2PathAclRecursiveResource{}
+
+type DataLakeGen2PathAclRecursiveModel struct {
+	StorageAccountId      string                                    `tfschema:"storage_account_id"`
+	FileSystemName        string                                    `tfschema:"filesystem_name"`
+	Path                  string                                    `tfschema:"path"`
+	Mode                  string                                    `tfschema:"mode"`
+	Ace                   []DataLakeGen2PathAclRecursiveAceModel    `tfschema:"ace"`
+	BatchSize             int64                                     `tfschema:"batch_size"`
+	ContinueOnFailure     bool                                      `tfschema:"continue_on_failure"`
+	ContinuationToken     string                                    `tfschema:"continuation_token"`
+	Triggers              map[string]string                         `tfschema:"triggers"`
+	DirectoriesSuccessful int64                                     `tfschema:"directories_successful"`
+	FilesSuccessful       int64                                     `tfschema:"files_successful"`
+	FailureCount          int64                                     `tfschema:"failure_count"`
+	FailedEntry           []DataLakeGen2PathAclRecursiveFailedModel `tfschema:"failed_entry"`
+}
+
+type DataLakeGen2PathAclRecursiveAceModel struct {
+	Scope       string `tfschema:"scope"`
+	Type        string `tfschema:"type"`
+	Id          string `tfschema:"id"`
+	Permissions string `tfschema:"permissions"`
+}
+
+type DataLakeGen2PathAclRecursiveFailedModel struct {
+	Name         string `tfschema:"name"`
+	Type         string `tfschema:"type"`
+	ErrorMessage string `tfschema:"error_message"`
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"filesystem_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateStorageDataLakeGen2FileSystemName,
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(azuresdkhacks.SetAccessControlRecursiveModeModify),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.SetAccessControlRecursiveModeModify),
+				string(azuresdkhacks.SetAccessControlRecursiveModeRemove),
+				string(azuresdkhacks.SetAccessControlRecursiveModeSet),
+			}, false),
+		},
+
+		"ace": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"scope": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"default", "access"}, false),
+						Default:      "access",
+					},
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"user", "group", "mask", "other"}, false),
+					},
+					"id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+					"permissions": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.ADLSAccessControlPermissions,
+					},
+				},
+			},
+		},
+
+		"batch_size": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      2000,
+			ValidateFunc: validation.IntBetween(1, 2000),
+		},
+
+		"continue_on_failure": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		// the last token which was used is kept in the state, so that the configuration can retain it without re-running
+		"continuation_token": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"directories_successful": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"files_successful": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"failure_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"failed_entry": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"error_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) ResourceType() string {
+	return "azurerm_storage_data_lake_gen2_path_acl_recursive"
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) ModelObject() interface{} {
+	return &DataLakeGen2PathAclRecursiveModel{}
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(input interface{}, key string) (warnings []string, errors []error) {
+		v, ok := input.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+			return
+		}
+
+		if _, err := paths.ParseResourceID(v); err != nil {
+			errors = append(errors, err)
+		}
+		return
+	}
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config DataLakeGen2PathAclRecursiveModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, ace := range config.Ace {
+				// entries are removed by their scope, type and id - as such permissions are only required when setting/modifying
+				if config.Mode == string(azuresdkhacks.SetAccessControlRecursiveModeRemove) {
+					if ace.Permissions != "" {
+						return fmt.Errorf("`permissions` can't be specified for an `ace` when `mode` is `remove`")
+					}
+					continue
+				}
+
+				if ace.Permissions == "" {
+					return fmt.Errorf("`permissions` must be specified for each `ace` when `mode` is `%s`", config.Mode)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ADLSGen2PathsClient
+
+			var plan DataLakeGen2PathAclRecursiveModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			// confirm the Path exists, otherwise there's nothing to apply the ACL to
+			resp, err := client.GetProperties(ctx, accountId.StorageAccountName, plan.FileSystemName, plan.Path, paths.GetPropertiesActionGetStatus)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("Path %q in File System %q in %s was not found", plan.Path, plan.FileSystemName, *accountId)
+				}
+				return fmt.Errorf("retrieving Path %q in File System %q in %s: %+v", plan.Path, plan.FileSystemName, *accountId, err)
+			}
+
+			id := client.GetResourceID(accountId.StorageAccountName, plan.FileSystemName, plan.Path)
+			metadata.ResourceData.SetId(id)
+
+			applyErr := r.apply(ctx, client, accountId.StorageAccountName, &plan, plan.ContinuationToken)
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding: %+v", err)
+			}
+
+			return applyErr
+		},
+	}
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ADLSGen2PathsClient
+
+			id, err := paths.ParseResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Data Lake Gen2 Path %q in File System %q: %+v", id.AccountName, id.Path, id.FileSystemName, err)
+			}
+			if account == nil {
+				log.Printf("[DEBUG] Unable to locate Account %q for Data Lake Gen2 Path %q in File System %q - assuming removed & removing from state", id.AccountName, id.Path, id.FileSystemName)
+				metadata.ResourceData.SetId("")
+				return nil
+			}
+
+			resp, err := client.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetStatus)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					log.Printf("[DEBUG] %s was not found - removing from state", r.pathDescription(*id))
+					metadata.ResourceData.SetId("")
+					return nil
+				}
+				return fmt.Errorf("retrieving Path %q in File System %q in Storage Account %q: %+v", id.Path, id.FileSystemName, id.AccountName, err)
+			}
+
+			// the ACLs of every child Path can't be retrieved in a reasonable time, as such the remaining
+			// fields (including the results of the last operation) are taken from the state
+			var state DataLakeGen2PathAclRecursiveModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.StorageAccountId = commonids.NewStorageAccountID(metadata.Client.Account.SubscriptionId, account.ResourceGroup, id.AccountName).ID()
+			state.FileSystemName = id.FileSystemName
+			state.Path = id.Path
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ADLSGen2PathsClient
+
+			id, err := paths.ParseResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var plan DataLakeGen2PathAclRecursiveModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// changing the batch size or the failure behaviour alone doesn't require the ACL to be re-applied
+			if !metadata.ResourceData.HasChanges("mode", "ace", "continuation_token") {
+				return nil
+			}
+
+			// the `continuation_token` is only used to resume the previous run, a change to the ACL starts a new run
+			continuation := plan.ContinuationToken
+			if metadata.ResourceData.HasChanges("mode", "ace") {
+				continuation = ""
+			}
+
+			applyErr := r.apply(ctx, client, id.AccountName, &plan, continuation)
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding: %+v", err)
+			}
+
+			return applyErr
+		},
+	}
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := paths.ParseResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the ACL entries which have been applied can't be reverted, since the previous ACL of each child Path isn't known
+			log.Printf("[DEBUG] the recursive ACL for %s can't be reverted - removing from the state only", r.pathDescription(*id))
+			return nil
+		},
+	}
+}
+
+// apply applies the ACL to the Path and all of its children in batches, resuming from the continuation token
+// when one is specified and updating the results within the model.
+func (r DataLakeGen2PathAclRecursiveResource) apply(ctx context.Context, client *paths.Client, accountName string, model *DataLakeGen2PathAclRecursiveModel, continuation string) error {
+	acl, err := expandDataLakeGen2PathAclRecursiveAces(model.Ace, model.Mode)
+	if err != nil {
+		return fmt.Errorf("expanding `ace`: %+v", err)
+	}
+
+	input := azuresdkhacks.SetAccessControlRecursiveInput{
+		Mode:       azuresdkhacks.SetAccessControlRecursiveMode(model.Mode),
+		ACL:        acl,
+		ForceFlag:  pointer.To(model.ContinueOnFailure),
+		MaxRecords: pointer.To(model.BatchSize),
+	}
+	if continuation != "" {
+		input.Continuation = pointer.To(continuation)
+	}
+
+	model.DirectoriesSuccessful = 0
+	model.FilesSuccessful = 0
+	model.FailureCount = 0
+	model.FailedEntry = make([]DataLakeGen2PathAclRecursiveFailedModel, 0)
+
+	description := fmt.Sprintf("Path %q in File System %q in Storage Account %q", model.Path, model.FileSystemName, accountName)
+	for batch := 1; ; batch++ {
+		var result azuresdkhacks.SetAccessControlRecursiveResult
+		for attempt := 1; ; attempt++ {
+			result, err = azuresdkhacks.SetAccessControlRecursive(ctx, client, accountName, model.FileSystemName, model.Path, input)
+			if err == nil {
+				break
+			}
+			if attempt >= dataLakeGen2PathAclRecursiveMaxAttempts || ctx.Err() != nil {
+				return r.resumableError(fmt.Errorf("applying batch %d of the recursive ACL for %s: %+v", batch, description, err), input.Continuation)
+			}
+
+			log.Printf("[DEBUG] applying batch %d of the recursive ACL for %s failed (attempt %d of %d) - retrying: %+v", batch, description, attempt, dataLakeGen2PathAclRecursiveMaxAttempts, err)
+			select {
+			case <-ctx.Done():
+				return r.resumableError(fmt.Errorf("applying batch %d of the recursive ACL for %s: %+v", batch, description, ctx.Err()), input.Continuation)
+			case <-time.After(time.Duration(attempt) * 10 * time.Second):
+			}
+		}
+
+		model.DirectoriesSuccessful += pointer.From(result.DirectoriesSuccessful)
+		model.FilesSuccessful += pointer.From(result.FilesSuccessful)
+		model.FailureCount += pointer.From(result.FailureCount)
+		if result.FailedEntries != nil {
+			for _, v := range *result.FailedEntries {
+				if len(model.FailedEntry) >= dataLakeGen2PathAclRecursiveMaxFailedEntries {
+					break
+				}
+				model.FailedEntry = append(model.FailedEntry, DataLakeGen2PathAclRecursiveFailedModel{
+					Name:         pointer.From(v.Name),
+					Type:         pointer.From(v.Type),
+					ErrorMessage: pointer.From(v.ErrorMessage),
+				})
+			}
+		}
+
+		log.Printf("[DEBUG] applied batch %d of the recursive ACL for %s: %d directories and %d files successful, %d failures so far", batch, description, model.DirectoriesSuccessful, model.FilesSuccessful, model.FailureCount)
+
+		// when the operation isn't forced the API stops at the first failure, returning a token to resume from
+		if !model.ContinueOnFailure && pointer.From(result.FailureCount) > 0 {
+			return r.resumableError(fmt.Errorf("applying the recursive ACL for %s: %d Paths failed", description, model.FailureCount), result.Continuation)
+		}
+
+		if result.Continuation == nil {
+			return nil
+		}
+		input.Continuation = result.Continuation
+	}
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) resumableError(err error, continuation *string) error {
+	if continuation == nil {
+		return err
+	}
+
+	return fmt.Errorf("%+v\n\nThe operation can be resumed from where it stopped by setting `continuation_token` to %q", err, *continuation)
+}
+
+func (r DataLakeGen2PathAclRecursiveResource) pathDescription(id paths.ResourceID) string {
+	return fmt.Sprintf("Path %q in File System %q in Storage Account %q", id.Path, id.FileSystemName, id.AccountName)
+}
+
+func expandDataLakeGen2PathAclRecursiveAces(input []DataLakeGen2PathAclRecursiveAceModel, mode string) (string, error) {
+	entries := make([]string, 0)
+	for _, v := range input {
+		prefix := ""
+		if v.Scope == "default" {
+			prefix = "default:"
+		}
+
+		qualifier := ""
+		if v.Id != "" {
+			id, err := uuid.Parse(v.Id)
+			if err != nil {
+				return "", fmt.Errorf("parsing `id` %q: %+v", v.Id, err)
+			}
+			qualifier = id.String()
+		}
+
+		// entries are removed using only their scope, type and id
+		if mode == string(azuresdkhacks.SetAccessControlRecursiveModeRemove) {
+			entries = append(entries, fmt.Sprintf("%s%s:%s", prefix, v.Type, qualifier))
+			continue
+		}
+
+		entries = append(entries, fmt.Sprintf("%s%s:%s:%s", prefix, v.Type, qualifier, v.Permissions))
+	}
+
+	return strings.Join(entries, ","), nil
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/datalakestore/paths"
+)
+
+type StorageDataLakeGen2PathAclRecursiveResource struct{}
+
+func TestAccStorageDataLakeGen2PathAclRecursive_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_acl_recursive", "test")
+	r := StorageDataLakeGen2PathAclRecursiveResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("directories_successful").HasValue("3"),
+				check.That(data.ResourceName).Key("failure_count").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccStorageDataLakeGen2PathAclRecursive_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_acl_recursive", "test")
+	r := StorageDataLakeGen2PathAclRecursiveResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("directories_successful").HasValue("3"),
+				check.That(data.ResourceName).Key("failure_count").HasValue("0"),
+			),
+		},
+		{
+			Config: r.remove(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("directories_successful").HasValue("3"),
+			),
+		},
+	})
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := paths.ParseResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Storage.ADLSGen2PathsClient.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetStatus)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Path %q (File System %q / Account %q): %+v", id.Path, id.FileSystemName, id.AccountName, err)
+	}
+	return utils.Bool(true), nil
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_acl_recursive" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = azurerm_storage_data_lake_gen2_path.parent.path
+
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+
+  depends_on = [
+    azurerm_storage_data_lake_gen2_path.grandchild
+  ]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_acl_recursive" "test" {
+  storage_account_id  = azurerm_storage_account.test.id
+  filesystem_name     = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path                = azurerm_storage_data_lake_gen2_path.parent.path
+  mode                = "modify"
+  batch_size          = 1
+  continue_on_failure = true
+
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "rwx"
+  }
+
+  ace {
+    scope       = "default"
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+
+  depends_on = [
+    azurerm_storage_data_lake_gen2_path.grandchild
+  ]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) remove(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_acl_recursive" "test" {
+  storage_account_id  = azurerm_storage_account.test.id
+  filesystem_name     = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path                = azurerm_storage_data_lake_gen2_path.parent.path
+  mode                = "remove"
+  batch_size          = 1
+  continue_on_failure = true
+
+  ace {
+    scope = "default"
+    type  = "user"
+    id    = azuread_service_principal.test.object_id
+  }
+
+  depends_on = [
+    azurerm_storage_data_lake_gen2_path.grandchild
+  ]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestspa%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "BlobStorage"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_role_assignment" "storageAccountRoleAssignment" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "fstest"
+  storage_account_id = azurerm_storage_account.test.id
+  depends_on = [
+    azurerm_role_assignment.storageAccountRoleAssignment
+  ]
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "parent" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "parent"
+  resource           = "directory"
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "child" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "${azurerm_storage_data_lake_gen2_path.parent.path}/child"
+  resource           = "directory"
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "grandchild" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "${azurerm_storage_data_lake_gen2_path.child.path}/grandchild"
+  resource           = "directory"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StorageContainerLegalHoldTag(v interface{}, _ string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	if !regexp.MustCompile("^[0-9a-zA-Z]{3,23}$").MatchString(input) {
+		errors = append(errors, fmt.Errorf("legal hold tag %q must be alphanumeric, and between 3 to 23 characters", input))
+	}
+
+	return warnings, errors
+}
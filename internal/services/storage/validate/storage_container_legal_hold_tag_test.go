@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestStorageContainerLegalHoldTag(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"ab", true},
+		{"abc", false},
+		{"Case2023", false},
+		{"legal-hold", true},
+		{"legal_hold", true},
+		{"abcdefghijklmnopqrstuvw", false},
+		{"abcdefghijklmnopqrstuvwx", true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageContainerLegalHoldTag(test.input, "tags")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating tag %q to fail", test.input)
+		}
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating tag %q to pass", test.input)
+		}
+	}
+}
@@ -4,6 +4,9 @@
 package pluginsdk
 
 import (
+	"context"
+	"log"
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -40,3 +43,74 @@ func NonRetryableError(err error) *RetryError {
 	// TODO: deprecate this in the future
 	return retry.NonRetryableError(err)
 }
+
+// RetryPolicy defines how operations which fail with a transient error should be retried
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times an operation is attempted, where 1 disables retries
+	MaxAttempts int
+
+	// Delay is the delay before the first retry, which is doubled for each subsequent retry
+	Delay time.Duration
+
+	// MaxDelay is the maximum delay between retries
+	MaxDelay time.Duration
+
+	// RetryableErrors is a list of patterns matched against the error message to determine if an error is transient
+	RetryableErrors []*regexp.Regexp
+}
+
+// DefaultRetryableErrors is the list of patterns used to identify transient errors when none are specified
+var DefaultRetryableErrors = []string{
+	`AnotherOperationInProgress`,
+	`RetryableError`,
+	`ServerBusy`,
+	`TooManyRequests`,
+	`unexpected status (429|500|502|503|504)\b`,
+	`StatusCode=(429|500|502|503|504)\b`,
+	`connection reset by peer`,
+}
+
+// IsRetryable returns whether the specified error matches one of the RetryableErrors for this RetryPolicy
+func (p RetryPolicy) IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, pattern := range p.RetryableErrors {
+		if pattern.MatchString(err.Error()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RetryWithPolicy calls the function f until it succeeds, the maximum number of attempts have been made or
+// the context is cancelled. Errors returned using RetryableError are only retried when they match the RetryPolicy,
+// whereas errors returned using NonRetryableError are never retried.
+func RetryWithPolicy(ctx context.Context, policy RetryPolicy, f RetryFunc) error {
+	delay := policy.Delay
+	for attempt := 1; ; attempt++ {
+		retryErr := f()
+		if retryErr == nil {
+			return nil
+		}
+
+		err := retryErr.Err
+		if !retryErr.Retryable || attempt >= policy.MaxAttempts || !policy.IsRetryable(err) {
+			return err
+		}
+
+		log.Printf("[DEBUG] Attempt %d of %d failed with a retryable error, retrying in %s: %+v", attempt, policy.MaxAttempts, delay, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+		}
+	}
+}
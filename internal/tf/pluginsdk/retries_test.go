@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRetryWithPolicy(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		Delay:       time.Millisecond,
+		MaxDelay:    2 * time.Millisecond,
+		RetryableErrors: []*regexp.Regexp{
+			regexp.MustCompile(`AnotherOperationInProgress`),
+		},
+	}
+
+	testData := []struct {
+		Name             string
+		Errors           []*RetryError
+		ExpectedAttempts int
+		ExpectError      bool
+	}{
+		{
+			Name:             "Succeeds First Time",
+			Errors:           []*RetryError{nil},
+			ExpectedAttempts: 1,
+			ExpectError:      false,
+		},
+		{
+			Name: "Succeeds After A Retryable Error",
+			Errors: []*RetryError{
+				RetryableError(fmt.Errorf("Code: \"AnotherOperationInProgress\"")),
+				nil,
+			},
+			ExpectedAttempts: 2,
+			ExpectError:      false,
+		},
+		{
+			Name: "Non Retryable Error",
+			Errors: []*RetryError{
+				RetryableError(fmt.Errorf("Code: \"InvalidParameter\"")),
+				nil,
+			},
+			ExpectedAttempts: 1,
+			ExpectError:      true,
+		},
+		{
+			Name: "Max Attempts Exceeded",
+			Errors: []*RetryError{
+				RetryableError(fmt.Errorf("Code: \"AnotherOperationInProgress\"")),
+				RetryableError(fmt.Errorf("Code: \"AnotherOperationInProgress\"")),
+				RetryableError(fmt.Errorf("Code: \"AnotherOperationInProgress\"")),
+				nil,
+			},
+			ExpectedAttempts: 3,
+			ExpectError:      true,
+		},
+		{
+			Name: "Non Retryable Error Matching The Policy",
+			Errors: []*RetryError{
+				NonRetryableError(fmt.Errorf("Code: \"AnotherOperationInProgress\"")),
+				nil,
+			},
+			ExpectedAttempts: 1,
+			ExpectError:      true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		attempts := 0
+		err := RetryWithPolicy(context.TODO(), policy, func() *RetryError {
+			err := v.Errors[attempts]
+			attempts++
+			return err
+		})
+
+		if attempts != v.ExpectedAttempts {
+			t.Fatalf("expected %d attempts but got %d", v.ExpectedAttempts, attempts)
+		}
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	schema2 "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/util"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// exampleDiff is an issue found in one of the HCL examples within the documentation
+type exampleDiff struct {
+	checkBase
+	Message string
+
+	// From and To are populated when the issue can be fixed by renaming an argument or block
+	From string
+	To   string
+}
+
+func newExampleDiff(line int, key, message string) exampleDiff {
+	return exampleDiff{
+		checkBase: newCheckBase(line, key, nil),
+		Message:   message,
+	}
+}
+
+func newExampleRenameDiff(line int, key, message, from, to string) exampleDiff {
+	d := newExampleDiff(line, key, message)
+	d.From = from
+	d.To = to
+	return d
+}
+
+// ShouldSkip - examples aren't tied to a field within the documentation, so are never skipped
+func (c exampleDiff) ShouldSkip() bool {
+	return false
+}
+
+func (c exampleDiff) String() string {
+	if c.To != "" {
+		return fmt.Sprintf("%s example %s, should be %s", c.checkBase.Str(), c.Message, util.Bold(c.To))
+	}
+	return fmt.Sprintf("%s example %s", c.checkBase.Str(), c.Message)
+}
+
+// Fix renames the argument or block on this line when a rename is known, otherwise the line is returned as-is
+func (c exampleDiff) Fix(line string) (result string, err error) {
+	if c.From == "" || c.To == "" {
+		return line, nil
+	}
+
+	reg := regexp.MustCompile(`^(\s*(?:dynamic\s+")?)` + regexp.QuoteMeta(c.From) + `\b`)
+	return reg.ReplaceAllString(line, "${1}"+c.To), nil
+}
+
+var _ Checker = (*exampleDiff)(nil)
+
+// metaArguments are the arguments and blocks supported by all resources, which aren't part of the resource schema
+var metaArguments = map[string]struct{}{
+	"count":       {},
+	"depends_on":  {},
+	"for_each":    {},
+	"lifecycle":   {},
+	"provider":    {},
+	"provisioner": {},
+	"connection":  {},
+}
+
+var deprecatedRenameReg = regexp.MustCompile("(?:in favour of|in favor of|renamed to|use) (?:the )?`([a-z0-9_]+)`")
+
+type hclExample struct {
+	// Line is the index of the first line of HCL within the document
+	Line   int
+	Source string
+}
+
+// extractHCLExamples returns the contents of each fenced `hcl` or `terraform` code block within the document
+func extractHCLExamples(content string) []hclExample {
+	var examples []hclExample
+	var current *hclExample
+	var buf []string
+
+	for idx, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if current == nil {
+			if trimmed == "```hcl" || trimmed == "```terraform" {
+				current = &hclExample{Line: idx + 1}
+				buf = nil
+			}
+			continue
+		}
+
+		if trimmed == "```" {
+			current.Source = strings.Join(buf, "\n")
+			examples = append(examples, *current)
+			current = nil
+			continue
+		}
+		buf = append(buf, line)
+	}
+
+	return examples
+}
+
+// checkExamples parses every HCL example within the document and validates any blocks for this resource
+// against the resource schema - checking the names of arguments and blocks, and the possible values of literals
+func checkExamples(tf *schema.Resource, content string) (res []Checker) {
+	if tf == nil || tf.Schema == nil {
+		return nil
+	}
+
+	for _, example := range extractHCLExamples(content) {
+		file, diags := hclsyntax.ParseConfig([]byte(example.Source), "example.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			line := example.Line
+			if len(diags) > 0 && diags[0].Subject != nil {
+				line += diags[0].Subject.Start.Line - 1
+			}
+			res = append(res, newExampleDiff(line, tf.ResourceType, fmt.Sprintf("can not be parsed: %s", diags[0].Summary)))
+			continue
+		}
+
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) == 0 || block.Labels[0] != tf.ResourceType {
+				continue
+			}
+
+			res = append(res, checkExampleBody(tf, example.Line, block.Body, tf.Schema.Schema, "", tf.Schema.Timeouts != nil)...)
+		}
+	}
+
+	return res
+}
+
+func checkExampleBody(tf *schema.Resource, offset int, body *hclsyntax.Body, props map[string]*schema2.Schema, path string, supportsTimeouts bool) (res []Checker) {
+	lineOf := func(rng hcl.Range) int {
+		return offset + rng.Start.Line - 1
+	}
+	keyOf := func(name string) string {
+		if path == "" {
+			return name
+		}
+		return path + "." + name
+	}
+
+	names := make([]string, 0)
+	for name := range body.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		attr := body.Attributes[name]
+		if _, ok := metaArguments[name]; ok && path == "" {
+			continue
+		}
+
+		prop, ok := props[name]
+		if !ok {
+			res = append(res, unknownExampleProperty(lineOf(attr.NameRange), keyOf(name), "argument", name, props))
+			continue
+		}
+
+		if prop.Computed && !prop.Optional && !prop.Required {
+			res = append(res, newExampleDiff(lineOf(attr.NameRange), keyOf(name), fmt.Sprintf("sets the read-only attribute `%s`", name)))
+			continue
+		}
+
+		if isBlockSchema(prop) && prop.ConfigMode != schema2.SchemaConfigModeAttr {
+			res = append(res, newExampleDiff(lineOf(attr.NameRange), keyOf(name), fmt.Sprintf("uses `%s` as an argument but it's a block", name)))
+			continue
+		}
+
+		if rename := deprecatedRename(prop, props); rename != "" {
+			res = append(res, newExampleRenameDiff(lineOf(attr.NameRange), keyOf(name), fmt.Sprintf("uses the deprecated argument `%s`", name), name, rename))
+		}
+
+		if possible := tf.PossibleValues[keyOf(name)]; len(possible) > 0 {
+			if value, ok := literalString(attr.Expr); ok && !containsFold(possible, value) {
+				res = append(res, newExampleDiff(lineOf(attr.Expr.Range()), keyOf(name), fmt.Sprintf("uses the value %q for `%s` which is not one of %s", value, name, possibleValueStr(possible))))
+			}
+		}
+	}
+
+	for _, block := range body.Blocks {
+		name := block.Type
+		blockBody := block.Body
+
+		switch {
+		case name == "timeouts" && path == "" && supportsTimeouts:
+			continue
+		case path == "":
+			if _, ok := metaArguments[name]; ok {
+				continue
+			}
+		}
+
+		if name == "dynamic" {
+			if len(block.Labels) == 0 {
+				continue
+			}
+			name = block.Labels[0]
+			blockBody = nil
+			for _, inner := range block.Body.Blocks {
+				if inner.Type == "content" {
+					blockBody = inner.Body
+				}
+			}
+		}
+
+		prop, ok := props[name]
+		if !ok {
+			res = append(res, unknownExampleProperty(lineOf(block.TypeRange), keyOf(name), "block", name, props))
+			continue
+		}
+
+		if !isBlockSchema(prop) {
+			res = append(res, newExampleDiff(lineOf(block.TypeRange), keyOf(name), fmt.Sprintf("uses `%s` as a block but it's an argument", name)))
+			continue
+		}
+
+		if rename := deprecatedRename(prop, props); rename != "" {
+			res = append(res, newExampleRenameDiff(lineOf(block.TypeRange), keyOf(name), fmt.Sprintf("uses the deprecated block `%s`", name), name, rename))
+		}
+
+		if blockBody != nil {
+			nested := prop.Elem.(*schema2.Resource).Schema
+			res = append(res, checkExampleBody(tf, offset, blockBody, nested, keyOf(name), false)...)
+		}
+	}
+
+	return res
+}
+
+// unknownExampleProperty returns an issue for an argument or block which doesn't exist in the schema, suggesting a
+// rename when there's a single likely candidate
+func unknownExampleProperty(line int, key, kind, name string, props map[string]*schema2.Schema) exampleDiff {
+	message := fmt.Sprintf("uses the unknown %s `%s`", kind, name)
+	if candidate := renameCandidate(name, props); candidate != "" {
+		return newExampleRenameDiff(line, key, message, name, candidate)
+	}
+	return newExampleDiff(line, key, message)
+}
+
+// renameCandidate returns the property which `name` has most likely been renamed to, or an empty string when
+// there isn't a single likely candidate
+func renameCandidate(name string, props map[string]*schema2.Schema) string {
+	var candidates []string
+	add := func(v string) {
+		if _, ok := props[v]; ok {
+			candidates = append(candidates, v)
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(name, "enable_"):
+		add(strings.TrimPrefix(name, "enable_") + "_enabled")
+	case strings.HasPrefix(name, "is_") && strings.HasSuffix(name, "_enabled"):
+		add(strings.TrimPrefix(name, "is_"))
+	case strings.HasSuffix(name, "_enabled"):
+		add("enable_" + strings.TrimSuffix(name, "_enabled"))
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+
+	best, bestDist := "", 3
+	for prop, v := range props {
+		if v.Computed && !v.Optional && !v.Required {
+			continue
+		}
+		dist := levenshteinDist(name, prop)
+		switch {
+		case dist < bestDist:
+			best, bestDist = prop, dist
+		case dist == bestDist:
+			// ambiguous - more than one property is equally likely
+			best = ""
+		}
+	}
+
+	return best
+}
+
+// deprecatedRename returns the property which replaces a deprecated property, when this is specified in the
+// deprecation message and exists within the same schema
+func deprecatedRename(prop *schema2.Schema, props map[string]*schema2.Schema) string {
+	if prop.Deprecated == "" {
+		return ""
+	}
+
+	match := deprecatedRenameReg.FindStringSubmatch(prop.Deprecated)
+	if len(match) != 2 {
+		return ""
+	}
+
+	if _, ok := props[match[1]]; !ok {
+		return ""
+	}
+	return match[1]
+}
+
+func isBlockSchema(prop *schema2.Schema) bool {
+	if prop.Type != schema2.TypeList && prop.Type != schema2.TypeSet {
+		return false
+	}
+	_, ok := prop.Elem.(*schema2.Resource)
+	return ok
+}
+
+// literalString returns the value of an expression when it's a string literal
+func literalString(expr hclsyntax.Expression) (string, bool) {
+	if len(expr.Variables()) > 0 {
+		return "", false
+	}
+
+	switch expr.(type) {
+	case *hclsyntax.TemplateExpr, *hclsyntax.LiteralValueExpr:
+	default:
+		return "", false
+	}
+
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() || !value.IsKnown() || value.IsNull() || value.Type() != cty.String {
+		return "", false
+	}
+
+	return value.AsString(), true
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
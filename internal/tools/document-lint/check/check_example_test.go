@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"strings"
+	"testing"
+
+	schema2 "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/schema"
+)
+
+func testExampleResource() *schema.Resource {
+	return &schema.Resource{
+		ResourceType: "azurerm_example",
+		Schema: &schema2.Resource{
+			Schema: map[string]*schema2.Schema{
+				"name": {
+					Type:     schema2.TypeString,
+					Required: true,
+				},
+				"sku": {
+					Type:     schema2.TypeString,
+					Optional: true,
+				},
+				"public_network_access_enabled": {
+					Type:     schema2.TypeBool,
+					Optional: true,
+				},
+				"old_setting": {
+					Type:       schema2.TypeString,
+					Optional:   true,
+					Deprecated: "`old_setting` has been deprecated in favour of `new_setting`",
+				},
+				"new_setting": {
+					Type:     schema2.TypeString,
+					Optional: true,
+				},
+				"identity": {
+					Type:     schema2.TypeList,
+					Optional: true,
+					Elem: &schema2.Resource{
+						Schema: map[string]*schema2.Schema{
+							"type": {
+								Type:     schema2.TypeString,
+								Required: true,
+							},
+							"principal_id": {
+								Type:     schema2.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"endpoint": {
+					Type:     schema2.TypeString,
+					Computed: true,
+				},
+			},
+			Timeouts: &schema2.ResourceTimeout{},
+		},
+		PossibleValues: map[string][]string{
+			"sku":           {"Basic", "Standard"},
+			"identity.type": {"SystemAssigned", "UserAssigned"},
+		},
+	}
+}
+
+func TestCheckExamples(t *testing.T) {
+	tests := []struct {
+		name     string
+		example  string
+		expected []string
+	}{
+		{
+			name: "valid",
+			example: `resource "azurerm_example" "test" {
+  name = "example"
+  sku  = "standard"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  lifecycle {
+    ignore_changes = [sku]
+  }
+
+  timeouts {
+    create = "10m"
+  }
+}`,
+		},
+		{
+			name: "other resources are ignored",
+			example: `resource "azurerm_resource_group" "test" {
+  name     = "example"
+  location = "West Europe"
+}`,
+		},
+		{
+			name: "unknown argument",
+			example: `resource "azurerm_example" "test" {
+  name    = "example"
+  unknown = "foo"
+}`,
+			expected: []string{"unknown"},
+		},
+		{
+			name: "renamed argument",
+			example: `resource "azurerm_example" "test" {
+  name                          = "example"
+  enable_public_network_access = true
+}`,
+			expected: []string{"enable_public_network_access=>public_network_access_enabled"},
+		},
+		{
+			name: "misspelt argument",
+			example: `resource "azurerm_example" "test" {
+  nmae = "example"
+}`,
+			expected: []string{"nmae=>name"},
+		},
+		{
+			name: "deprecated argument",
+			example: `resource "azurerm_example" "test" {
+  name        = "example"
+  old_setting = "foo"
+}`,
+			expected: []string{"old_setting=>new_setting"},
+		},
+		{
+			name: "read only attribute",
+			example: `resource "azurerm_example" "test" {
+  name     = "example"
+  endpoint = "foo"
+}`,
+			expected: []string{"endpoint"},
+		},
+		{
+			name: "block used as argument",
+			example: `resource "azurerm_example" "test" {
+  name     = "example"
+  identity = {}
+}`,
+			expected: []string{"identity"},
+		},
+		{
+			name: "argument used as block",
+			example: `resource "azurerm_example" "test" {
+  name = "example"
+  sku {}
+}`,
+			expected: []string{"sku"},
+		},
+		{
+			name: "nested",
+			example: `resource "azurerm_example" "test" {
+  name = "example"
+
+  dynamic "identity" {
+    for_each = [1]
+    content {
+      type         = "None"
+      principal_id = "foo"
+    }
+  }
+}`,
+			expected: []string{"identity.principal_id", "identity.type"},
+		},
+		{
+			name: "invalid possible value",
+			example: `resource "azurerm_example" "test" {
+  name = "example"
+  sku  = "Premium"
+}`,
+			expected: []string{"sku"},
+		},
+		{
+			name: "interpolated values aren't validated",
+			example: `resource "azurerm_example" "test" {
+  name = "example"
+  sku  = "${var.prefix}Premium"
+}`,
+		},
+		{
+			name: "invalid hcl",
+			example: `resource "azurerm_example" "test" {
+  name = "example"
+`,
+			expected: []string{"azurerm_example"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := "# azurerm_example\n\n## Example Usage\n\n```hcl\n" + tt.example + "\n```\n"
+			var got []string
+			for _, item := range checkExamples(testExampleResource(), content) {
+				diff, ok := item.(exampleDiff)
+				if !ok {
+					t.Fatalf("expected an exampleDiff but got %T", item)
+				}
+				if diff.ShouldSkip() {
+					t.Fatalf("expected %q not to be skipped", diff.Key())
+				}
+				key := diff.Key()
+				if diff.To != "" {
+					key = diff.From + "=>" + diff.To
+				}
+				got = append(got, key)
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.expected, ",") {
+				t.Fatalf("expected %v but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestExampleDiffFix(t *testing.T) {
+	tests := []struct {
+		name     string
+		diff     exampleDiff
+		line     string
+		expected string
+	}{
+		{
+			name:     "rename argument",
+			diff:     newExampleRenameDiff(1, "enable_foo", "", "enable_foo", "foo_enabled"),
+			line:     "  enable_foo = true",
+			expected: "  foo_enabled = true",
+		},
+		{
+			name:     "rename dynamic block",
+			diff:     newExampleRenameDiff(1, "old_block", "", "old_block", "new_block"),
+			line:     `  dynamic "old_block" {`,
+			expected: `  dynamic "new_block" {`,
+		},
+		{
+			name:     "only whole identifiers are renamed",
+			diff:     newExampleRenameDiff(1, "name", "", "name", "display_name"),
+			line:     "  name_prefix = true",
+			expected: "  name_prefix = true",
+		},
+		{
+			name:     "no rename",
+			diff:     newExampleDiff(1, "unknown", ""),
+			line:     "  unknown = true",
+			expected: "  unknown = true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.diff.Fix(tt.line)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if got != tt.expected {
+				t.Fatalf("expected %q but got %q", tt.expected, got)
+			}
+		})
+	}
+}
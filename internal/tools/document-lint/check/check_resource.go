@@ -91,4 +91,9 @@ func (r *ResourceDiff) DiffAll() {
 
 	timeouts := diffTimeout(r.tf, r.md)
 	r.Diff = append(r.Diff, timeouts...)
+
+	if content, err := os.ReadFile(r.MDFile); err == nil {
+		examples := checkExamples(r.tf, string(content))
+		r.Diff = append(r.Diff, examples...)
+	}
 }
@@ -123,6 +123,12 @@ func (f *Fixer) TryFix() (err error) {
 			return err
 		}
 
+		// examples are HCL, so the line mustn't be formatted as a sentence
+		if _, ok := item.(exampleDiff); ok {
+			lines[lineIdx] = line
+			continue
+		}
+
 		if suf := strings.TrimSuffix(line, " "); suf != "" {
 			if ch := suf[len(suf)-1]; ch != '.' && ch != '?' {
 				line = suf + "."
@@ -95,7 +95,7 @@ func AzurermAllResources(service, skipService string, resources, skipResources s
 			}
 			res.resources = append(res.resources, resource{
 				name:   name,
-				schema: svc,
+				schema: provider.WithSubscriptionOverride(name, svc),
 			})
 		}
 	}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func main() {
+	subscriptionId := flag.String("subscription-id", os.Getenv("ARM_SUBSCRIPTION_ID"), "The ID of the Subscription to generate import blocks for - defaults to the `ARM_SUBSCRIPTION_ID` environment variable")
+	resourceGroupName := flag.String("resource-group", "", "The name of a Resource Group to limit the generated import blocks to")
+	resourceTypes := flag.String("resource-types", "", "A comma-separated list of Resource Types to generate import blocks for - defaults to all Resource Types which support listing")
+	outputPath := flag.String("output", "", "The path to the file the import blocks should be written to - defaults to stdout")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if *subscriptionId == "" {
+		log.Fatalf("a Subscription ID must be specified using either `-subscription-id` or the `ARM_SUBSCRIPTION_ID` environment variable")
+	}
+
+	if err := run(context.Background(), *subscriptionId, *resourceGroupName, *resourceTypes, *outputPath); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, subscriptionId, resourceGroupName, resourceTypes, outputPath string) error {
+	resources := listableResources(provider.SupportedTypedServices(), filterResourceTypes(resourceTypes))
+	if len(resources) == 0 {
+		return fmt.Errorf("no Resources supporting listing were found")
+	}
+
+	client, err := buildClient(ctx, subscriptionId)
+	if err != nil {
+		return err
+	}
+
+	scope := sdk.ListScope{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+	}
+
+	output := make([]resourceBlocks, 0)
+	for _, resource := range resources {
+		log.Printf("[DEBUG] Listing %q..", resource.ResourceType())
+
+		list := resource.List()
+		listCtx, cancel := context.WithTimeout(ctx, list.Timeout)
+		items, err := list.Func(listCtx, client, scope)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("listing %q: %+v", resource.ResourceType(), err)
+		}
+
+		log.Printf("[DEBUG] Found %d instances of %q", len(items), resource.ResourceType())
+		output = append(output, resourceBlocks{
+			ResourceType: resource.ResourceType(),
+			Arguments:    resource.Arguments(),
+			Items:        items,
+		})
+	}
+
+	contents := renderImportBlocks(output)
+	if outputPath == "" {
+		fmt.Print(contents)
+		return nil
+	}
+
+	if err := os.WriteFile(outputPath, []byte(contents), 0o644); err != nil {
+		return fmt.Errorf("writing %q: %+v", outputPath, err)
+	}
+
+	return nil
+}
+
+// buildClient configures the Provider using the `ARM_*` environment variables (as Terraform would)
+// and returns the Client used to list the existing resources
+func buildClient(ctx context.Context, subscriptionId string) (*clients.Client, error) {
+	p := provider.AzureProvider()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"features":        []interface{}{},
+		"subscription_id": subscriptionId,
+		// listing resources doesn't require any Resource Providers to be registered
+		"skip_provider_registration": true,
+	})
+
+	if diags := p.Configure(ctx, config); diags.HasError() {
+		errs := make([]string, 0)
+		for _, diag := range diags {
+			errs = append(errs, diag.Summary)
+		}
+		return nil, fmt.Errorf("configuring the Provider: %s", strings.Join(errs, ", "))
+	}
+
+	client, ok := p.Meta().(*clients.Client)
+	if !ok {
+		return nil, fmt.Errorf("configuring the Provider: expected a `*clients.Client` but got %T", p.Meta())
+	}
+
+	return client, nil
+}
+
+// listableResources returns the Resources which implement `sdk.ResourceWithList` sorted by their Resource Type
+func listableResources(services []sdk.TypedServiceRegistration, include map[string]struct{}) []sdk.ResourceWithList {
+	output := make([]sdk.ResourceWithList, 0)
+	for _, service := range services {
+		for _, resource := range service.Resources() {
+			v, ok := resource.(sdk.ResourceWithList)
+			if !ok {
+				continue
+			}
+
+			if len(include) > 0 {
+				if _, ok := include[v.ResourceType()]; !ok {
+					continue
+				}
+			}
+
+			output = append(output, v)
+		}
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].ResourceType() < output[j].ResourceType()
+	})
+
+	return output
+}
+
+func filterResourceTypes(input string) map[string]struct{} {
+	output := make(map[string]struct{})
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			output[v] = struct{}{}
+		}
+	}
+	return output
+}
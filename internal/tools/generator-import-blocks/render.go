@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type resourceBlocks struct {
+	ResourceType string
+	Arguments    map[string]*pluginsdk.Schema
+	Items        []sdk.ListedResource
+}
+
+var invalidLabelCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// renderImportBlocks renders an `import` block and a skeleton `resource` block for each of the listed resources
+func renderImportBlocks(input []resourceBlocks) string {
+	var sb strings.Builder
+
+	for _, block := range input {
+		items := make([]sdk.ListedResource, len(block.Items))
+		copy(items, block.Items)
+		sort.Slice(items, func(i, j int) bool {
+			return strings.ToLower(items[i].ID) < strings.ToLower(items[j].ID)
+		})
+
+		labels := make(map[string]struct{})
+		for _, item := range items {
+			label := uniqueLabel(resourceLabel(item.Name), labels)
+
+			if sb.Len() > 0 {
+				sb.WriteString("\n")
+			}
+
+			sb.WriteString("import {\n")
+			sb.WriteString(fmt.Sprintf("  to = %s.%s\n", block.ResourceType, label))
+			sb.WriteString(fmt.Sprintf("  id = %s\n", quoteString(item.ID)))
+			sb.WriteString("}\n\n")
+
+			sb.WriteString(fmt.Sprintf("resource %q %q {\n", block.ResourceType, label))
+			sb.WriteString(renderArguments(block.Arguments, item.Arguments))
+			sb.WriteString("}\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// renderArguments renders the known argument values, followed by a placeholder for each Required
+// argument which doesn't have a known value
+func renderArguments(schema map[string]*pluginsdk.Schema, values map[string]interface{}) string {
+	var sb strings.Builder
+
+	known := make([]string, 0)
+	for k := range values {
+		if _, ok := schema[k]; ok {
+			known = append(known, k)
+		}
+	}
+	sort.Strings(known)
+
+	// consecutive single-line attributes are aligned on the `=`, as `terraform fmt` would
+	pending := make([][2]string, 0)
+	flush := func() {
+		width := 0
+		for _, v := range pending {
+			if len(v[0]) > width {
+				width = len(v[0])
+			}
+		}
+		for _, v := range pending {
+			sb.WriteString(fmt.Sprintf("  %-*s = %s\n", width, v[0], v[1]))
+		}
+		pending = make([][2]string, 0)
+	}
+
+	for _, k := range known {
+		value := renderValue(values[k], "  ")
+		if strings.Contains(value, "\n") {
+			flush()
+			sb.WriteString(fmt.Sprintf("  %s = %s\n", k, value))
+			continue
+		}
+		pending = append(pending, [2]string{k, value})
+	}
+	flush()
+
+	missing := make([]string, 0)
+	for k, v := range schema {
+		if _, ok := values[k]; ok || !v.Required {
+			continue
+		}
+		missing = append(missing, k)
+	}
+	sort.Strings(missing)
+
+	if len(missing) > 0 && len(known) > 0 {
+		sb.WriteString("\n")
+	}
+	for _, k := range missing {
+		if _, isBlock := schema[k].Elem.(*pluginsdk.Resource); isBlock {
+			sb.WriteString(fmt.Sprintf("  # TODO: configure the required `%s` block\n", k))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("  # TODO: configure the required `%s` argument\n", k))
+	}
+
+	return sb.String()
+}
+
+func renderValue(input interface{}, indent string) string {
+	switch v := input.(type) {
+	case string:
+		return quoteString(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []string:
+		items := make([]interface{}, 0)
+		for _, item := range v {
+			items = append(items, item)
+		}
+		return renderValue(items, indent)
+	case []interface{}:
+		items := make([]string, 0)
+		for _, item := range v {
+			items = append(items, renderValue(item, indent))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(items, ", "))
+	case map[string]string:
+		items := make(map[string]interface{})
+		for key, item := range v {
+			items[key] = item
+		}
+		return renderValue(items, indent)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+
+		keys := make([]string, 0)
+		width := 0
+		for key := range v {
+			keys = append(keys, key)
+			if len(quoteKey(key)) > width {
+				width = len(quoteKey(key))
+			}
+		}
+		sort.Strings(keys)
+
+		var sb strings.Builder
+		sb.WriteString("{\n")
+		for _, key := range keys {
+			sb.WriteString(fmt.Sprintf("%s  %-*s = %s\n", indent, width, quoteKey(key), renderValue(v[key], indent+"  ")))
+		}
+		sb.WriteString(fmt.Sprintf("%s}", indent))
+		return sb.String()
+	}
+
+	return quoteString(fmt.Sprintf("%v", input))
+}
+
+// quoteString returns a quoted HCL string, escaping any template sequences
+func quoteString(input string) string {
+	output := strconv.Quote(input)
+	output = strings.ReplaceAll(output, "${", "$${")
+	output = strings.ReplaceAll(output, "%{", "%%{")
+	return output
+}
+
+var identifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+func quoteKey(input string) string {
+	if identifier.MatchString(input) {
+		return input
+	}
+	return quoteString(input)
+}
+
+// resourceLabel returns a valid Terraform resource label for the name of a resource
+func resourceLabel(name string) string {
+	label := invalidLabelCharacters.ReplaceAllString(strings.ToLower(name), "_")
+	label = strings.Trim(label, "_")
+	if label == "" {
+		return "resource"
+	}
+
+	if c := label[0]; c < 'a' || c > 'z' {
+		label = "r_" + label
+	}
+
+	return label
+}
+
+func uniqueLabel(label string, existing map[string]struct{}) string {
+	output := label
+	for i := 2; ; i++ {
+		if _, ok := existing[output]; !ok {
+			break
+		}
+		output = fmt.Sprintf("%s_%d", label, i)
+	}
+	existing[output] = struct{}{}
+	return output
+}
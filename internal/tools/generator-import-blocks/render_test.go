@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestResourceLabel(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "example",
+			Expected: "example",
+		},
+		{
+			Input:    "Example-Identity",
+			Expected: "example-identity",
+		},
+		{
+			Input:    "my.identity (prod)",
+			Expected: "my_identity_prod",
+		},
+		{
+			Input:    "1example",
+			Expected: "r_1example",
+		},
+		{
+			Input:    "...",
+			Expected: "resource",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := resourceLabel(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestRenderImportBlocks(t *testing.T) {
+	schema := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"identity": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{},
+			},
+		},
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+
+	input := []resourceBlocks{
+		{
+			ResourceType: "azurerm_example",
+			Arguments:    schema,
+			Items: []sdk.ListedResource{
+				{
+					ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg2/providers/Microsoft.Example/examples/Example",
+					Name: "Example",
+					Arguments: map[string]interface{}{
+						"name":                "Example",
+						"resource_group_name": "rg2",
+					},
+				},
+				{
+					ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Example/examples/example",
+					Name: "example",
+					Arguments: map[string]interface{}{
+						"name":                "example",
+						"resource_group_name": "rg1",
+						"unknown":             "ignored",
+						"tags": map[string]string{
+							"environment": "${var.env}",
+							"cost-centre": "1234",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := `import {
+  to = azurerm_example.example
+  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Example/examples/example"
+}
+
+resource "azurerm_example" "example" {
+  name                = "example"
+  resource_group_name = "rg1"
+  tags = {
+    cost-centre = "1234"
+    environment = "$${var.env}"
+  }
+
+  # TODO: configure the required ` + "`identity`" + ` block
+  # TODO: configure the required ` + "`sku_name`" + ` argument
+}
+
+import {
+  to = azurerm_example.example_2
+  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg2/providers/Microsoft.Example/examples/Example"
+}
+
+resource "azurerm_example" "example_2" {
+  name                = "Example"
+  resource_group_name = "rg2"
+
+  # TODO: configure the required ` + "`identity`" + ` block
+  # TODO: configure the required ` + "`sku_name`" + ` argument
+}
+`
+
+	if actual := renderImportBlocks(input); actual != expected {
+		t.Fatalf("expected:\n%s\n\nbut got:\n%s", expected, actual)
+	}
+}
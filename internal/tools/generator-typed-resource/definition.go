@@ -0,0 +1,379 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// resourceDefinition is the intermediate representation of the Typed Resource which is being generated, built from
+// the go-azure-sdk package
+type resourceDefinition struct {
+	// Name is the name of the Resource without the `azurerm_` prefix, for example `dev_center_project`
+	Name string
+
+	// BrandName is the friendly name of the Resource, for example `Dev Center Project`
+	BrandName string
+
+	// ServicePackage is the name of the Service Package within the Provider, for example `devcenter`
+	ServicePackage string
+
+	// ServiceName is the name of the Service Client within the Provider, for example `DevCenter`
+	ServiceName string
+
+	// WebsiteCategory is the category the Resource is shown in within the website, for example `Dev Center`
+	WebsiteCategory string
+
+	SDK *sdkPackage
+
+	// Model is the name of the SDK model used to create and retrieve the Resource, for example `Project`
+	Model string
+
+	// PropertiesModel is the name of the SDK model used for the `properties` of the Resource, if any
+	PropertiesModel string
+
+	// CreateMethod, UpdateMethod and DeleteMethod are the names of the SDK methods used for these operations
+	CreateMethod string
+	UpdateMethod string
+	DeleteMethod string
+
+	// UpdateModel and UpdatePropertiesModel are the SDK models used when updating the Resource via a separate
+	// (PATCH) method - when empty the Resource is updated using the CreateMethod
+	UpdateModel           string
+	UpdatePropertiesModel string
+
+	// GetOptions and DeleteOptions are the options types used by these methods, if any
+	GetOptions    string
+	DeleteOptions string
+
+	// ParentID is the name of the SDK Resource ID type for the parent of this Resource, if any
+	ParentID string
+
+	// LocationSDKType and TagsSDKType are the types of the `Location` and `Tags` fields within the SDK model, which
+	// are empty when the model doesn't contain these fields
+	LocationSDKType string
+	TagsSDKType     string
+
+	// IDArguments are the arguments used to build the Resource ID, excluding the Subscription ID
+	IDArguments []idArgument
+
+	Properties []property
+
+	// Unsupported are the SDK fields which couldn't be mapped to the schema and need to be implemented by hand
+	Unsupported []string
+}
+
+type idArgument struct {
+	// SchemaName is the name of the argument within the schema, for example `resource_group_name`
+	SchemaName string
+
+	// ModelField is the name of the field within the Typed Model, for example `ResourceGroupName`
+	ModelField string
+
+	// IDField is the name of the field within the SDK Resource ID, for example `ResourceGroupName`
+	IDField string
+
+	// FromParent is true when this argument is the ID of the parent Resource (for example `dev_center_id`)
+	FromParent bool
+}
+
+type property struct {
+	SchemaName string
+	ModelField string
+
+	// ModelType is the Go type used in the Typed Model, for example `string` or `[]string`
+	ModelType string
+
+	// SchemaType is the pluginsdk type, for example `pluginsdk.TypeString`
+	SchemaType string
+
+	// ElemType is the pluginsdk type of the elements for lists and maps
+	ElemType string
+
+	Required bool
+	ForceNew bool
+
+	// PossibleValues are the possible values for constants, EnumType is the name of the SDK constant type
+	PossibleValues []string
+	EnumType       string
+
+	// SDKField is the name of the field within the SDK model, SDKType is its type within the create model and
+	// UpdateSDKType is its type within the update model (when updated via a separate method)
+	SDKField      string
+	SDKType       string
+	UpdateSDKType string
+
+	// InProperties is true when the field is within the `properties` of the SDK model
+	InProperties bool
+}
+
+// ignoredSdkFields are the fields within SDK models which are either exposed in another way (for example via the
+// Resource ID) or not user configurable
+var ignoredSdkFields = map[string]struct{}{
+	"Etag":              {},
+	"Id":                {},
+	"Name":              {},
+	"ProvisioningState": {},
+	"SystemData":        {},
+	"Type":              {},
+}
+
+func buildResourceDefinition(pkg *sdkPackage, name, brandName, servicePackage, serviceName, websiteCategory string) (*resourceDefinition, error) {
+	def := resourceDefinition{
+		Name:            name,
+		BrandName:       brandName,
+		ServicePackage:  servicePackage,
+		ServiceName:     serviceName,
+		WebsiteCategory: websiteCategory,
+		SDK:             pkg,
+	}
+
+	def.CreateMethod = firstMethod(pkg, "CreateOrUpdateThenPoll", "CreateThenPoll", "CreateOrUpdate", "Create", "PutThenPoll", "Put")
+	if def.CreateMethod == "" {
+		return nil, fmt.Errorf("the Client %q doesn't have a method to create the Resource", pkg.ClientTypeName)
+	}
+	def.Model = strings.TrimPrefix(pkg.Methods[def.CreateMethod], "*")
+	if _, ok := pkg.Models[def.Model]; !ok {
+		return nil, fmt.Errorf("the model %q used by %q was not found", def.Model, def.CreateMethod)
+	}
+
+	def.DeleteMethod = firstMethod(pkg, "DeleteThenPoll", "Delete")
+	if def.DeleteMethod == "" {
+		return nil, fmt.Errorf("the Client %q doesn't have a method to delete the Resource", pkg.ClientTypeName)
+	}
+	def.DeleteOptions = operationOptions(pkg.Methods[def.DeleteMethod])
+	def.GetOptions = operationOptions(pkg.Methods["Get"])
+
+	def.UpdateMethod = def.CreateMethod
+	if method := firstMethod(pkg, "UpdateThenPoll", "Update", "PatchThenPoll", "Patch"); method != "" {
+		if payload := strings.TrimPrefix(pkg.Methods[method], "*"); payload != def.Model {
+			if _, ok := pkg.Models[payload]; ok {
+				def.UpdateMethod = method
+				def.UpdateModel = payload
+			}
+		}
+	}
+
+	def.ParentID = pkg.ParentIDTypeName()
+	def.IDArguments = idArguments(pkg.ID.Fields, def.ParentID)
+
+	updatable := map[string]string{}
+	if def.UpdateModel != "" {
+		for _, field := range pkg.Models[def.UpdateModel] {
+			if field.Name == "Properties" {
+				def.UpdatePropertiesModel = strings.TrimPrefix(field.Type, "*")
+				for _, f := range pkg.Models[def.UpdatePropertiesModel] {
+					updatable["properties."+f.Name] = f.Type
+				}
+				continue
+			}
+			updatable[field.Name] = field.Type
+		}
+	}
+
+	for _, field := range pkg.Models[def.Model] {
+		switch field.Name {
+		case "Location":
+			def.LocationSDKType = field.Type
+			continue
+		case "Tags":
+			def.TagsSDKType = field.Type
+			continue
+		case "Properties":
+			def.PropertiesModel = strings.TrimPrefix(field.Type, "*")
+			for _, f := range pkg.Models[def.PropertiesModel] {
+				def.addProperty(f, true, updatable)
+			}
+			continue
+		}
+		def.addProperty(field, false, updatable)
+	}
+
+	sort.Slice(def.Properties, func(i, j int) bool {
+		if def.Properties[i].Required != def.Properties[j].Required {
+			return def.Properties[i].Required
+		}
+		return def.Properties[i].SchemaName < def.Properties[j].SchemaName
+	})
+	sort.Strings(def.Unsupported)
+
+	return &def, nil
+}
+
+func (def *resourceDefinition) addProperty(field sdkField, inProperties bool, updatable map[string]string) {
+	if _, ok := ignoredSdkFields[field.Name]; ok {
+		return
+	}
+
+	path := field.Name
+	if inProperties {
+		path = "properties." + field.Name
+	}
+
+	prop := property{
+		SchemaName:   schemaNameForField(field.Name, field.Type),
+		SDKField:     field.Name,
+		SDKType:      field.Type,
+		Required:     !field.Optional && !strings.HasPrefix(field.Type, "*"),
+		InProperties: inProperties,
+	}
+	prop.ModelField = modelFieldForSchemaName(prop.SchemaName)
+
+	underlying := strings.TrimPrefix(field.Type, "*")
+	switch underlying {
+	case "string":
+		prop.ModelType, prop.SchemaType = "string", "pluginsdk.TypeString"
+	case "int64":
+		prop.ModelType, prop.SchemaType = "int64", "pluginsdk.TypeInt"
+	case "bool":
+		prop.ModelType, prop.SchemaType = "bool", "pluginsdk.TypeBool"
+	case "float64":
+		prop.ModelType, prop.SchemaType = "float64", "pluginsdk.TypeFloat"
+	case "[]string":
+		prop.ModelType, prop.SchemaType, prop.ElemType = "[]string", "pluginsdk.TypeList", "pluginsdk.TypeString"
+	case "map[string]string":
+		prop.ModelType, prop.SchemaType, prop.ElemType = "map[string]string", "pluginsdk.TypeMap", "pluginsdk.TypeString"
+	default:
+		values, ok := def.SDK.Constants[underlying]
+		if !ok {
+			def.Unsupported = append(def.Unsupported, fmt.Sprintf("%s (%s)", path, field.Type))
+			return
+		}
+		prop.ModelType, prop.SchemaType = "string", "pluginsdk.TypeString"
+		prop.EnumType = underlying
+		prop.PossibleValues = values
+	}
+
+	if def.UpdateModel != "" {
+		updateType, ok := updatable[path]
+		if !ok {
+			prop.ForceNew = true
+		}
+		prop.UpdateSDKType = updateType
+	}
+
+	def.Properties = append(def.Properties, prop)
+}
+
+func firstMethod(pkg *sdkPackage, names ...string) string {
+	for _, name := range names {
+		if _, ok := pkg.Methods[name]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+func operationOptions(payload string) string {
+	if strings.HasSuffix(payload, "OperationOptions") {
+		return payload
+	}
+	return ""
+}
+
+// idArguments returns the schema arguments used to build the Resource ID - which is either the ID of the parent
+// Resource (when the parent Resource ID is available in the same package) or the name of each segment
+func idArguments(fields []string, parentID string) []idArgument {
+	out := make([]idArgument, 0)
+	if len(fields) == 0 {
+		return out
+	}
+
+	nameField := fields[len(fields)-1]
+	parentFields := fields[:len(fields)-1]
+
+	switch {
+	case parentID != "" && !(len(parentFields) == 2 && parentFields[1] == "ResourceGroupName"):
+		schemaName := schemaNameForField(parentID, "string")
+		out = append(out, idArgument{
+			SchemaName: schemaName,
+			ModelField: modelFieldForSchemaName(schemaName),
+			FromParent: true,
+		})
+
+	default:
+		for _, field := range parentFields {
+			if field == "SubscriptionId" {
+				continue
+			}
+			schemaName := schemaNameForField(field, "string")
+			out = append(out, idArgument{
+				SchemaName: schemaName,
+				ModelField: modelFieldForSchemaName(schemaName),
+				IDField:    field,
+			})
+		}
+	}
+
+	out = append([]idArgument{{
+		SchemaName: "name",
+		ModelField: "Name",
+		IDField:    nameField,
+	}}, out...)
+	return out
+}
+
+var (
+	enablePrefix   = regexp.MustCompile(`^enable_(.+)$`)
+	isEnabledRegex = regexp.MustCompile(`^is_(.+)_enabled$`)
+)
+
+// schemaNameForField returns the name used within the schema for a field within an SDK model, following the
+// conventions used within the Provider (for example `EnableFoo` becomes `foo_enabled`)
+func schemaNameForField(name, fieldType string) string {
+	out := toSnakeCase(name)
+
+	if strings.TrimPrefix(fieldType, "*") == "bool" {
+		if match := enablePrefix.FindStringSubmatch(out); match != nil {
+			return match[1] + "_enabled"
+		}
+		if match := isEnabledRegex.FindStringSubmatch(out); match != nil {
+			return match[1] + "_enabled"
+		}
+	}
+
+	if strings.HasPrefix(out, "max_") {
+		out = "maximum_" + strings.TrimPrefix(out, "max_")
+	}
+	return out
+}
+
+// toSnakeCase converts a Go identifier into snake_case, keeping acronyms together (for example `VMSizeId` becomes
+// `vm_size_id`)
+func toSnakeCase(input string) string {
+	runes := []rune(input)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				sb.WriteRune('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+// modelFieldForSchemaName returns the name of the field within the Typed Model for a schema argument
+func modelFieldForSchemaName(input string) string {
+	var sb strings.Builder
+	for _, segment := range strings.Split(input, "_") {
+		if segment == "" {
+			continue
+		}
+		switch segment {
+		case "id":
+			sb.WriteString("Id")
+		default:
+			sb.WriteString(strings.ToUpper(segment[:1]) + segment[1:])
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToSnakeCase(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "Name",
+			Expected: "name",
+		},
+		{
+			Input:    "DevCenterId",
+			Expected: "dev_center_id",
+		},
+		{
+			Input:    "VMSizeId",
+			Expected: "vm_size_id",
+		},
+		{
+			Input:    "Ipv4Address",
+			Expected: "ipv4_address",
+		},
+		{
+			Input:    "MaxDevBoxesPerUser",
+			Expected: "max_dev_boxes_per_user",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := toSnakeCase(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestSchemaNameForField(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Type     string
+		Expected string
+	}{
+		{
+			Input:    "Description",
+			Type:     "*string",
+			Expected: "description",
+		},
+		{
+			Input:    "EnableAutoScale",
+			Type:     "*bool",
+			Expected: "auto_scale_enabled",
+		},
+		{
+			Input:    "EnableAutoScale",
+			Type:     "*string",
+			Expected: "enable_auto_scale",
+		},
+		{
+			Input:    "IsPublicAccessEnabled",
+			Type:     "bool",
+			Expected: "public_access_enabled",
+		},
+		{
+			Input:    "MaxDevBoxesPerUser",
+			Type:     "*int64",
+			Expected: "maximum_dev_boxes_per_user",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q (%s)", v.Input, v.Type)
+
+		if actual := schemaNameForField(v.Input, v.Type); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestModelFieldForSchemaName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "name",
+			Expected: "Name",
+		},
+		{
+			Input:    "dev_center_id",
+			Expected: "DevCenterId",
+		},
+		{
+			Input:    "auto_scale_enabled",
+			Expected: "AutoScaleEnabled",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := modelFieldForSchemaName(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestVersionFieldName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "2023-04-01",
+			Expected: "V20230401",
+		},
+		{
+			Input:    "2022-09-02-preview",
+			Expected: "V20220902Preview",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := versionFieldName(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestIDArguments(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Fields   []string
+		ParentID string
+		Expected []idArgument
+	}{
+		{
+			Name:   "Resource Group scoped",
+			Fields: []string{"SubscriptionId", "ResourceGroupName", "ProjectName"},
+			Expected: []idArgument{
+				{SchemaName: "name", ModelField: "Name", IDField: "ProjectName"},
+				{SchemaName: "resource_group_name", ModelField: "ResourceGroupName", IDField: "ResourceGroupName"},
+			},
+		},
+		{
+			// the Resource Group isn't used as a parent Resource, since `resource_group_name` is used instead
+			Name:     "Resource Group scoped with a Resource Group ID",
+			Fields:   []string{"SubscriptionId", "ResourceGroupName", "ProjectName"},
+			ParentID: "ResourceGroupId",
+			Expected: []idArgument{
+				{SchemaName: "name", ModelField: "Name", IDField: "ProjectName"},
+				{SchemaName: "resource_group_name", ModelField: "ResourceGroupName", IDField: "ResourceGroupName"},
+			},
+		},
+		{
+			Name:     "Child Resource",
+			Fields:   []string{"SubscriptionId", "ResourceGroupName", "DevCenterName", "GalleryName"},
+			ParentID: "DevCenterId",
+			Expected: []idArgument{
+				{SchemaName: "name", ModelField: "Name", IDField: "GalleryName"},
+				{SchemaName: "dev_center_id", ModelField: "DevCenterId", FromParent: true},
+			},
+		},
+		{
+			Name:   "Child Resource without a parent Resource ID",
+			Fields: []string{"SubscriptionId", "ResourceGroupName", "DevCenterName", "GalleryName"},
+			Expected: []idArgument{
+				{SchemaName: "name", ModelField: "Name", IDField: "GalleryName"},
+				{SchemaName: "resource_group_name", ModelField: "ResourceGroupName", IDField: "ResourceGroupName"},
+				{SchemaName: "dev_center_name", ModelField: "DevCenterName", IDField: "DevCenterName"},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := idArguments(v.Fields, v.ParentID)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
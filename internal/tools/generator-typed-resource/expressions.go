@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+var basicTypes = map[string]struct{}{
+	"bool":              {},
+	"float64":           {},
+	"int64":             {},
+	"map[string]string": {},
+	"string":            {},
+	"[]string":          {},
+}
+
+// expandExpression returns the Go expression which converts `value` (from the Typed Model) into the SDK type `sdkType`
+func expandExpression(sdkPackageName, sdkType, value string) string {
+	underlying := strings.TrimPrefix(sdkType, "*")
+	if _, ok := basicTypes[underlying]; !ok {
+		// constants
+		value = fmt.Sprintf("%s.%s(%s)", sdkPackageName, underlying, value)
+	}
+
+	if strings.HasPrefix(sdkType, "*") {
+		return fmt.Sprintf("pointer.To(%s)", value)
+	}
+	return value
+}
+
+// flattenExpression returns the Go expression which converts `value` (of the SDK type `sdkType`) into the type used
+// in the Typed Model
+func flattenExpression(sdkType, value string) string {
+	underlying := strings.TrimPrefix(sdkType, "*")
+	if strings.HasPrefix(sdkType, "*") {
+		value = fmt.Sprintf("pointer.From(%s)", value)
+	}
+
+	if _, ok := basicTypes[underlying]; !ok {
+		// constants
+		return fmt.Sprintf("string(%s)", value)
+	}
+	return value
+}
+
+// hclExampleValue returns an example value for the property, for use in the acceptance tests and documentation
+func hclExampleValue(prop property) string {
+	if len(prop.PossibleValues) > 0 {
+		return fmt.Sprintf("%q", prop.PossibleValues[0])
+	}
+
+	switch prop.ModelType {
+	case "bool":
+		return "true"
+	case "float64":
+		return "1.0"
+	case "int64":
+		return "1"
+	case "[]string":
+		return `["example"]`
+	case "map[string]string":
+		return `{ example = "value" }`
+	}
+	return `"example"`
+}
+
+// hclAttributes renders the attributes aligned in the same way as `terraform fmt`, using the specified indentation
+func hclAttributes(indent string, attributes [][2]string) string {
+	width := 0
+	for _, v := range attributes {
+		if len(v[0]) > width {
+			width = len(v[0])
+		}
+	}
+
+	lines := make([]string, 0)
+	for _, v := range attributes {
+		lines = append(lines, fmt.Sprintf("%s%-*s = %s", indent, width, v[0], v[1]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// humanize converts a schema name into a friendly name, for example `maximum_dev_boxes_per_user` becomes
+// `Maximum Dev Boxes Per User`
+func humanize(input string) string {
+	segments := strings.Split(input, "_")
+	for i, v := range segments {
+		switch v {
+		case "":
+		case "id":
+			segments[i] = "ID"
+		default:
+			segments[i] = strings.ToUpper(v[:1]) + v[1:]
+		}
+	}
+	return strings.Join(segments, " ")
+}
+
+// possibleValuesSentence returns the sentence used in the documentation describing the possible values
+func possibleValuesSentence(values []string) string {
+	quoted := make([]string, 0)
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("`%s`", v))
+	}
+
+	switch len(quoted) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf(" The only possible value is %s.", quoted[0])
+	}
+	return fmt.Sprintf(" Possible values are %s and %s.", strings.Join(quoted[:len(quoted)-1], ", "), quoted[len(quoted)-1])
+}
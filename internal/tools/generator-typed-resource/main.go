@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+func main() {
+	sdkService := flag.String("sdk-service", "", "The name of the Service within the go-azure-sdk, for example `devcenter`")
+	apiVersion := flag.String("api-version", "", "The API Version within the go-azure-sdk, for example `2023-04-01`")
+	sdkPackageName := flag.String("sdk-package", "", "The name of the package within the API Version, for example `projects`")
+	resourceName := flag.String("name", "", "The name of the Resource without the `azurerm_` prefix, for example `dev_center_project`")
+	brandName := flag.String("brand-name", "", "The friendly/brand name of the Resource, for example `Dev Center Project`")
+	servicePackage := flag.String("service-package", "", "The name of the Service Package within the Provider - defaults to the value of `-sdk-service`")
+	serviceName := flag.String("service-name", "", "The name of the Service Client within the Provider, for example `DevCenter`")
+	websiteCategory := flag.String("website-category", "", "The Website Category for the Resource - defaults to the existing category for the Service")
+	rootDirectory := flag.String("root-dir", ".", "The path to the root of the repository")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	input := generatorInput{
+		SDKService:      *sdkService,
+		APIVersion:      *apiVersion,
+		SDKPackage:      *sdkPackageName,
+		Name:            strings.TrimPrefix(*resourceName, "azurerm_"),
+		BrandName:       *brandName,
+		ServicePackage:  *servicePackage,
+		ServiceName:     *serviceName,
+		WebsiteCategory: *websiteCategory,
+		RootDirectory:   *rootDirectory,
+	}
+	if input.ServicePackage == "" {
+		input.ServicePackage = input.SDKService
+	}
+
+	if err := input.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := run(input); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generatorInput struct {
+	SDKService      string
+	APIVersion      string
+	SDKPackage      string
+	Name            string
+	BrandName       string
+	ServicePackage  string
+	ServiceName     string
+	WebsiteCategory string
+	RootDirectory   string
+}
+
+var resourceNameRegex = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+func (i generatorInput) validate() error {
+	required := map[string]string{
+		"sdk-service":  i.SDKService,
+		"api-version":  i.APIVersion,
+		"sdk-package":  i.SDKPackage,
+		"name":         i.Name,
+		"brand-name":   i.BrandName,
+		"service-name": i.ServiceName,
+	}
+	for k, v := range required {
+		if v == "" {
+			return fmt.Errorf("`-%s` must be specified", k)
+		}
+	}
+
+	if !resourceNameRegex.MatchString(i.Name) {
+		return fmt.Errorf("`-name` must only contain lower-case letters, numbers and underscores but got %q", i.Name)
+	}
+
+	return nil
+}
+
+func (i generatorInput) servicePath(parts ...string) string {
+	return filepath.Join(append([]string{i.RootDirectory, "internal", "services", i.ServicePackage}, parts...)...)
+}
+
+func run(input generatorInput) error {
+	pkg, err := loadSdkPackage(filepath.Join(input.RootDirectory, "vendor"), input.SDKService, input.APIVersion, input.SDKPackage)
+	if err != nil {
+		return err
+	}
+
+	websiteCategories := websiteCategoriesFromFile(readFileIfExists(input.servicePath("registration_gen.go")))
+	if input.WebsiteCategory == "" && len(websiteCategories) > 0 {
+		input.WebsiteCategory = websiteCategories[0]
+	}
+	if input.WebsiteCategory == "" {
+		return fmt.Errorf("`-website-category` must be specified for a Service without existing generated Resources")
+	}
+
+	def, err := buildResourceDefinition(pkg, input.Name, input.BrandName, input.ServicePackage, input.ServiceName, input.WebsiteCategory)
+	if err != nil {
+		return err
+	}
+
+	resource, err := renderResource(def)
+	if err != nil {
+		return err
+	}
+	if err := writeFile(input.servicePath(fmt.Sprintf("%s_resource_gen.go", input.Name)), resource); err != nil {
+		return err
+	}
+
+	acceptanceTest, err := renderAcceptanceTest(def)
+	if err != nil {
+		return err
+	}
+	if err := writeFile(input.servicePath(fmt.Sprintf("%s_resource_gen_test.go", input.Name)), acceptanceTest); err != nil {
+		return err
+	}
+
+	docs, err := renderDocs(def)
+	if err != nil {
+		return err
+	}
+	if err := writeFile(filepath.Join(input.RootDirectory, "website", "docs", "r", fmt.Sprintf("%s.html.markdown", input.Name)), docs); err != nil {
+		return err
+	}
+
+	if err := writeServiceClient(input, pkg); err != nil {
+		return err
+	}
+
+	if err := writeRegistration(input, websiteCategories); err != nil {
+		return err
+	}
+
+	if err := writeAutoClients(input); err != nil {
+		return err
+	}
+
+	if err := writeAutoRegisteredServices(input); err != nil {
+		return err
+	}
+
+	if len(def.Unsupported) > 0 {
+		log.Printf("[WARN] The following fields aren't supported by the generator and need to be implemented by hand:")
+		for _, v := range def.Unsupported {
+			log.Printf("[WARN] * %s", v)
+		}
+	}
+	log.Printf("[INFO] Read-only fields within the SDK models are generated as Optional arguments - these should be moved to the Attributes by hand")
+
+	return nil
+}
+
+func writeServiceClient(input generatorInput, pkg *sdkPackage) error {
+	path := input.servicePath("client", "client_gen.go")
+	versions := append(serviceClientVersionsFromFile(readFileIfExists(path)), serviceClientVersion{
+		Service:    pkg.Service,
+		APIVersion: pkg.APIVersion,
+	})
+	contents, err := renderServiceClient(versions)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, contents)
+}
+
+func writeRegistration(input generatorInput, websiteCategories []string) error {
+	files := map[string]string{}
+	entries, err := os.ReadDir(input.servicePath())
+	if err != nil {
+		return fmt.Errorf("reading %q: %+v", input.servicePath(), err)
+	}
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), "_gen.go") {
+			files[entry.Name()] = readFileIfExists(input.servicePath(entry.Name()))
+		}
+	}
+
+	if len(websiteCategories) == 0 {
+		websiteCategories = []string{input.WebsiteCategory}
+	}
+
+	reg := registration{
+		ServicePackage:    input.ServicePackage,
+		Name:              input.ServiceName,
+		WebsiteCategories: websiteCategories,
+	}
+	reg.Resources, reg.DataSources = typesFromGeneratedFiles(files)
+
+	contents, err := renderAutoRegistration(reg)
+	if err != nil {
+		return err
+	}
+	if err := writeFile(input.servicePath("registration_gen.go"), contents); err != nil {
+		return err
+	}
+
+	existing := readFileIfExists(input.servicePath("registration.go"))
+	switch {
+	case existing == "":
+		contents, err := renderRegistration(reg)
+		if err != nil {
+			return err
+		}
+		return writeFile(input.servicePath("registration.go"), contents)
+
+	case !strings.Contains(existing, "autoRegistration"):
+		log.Printf("[WARN] The existing Registration for %q needs to embed `autoRegistration` to register the generated Resources", input.ServicePackage)
+	}
+
+	return nil
+}
+
+func writeAutoClients(input generatorInput) error {
+	path := filepath.Join(input.RootDirectory, "internal", "clients", "client_gen.go")
+	existing := autoClientEntriesFromFile(readFileIfExists(path))
+	for _, v := range existing {
+		if v.ServicePackage == input.ServicePackage {
+			return nil
+		}
+	}
+
+	clientFieldRegex := regexp.MustCompile(fmt.Sprintf(`(?m)^\s*%s\s+\*`, regexp.QuoteMeta(input.ServiceName)))
+	if clientFieldRegex.MatchString(readFileIfExists(filepath.Join(input.RootDirectory, "internal", "clients", "client.go"))) {
+		return fmt.Errorf("the Client already contains a field named %q - specify a different name using `-service-name`", input.ServiceName)
+	}
+
+	contents, err := renderAutoClients(append(existing, autoClientEntry{
+		Field:          input.ServiceName,
+		ServicePackage: input.ServicePackage,
+	}))
+	if err != nil {
+		return err
+	}
+	return writeFile(path, contents)
+}
+
+func writeAutoRegisteredServices(input generatorInput) error {
+	path := filepath.Join(input.RootDirectory, "internal", "provider", "services_gen.go")
+	existing := autoRegisteredServicesFromFile(readFileIfExists(path))
+	manuallyRegistered := autoRegisteredServicesFromFile(readFileIfExists(filepath.Join(input.RootDirectory, "internal", "provider", "services.go")))
+	for _, v := range append(manuallyRegistered, existing...) {
+		if v == input.ServicePackage {
+			return nil
+		}
+	}
+
+	contents, err := renderAutoRegisteredServices(append(existing, input.ServicePackage))
+	if err != nil {
+		return err
+	}
+	return writeFile(path, contents)
+}
+
+func readFileIfExists(path string) string {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return string(contents)
+}
+
+func writeFile(path, contents string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating the directory for %q: %+v", path, err)
+	}
+
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		return fmt.Errorf("writing %q: %+v", path, err)
+	}
+
+	log.Printf("[DEBUG] Wrote %q", path)
+	return nil
+}
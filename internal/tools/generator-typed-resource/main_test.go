@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// sdkFixture is a minimal go-azure-sdk package containing a Resource Group scoped Resource with an Update method
+var sdkFixture = map[string]string{
+	"client.go": `package v2023_01_01
+
+import "github.com/hashicorp/go-azure-sdk/resource-manager/widgets/2023-01-01/widgets"
+
+type Client struct {
+	Widgets *widgets.WidgetsClient
+}
+`,
+	"widgets/client.go": `package widgets
+
+import "github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+
+type WidgetsClient struct {
+	Client *resourcemanager.Client
+}
+`,
+	"widgets/constants.go": `package widgets
+
+type SkuName string
+
+const (
+	SkuNameBasic    SkuName = "Basic"
+	SkuNameStandard SkuName = "Standard"
+)
+`,
+	"widgets/id_widget.go": `package widgets
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WidgetId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WidgetName        string
+}
+
+func (id WidgetId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Widgets/widgets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WidgetName)
+}
+
+func (id WidgetId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticWidgets", "widgets", "widgets"),
+		resourceids.UserSpecifiedSegment("widgetName", "widgetValue"),
+	}
+}
+`,
+	"widgets/models.go": `package widgets
+
+type Widget struct {
+	Id         *string           ` + "`json:\"id,omitempty\"`" + `
+	Location   string            ` + "`json:\"location\"`" + `
+	Name       *string           ` + "`json:\"name,omitempty\"`" + `
+	Properties *WidgetProperties ` + "`json:\"properties,omitempty\"`" + `
+	Tags       *map[string]string ` + "`json:\"tags,omitempty\"`" + `
+}
+
+type WidgetProperties struct {
+	Description       *string     ` + "`json:\"description,omitempty\"`" + `
+	EnableEncryption  *bool       ` + "`json:\"enableEncryption,omitempty\"`" + `
+	ProvisioningState *string     ` + "`json:\"provisioningState,omitempty\"`" + `
+	Sku               SkuName     ` + "`json:\"sku\"`" + `
+	Network           *NetworkAcl ` + "`json:\"network,omitempty\"`" + `
+}
+
+type NetworkAcl struct {
+	DefaultAction *string ` + "`json:\"defaultAction,omitempty\"`" + `
+}
+
+type WidgetUpdate struct {
+	Properties *WidgetUpdateProperties ` + "`json:\"properties,omitempty\"`" + `
+	Tags       *map[string]string      ` + "`json:\"tags,omitempty\"`" + `
+}
+
+type WidgetUpdateProperties struct {
+	Description *string ` + "`json:\"description,omitempty\"`" + `
+}
+`,
+	"widgets/methods.go": `package widgets
+
+import "context"
+
+func (c WidgetsClient) CreateOrUpdateThenPoll(ctx context.Context, id WidgetId, input Widget) error {
+	return nil
+}
+
+func (c WidgetsClient) DeleteThenPoll(ctx context.Context, id WidgetId) error {
+	return nil
+}
+
+func (c WidgetsClient) Get(ctx context.Context, id WidgetId) (result GetOperationResponse, err error) {
+	return
+}
+
+func (c WidgetsClient) UpdateThenPoll(ctx context.Context, id WidgetId, input WidgetUpdate) error {
+	return nil
+}
+`,
+}
+
+func writeSdkFixture(t *testing.T) string {
+	rootDirectory := t.TempDir()
+	versionPath := filepath.Join(rootDirectory, "vendor", filepath.FromSlash(sdkImportPathPrefix), "widgets", "2023-01-01")
+	for name, contents := range sdkFixture {
+		path := filepath.Join(versionPath, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("creating %q: %+v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatalf("writing %q: %+v", path, err)
+		}
+	}
+	return rootDirectory
+}
+
+func TestLoadSdkPackage(t *testing.T) {
+	rootDirectory := writeSdkFixture(t)
+
+	pkg, err := loadSdkPackage(filepath.Join(rootDirectory, "vendor"), "widgets", "2023-01-01", "widgets")
+	if err != nil {
+		t.Fatalf("loading the SDK package: %+v", err)
+	}
+
+	if pkg.ClientTypeName != "WidgetsClient" {
+		t.Fatalf("expected the Client Type to be `WidgetsClient` but got %q", pkg.ClientTypeName)
+	}
+	if pkg.ClientFieldName != "Widgets" {
+		t.Fatalf("expected the Client Field to be `Widgets` but got %q", pkg.ClientFieldName)
+	}
+	if pkg.ID.TypeName != "WidgetId" {
+		t.Fatalf("expected the Resource ID to be `WidgetId` but got %q", pkg.ID.TypeName)
+	}
+	expectedFormat := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Widgets/widgets/{widgetName}"
+	if pkg.ID.Format != expectedFormat {
+		t.Fatalf("expected the Resource ID format to be %q but got %q", expectedFormat, pkg.ID.Format)
+	}
+	if values := strings.Join(pkg.Constants["SkuName"], ","); values != "Basic,Standard" {
+		t.Fatalf("expected the values for `SkuName` to be `Basic,Standard` but got %q", values)
+	}
+	if payload := pkg.Methods["UpdateThenPoll"]; payload != "WidgetUpdate" {
+		t.Fatalf("expected the payload for `UpdateThenPoll` to be `WidgetUpdate` but got %q", payload)
+	}
+}
+
+func TestRun(t *testing.T) {
+	rootDirectory := writeSdkFixture(t)
+
+	input := generatorInput{
+		SDKService:      "widgets",
+		APIVersion:      "2023-01-01",
+		SDKPackage:      "widgets",
+		Name:            "widget",
+		BrandName:       "Widget",
+		ServicePackage:  "widgets",
+		ServiceName:     "Widgets",
+		WebsiteCategory: "Widgets",
+		RootDirectory:   rootDirectory,
+	}
+	if err := input.validate(); err != nil {
+		t.Fatalf("validating the input: %+v", err)
+	}
+	if err := run(input); err != nil {
+		t.Fatalf("running the generator: %+v", err)
+	}
+
+	expected := map[string][]string{
+		"internal/services/widgets/widget_resource_gen.go": {
+			"// NOTE: this file is generated - manual changes will be overwritten.",
+			`return "azurerm_widget"`,
+			"client := metadata.Client.Widgets.V20230101.Widgets",
+			"id := widgets.NewWidgetID(subscriptionId, config.ResourceGroupName, config.Name)",
+			"validation.StringInSlice(widgets.PossibleValuesForSkuName(), false)",
+			`"encryption_enabled": {`,
+			"payload.Properties.Description = pointer.To(config.Description)",
+			"client.UpdateThenPoll(ctx, *id, payload)",
+			"properties.Network (*NetworkAcl)",
+		},
+		"internal/services/widgets/widget_resource_gen_test.go": {
+			"package widgets_test",
+			"func TestAccWidget_basic(t *testing.T) {",
+			"func TestAccWidget_update(t *testing.T) {",
+			`sku                 = "Basic"`,
+		},
+		"website/docs/r/widget.html.markdown": {
+			`subcategory: "Widgets"`,
+			"* `sku` - (Required) The Sku for this Widget. Possible values are `Basic` and `Standard`.",
+			"* `encryption_enabled` - (Optional) The Encryption Enabled for this Widget. Changing this forces a new Widget to be created.",
+			"terraform import azurerm_widget.example /subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Widgets/widgets/widgetValue",
+		},
+		"internal/services/widgets/client/client_gen.go": {
+			`widgetsV20230101 "github.com/hashicorp/go-azure-sdk/resource-manager/widgets/2023-01-01"`,
+			"V20230101 widgetsV20230101.Client",
+		},
+		"internal/services/widgets/registration_gen.go": {
+			"WidgetResource{},",
+			`"Widgets",`,
+		},
+		"internal/services/widgets/registration.go": {
+			"autoRegistration",
+			`return "service/widgets"`,
+		},
+		"internal/clients/client_gen.go": {
+			"Widgets *widgets.AutoClient",
+		},
+		"internal/provider/services_gen.go": {
+			"widgets.Registration{},",
+		},
+	}
+
+	for path, values := range expected {
+		t.Logf("[DEBUG] Testing %q", path)
+
+		contents, err := os.ReadFile(filepath.Join(rootDirectory, filepath.FromSlash(path)))
+		if err != nil {
+			t.Fatalf("reading %q: %+v", path, err)
+		}
+		for _, v := range values {
+			if !strings.Contains(string(contents), v) {
+				t.Fatalf("expected %q to contain %q but got:\n%s", path, v, string(contents))
+			}
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParentResourceType returns the (assumed) Resource Type of the parent Resource, for example `azurerm_dev_center`
+func (v resourceView) ParentResourceType() string {
+	return "azurerm_" + toSnakeCase(v.ParentBase)
+}
+
+// testAttributes returns the attributes used for the Resource within the acceptance tests, optionally including the
+// optional arguments - the name uses the random integer, which is the second argument passed to `fmt.Sprintf`
+func (v resourceView) testAttributes(complete bool) [][2]string {
+	out := make([][2]string, 0)
+	for _, arg := range v.Def.IDArguments {
+		switch {
+		case arg.SchemaName == "resource_group_name":
+			out = append(out, [2]string{arg.SchemaName, "azurerm_resource_group.test.name"})
+		case arg.FromParent:
+			out = append(out, [2]string{arg.SchemaName, fmt.Sprintf("%s.test.id", v.ParentResourceType())})
+		default:
+			out = append(out, [2]string{arg.SchemaName, `"acctest-%[2]d"`})
+		}
+	}
+
+	if v.Def.LocationSDKType != "" {
+		out = append(out, [2]string{"location", "azurerm_resource_group.test.location"})
+	}
+
+	for _, prop := range v.Def.Properties {
+		if prop.Required || complete {
+			out = append(out, [2]string{prop.SchemaName, hclExampleValue(prop)})
+		}
+	}
+
+	return out
+}
+
+func (v resourceView) BasicConfig() string {
+	return hclAttributes("  ", v.testAttributes(false))
+}
+
+func (v resourceView) CompleteConfig() string {
+	out := hclAttributes("  ", v.testAttributes(true))
+	if v.Def.TagsSDKType != "" {
+		out += "\n\n  tags = {\n    environment = \"Test\"\n  }"
+	}
+	return out
+}
+
+func (v resourceView) RequiresImportConfig() string {
+	attributes := make([][2]string, 0)
+	for _, attr := range v.testAttributes(false) {
+		attributes = append(attributes, [2]string{attr[0], fmt.Sprintf("azurerm_%s.test.%s", v.Def.Name, attr[0])})
+	}
+	return hclAttributes("  ", attributes)
+}
+
+// ExistsGetArgs returns the arguments passed to the Get method within the `Exists` function of the acceptance tests
+func (v resourceView) ExistsGetArgs() string {
+	return "*id" + v.OptionsArg(v.Def.GetOptions)
+}
+
+// ExistsClient returns the expression used to access the SDK Client within the acceptance tests
+func (v resourceView) ExistsClient() string {
+	return strings.Replace(v.Client, "metadata.Client.", "clients.", 1)
+}
+
+const acceptanceTestTemplate = `package {{ .Def.ServicePackage }}_test
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"{{ .Def.SDK.ImportPath }}"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type {{ .Struct }}TestResource struct{}
+
+func TestAcc{{ .Struct }}_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_{{ .Def.Name }}", "test")
+	r := {{ .Struct }}TestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAcc{{ .Struct }}_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_{{ .Def.Name }}", "test")
+	r := {{ .Struct }}TestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAcc{{ .Struct }}_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_{{ .Def.Name }}", "test")
+	r := {{ .Struct }}TestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+{{ if .HasUpdate }}
+func TestAcc{{ .Struct }}_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_{{ .Def.Name }}", "test")
+	r := {{ .Struct }}TestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+{{ end }}
+func (r {{ .Struct }}TestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := {{ .Pkg }}.Parse{{ .IDBase }}ID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := {{ .ExistsClient }}.Get(ctx, {{ .ExistsGetArgs }})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r {{ .Struct }}TestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(` + "`" + `
+%[1]s
+
+resource "azurerm_{{ .Def.Name }}" "test" {
+{{ .BasicConfig }}
+}
+` + "`" + `, r.template(data), data.RandomInteger)
+}
+
+func (r {{ .Struct }}TestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(` + "`" + `
+%s
+
+resource "azurerm_{{ .Def.Name }}" "import" {
+{{ .RequiresImportConfig }}
+}
+` + "`" + `, r.basic(data))
+}
+
+func (r {{ .Struct }}TestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(` + "`" + `
+%[1]s
+
+resource "azurerm_{{ .Def.Name }}" "test" {
+{{ .CompleteConfig }}
+}
+` + "`" + `, r.template(data), data.RandomInteger)
+}
+
+func (r {{ .Struct }}TestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(` + "`" + `
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+{{- if .ParentModelField }}
+
+# TODO: configure the parent resource "{{ .ParentResourceType }}" "test"
+{{- end }}
+` + "`" + `, data.RandomInteger, data.Locations.Primary)
+}
+`
+
+// renderAcceptanceTest renders the acceptance tests for the Typed Resource
+func renderAcceptanceTest(def *resourceDefinition) (string, error) {
+	out, err := renderTemplate("acceptance test", acceptanceTestTemplate, newResourceView(def))
+	if err != nil {
+		return "", err
+	}
+	return formatGoCode(out)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DocsExample returns the example configuration used within the documentation
+func (v resourceView) DocsExample() string {
+	attributes := make([][2]string, 0)
+	for _, attr := range v.testAttributes(false) {
+		value := strings.ReplaceAll(attr[1], ".test.", ".example.")
+		if strings.Contains(value, "%[2]d") {
+			value = `"example"`
+		}
+		attributes = append(attributes, [2]string{attr[0], value})
+	}
+	return hclAttributes("  ", attributes)
+}
+
+// DocsArguments returns the documentation for each argument, with the Required arguments first
+func (v resourceView) DocsArguments() []string {
+	forceNew := fmt.Sprintf(" Changing this forces a new %s to be created.", v.Def.BrandName)
+
+	required := make([]string, 0)
+	optional := make([]string, 0)
+	for _, arg := range v.Def.IDArguments {
+		var description string
+		switch {
+		case arg.SchemaName == "name":
+			description = fmt.Sprintf("The name which should be used for this %s.", v.Def.BrandName)
+		case arg.SchemaName == "resource_group_name":
+			description = fmt.Sprintf("The name of the Resource Group where the %s should exist.", v.Def.BrandName)
+		case arg.FromParent:
+			description = fmt.Sprintf("The ID of the %s within which this %s should exist.", humanize(toSnakeCase(v.ParentBase)), v.Def.BrandName)
+		default:
+			description = fmt.Sprintf("The %s for this %s.", humanize(arg.SchemaName), v.Def.BrandName)
+		}
+		required = append(required, fmt.Sprintf("* `%s` - (Required) %s%s", arg.SchemaName, description, forceNew))
+	}
+
+	if v.Def.LocationSDKType != "" {
+		required = append(required, fmt.Sprintf("* `location` - (Required) The Azure Region where the %s should exist.%s", v.Def.BrandName, forceNew))
+	}
+
+	for _, prop := range v.Def.Properties {
+		line := fmt.Sprintf("The %s for this %s.%s", humanize(prop.SchemaName), v.Def.BrandName, possibleValuesSentence(prop.PossibleValues))
+		if prop.ForceNew {
+			line += forceNew
+		}
+
+		if prop.Required {
+			required = append(required, fmt.Sprintf("* `%s` - (Required) %s", prop.SchemaName, line))
+		} else {
+			optional = append(optional, fmt.Sprintf("* `%s` - (Optional) %s", prop.SchemaName, line))
+		}
+	}
+
+	if v.Def.TagsSDKType != "" {
+		line := fmt.Sprintf("* `tags` - (Optional) A mapping of tags which should be assigned to the %s.", v.Def.BrandName)
+		if !v.TagsUpdatable() {
+			line += forceNew
+		}
+		optional = append(optional, line)
+	}
+
+	return append(required, optional...)
+}
+
+// Article returns the indefinite article used before the Brand Name within the documentation
+func (v resourceView) Article() string {
+	if strings.ContainsAny(strings.ToLower(v.Def.BrandName[:1]), "aeiou") {
+		return "an"
+	}
+	return "a"
+}
+
+// DocsImportID returns an example Resource ID used in the Import section of the documentation
+func (v resourceView) DocsImportID() string {
+	out := v.Def.SDK.ID.Format
+	for _, segment := range v.Def.SDK.ID.Segments {
+		value := segment.ExampleValue
+		if segment.Name == "resourceGroupName" {
+			value = "resGroup1"
+		}
+		out = strings.Replace(out, fmt.Sprintf("{%s}", segment.Name), value, 1)
+	}
+	return out
+}
+
+const docsTemplate = `---
+subcategory: "{{ .Def.WebsiteCategory }}"
+layout: "azurerm"
+page_title: "Azure Resource Manager: azurerm_{{ .Def.Name }}"
+description: |-
+  Manages {{ .Article }} {{ .Def.BrandName }}.
+---
+
+# azurerm_{{ .Def.Name }}
+
+Manages {{ .Article }} {{ .Def.BrandName }}.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "azurerm_resource_group" "example" {
+  name     = "example-resources"
+  location = "West Europe"
+}
+
+resource "azurerm_{{ .Def.Name }}" "example" {
+{{ .DocsExample }}
+}
+` + "```" + `
+
+## Arguments Reference
+
+The following arguments are supported:
+{{ range .DocsArguments }}
+{{ . }}
+{{ end }}
+## Attributes Reference
+
+In addition to the Arguments listed above - the following Attributes are exported:
+
+* ` + "`id`" + ` - The ID of the {{ .Def.BrandName }}.
+
+## Timeouts
+
+The ` + "`timeouts`" + ` block allows you to specify [timeouts](https://www.terraform.io/language/resources/syntax#operation-timeouts) for certain actions:
+
+* ` + "`create`" + ` - (Defaults to 30 minutes) Used when creating the {{ .Def.BrandName }}.
+* ` + "`read`" + ` - (Defaults to 5 minutes) Used when retrieving the {{ .Def.BrandName }}.
+{{- if .HasUpdate }}
+* ` + "`update`" + ` - (Defaults to 30 minutes) Used when updating the {{ .Def.BrandName }}.
+{{- end }}
+* ` + "`delete`" + ` - (Defaults to 30 minutes) Used when deleting the {{ .Def.BrandName }}.
+
+## Import
+
+{{ .Def.BrandName }}s can be imported using the ` + "`resource id`" + `, e.g.
+
+` + "```shell" + `
+terraform import azurerm_{{ .Def.Name }}.example {{ .DocsImportID }}
+` + "```" + `
+`
+
+// renderDocs renders the documentation for the Typed Resource
+func renderDocs(def *resourceDefinition) (string, error) {
+	return renderTemplate("documentation", docsTemplate, newResourceView(def))
+}
@@ -0,0 +1,600 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// resourceView contains the derived values used when rendering the templates for a Resource
+type resourceView struct {
+	Def *resourceDefinition
+
+	// Struct is the prefix used for the Go types, for example `DevCenterProject`
+	Struct string
+
+	// Pkg is the name of the SDK package, for example `projects`
+	Pkg string
+
+	// IDBase is the name of the Resource ID without the `Id` suffix, for example `Project`
+	IDBase string
+
+	// ParentBase is the name of the parent Resource ID without the `Id` suffix, for example `DevCenter`
+	ParentBase string
+
+	// Client is the expression used to access the SDK Client, for example `metadata.Client.DevCenter.V20230401.Projects`
+	Client string
+
+	// NewIDArgs are the arguments passed to the Resource ID constructor within Create
+	NewIDArgs string
+
+	// IDState are the fields within the Typed Model populated from the Resource ID within Read
+	IDState [][2]string
+
+	UsesSubscriptionId bool
+
+	// UpdateTagsSDKType is the type of the `Tags` field within the update model, when updated via a separate method
+	UpdateTagsSDKType string
+
+	// Imports are the imports used within the Resource, other than those from the standard library
+	Imports []string
+}
+
+func newResourceView(def *resourceDefinition) resourceView {
+	view := resourceView{
+		Def:        def,
+		Struct:     modelFieldForSchemaName(def.Name),
+		Pkg:        def.SDK.Name,
+		IDBase:     strings.TrimSuffix(def.SDK.ID.TypeName, "Id"),
+		ParentBase: strings.TrimSuffix(def.ParentID, "Id"),
+		Client:     fmt.Sprintf("metadata.Client.%s.%s.%s", def.ServiceName, def.SDK.VersionFieldName(), def.SDK.ClientFieldName),
+	}
+
+	parentModelField := ""
+	idFieldToModelField := map[string]string{}
+	for _, arg := range def.IDArguments {
+		if arg.FromParent {
+			parentModelField = arg.ModelField
+			continue
+		}
+		idFieldToModelField[arg.IDField] = arg.ModelField
+	}
+
+	args := make([]string, 0)
+	parentArgs := make([]string, 0)
+	for i, field := range def.SDK.ID.Fields {
+		isParent := def.ParentID != "" && parentModelField != "" && i < len(def.SDK.ID.Fields)-1
+		switch {
+		case isParent:
+			args = append(args, fmt.Sprintf("parentId.%s", field))
+			parentArgs = append(parentArgs, fmt.Sprintf("id.%s", field))
+		case field == "SubscriptionId":
+			args = append(args, "subscriptionId")
+			view.UsesSubscriptionId = true
+		default:
+			args = append(args, fmt.Sprintf("config.%s", idFieldToModelField[field]))
+			view.IDState = append(view.IDState, [2]string{idFieldToModelField[field], fmt.Sprintf("id.%s", field)})
+		}
+	}
+	view.NewIDArgs = strings.Join(args, ", ")
+	if parentModelField != "" {
+		view.IDState = append(view.IDState, [2]string{parentModelField, fmt.Sprintf("%s.New%sID(%s).ID()", view.Pkg, view.ParentBase, strings.Join(parentArgs, ", "))})
+	}
+
+	if def.UpdateModel != "" {
+		for _, field := range def.SDK.Models[def.UpdateModel] {
+			if field.Name == "Tags" {
+				view.UpdateTagsSDKType = field.Type
+			}
+		}
+	}
+
+	return view
+}
+
+// ParentModelField returns the field within the Typed Model containing the ID of the parent Resource
+func (v resourceView) ParentModelField() string {
+	for _, arg := range v.Def.IDArguments {
+		if arg.FromParent {
+			return arg.ModelField
+		}
+	}
+	return ""
+}
+
+// TagsUpdatable returns whether the Tags can be updated in-place
+func (v resourceView) TagsUpdatable() bool {
+	return v.Def.TagsSDKType != "" && (v.Def.UpdateModel == "" || v.UpdateTagsSDKType != "")
+}
+
+// HasUpdate returns whether any of the arguments can be updated in-place
+func (v resourceView) HasUpdate() bool {
+	if v.TagsUpdatable() {
+		return true
+	}
+	for _, prop := range v.Def.Properties {
+		if !prop.ForceNew {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdatableProperties returns the properties which are updated via a separate update model, split by whether they're
+// within the `properties` of the model
+func (v resourceView) UpdatableProperties(inProperties bool) []property {
+	out := make([]property, 0)
+	for _, prop := range v.Def.Properties {
+		if !prop.ForceNew && prop.InProperties == inProperties {
+			out = append(out, prop)
+		}
+	}
+	return out
+}
+
+// Properties returns the properties split by whether they're within the `properties` of the model
+func (v resourceView) Properties(inProperties bool) []property {
+	out := make([]property, 0)
+	for _, prop := range v.Def.Properties {
+		if prop.InProperties == inProperties {
+			out = append(out, prop)
+		}
+	}
+	return out
+}
+
+func (v resourceView) PropertiesIsPointer() bool {
+	for _, field := range v.Def.SDK.Models[v.Def.Model] {
+		if field.Name == "Properties" {
+			return strings.HasPrefix(field.Type, "*")
+		}
+	}
+	return false
+}
+
+func (v resourceView) OptionsArg(options string) string {
+	if options == "" {
+		return ""
+	}
+	return fmt.Sprintf(", %s.Default%s()", v.Pkg, options)
+}
+
+// Call returns the statement used to call an SDK method, discarding the result of methods which don't poll
+func (v resourceView) Call(method, args string) string {
+	if strings.HasSuffix(method, "ThenPoll") {
+		return fmt.Sprintf("err := client.%s(ctx, %s)", method, args)
+	}
+	return fmt.Sprintf("_, err := client.%s(ctx, %s)", method, args)
+}
+
+func (v resourceView) ModelFields() [][3]string {
+	out := make([][3]string, 0)
+	for _, arg := range v.Def.IDArguments {
+		out = append(out, [3]string{arg.ModelField, "string", arg.SchemaName})
+	}
+	if v.Def.LocationSDKType != "" {
+		out = append(out, [3]string{"Location", "string", "location"})
+	}
+	for _, prop := range v.Def.Properties {
+		out = append(out, [3]string{prop.ModelField, prop.ModelType, prop.SchemaName})
+	}
+	if v.Def.TagsSDKType != "" {
+		out = append(out, [3]string{"Tags", "map[string]string", "tags"})
+	}
+	return out
+}
+
+// SchemaForArguments returns the Go code for the map of schema arguments
+func (v resourceView) SchemaForArguments() string {
+	lines := make([]string, 0)
+	for _, arg := range v.Def.IDArguments {
+		switch {
+		case arg.SchemaName == "resource_group_name":
+			lines = append(lines, `"resource_group_name": commonschema.ResourceGroupName(),`)
+		case arg.FromParent:
+			lines = append(lines, fmt.Sprintf(`%q: {
+	Type:         pluginsdk.TypeString,
+	Required:     true,
+	ForceNew:     true,
+	ValidateFunc: %s.Validate%sID,
+},`, arg.SchemaName, v.Pkg, v.ParentBase))
+		default:
+			lines = append(lines, fmt.Sprintf(`%q: {
+	Type:         pluginsdk.TypeString,
+	Required:     true,
+	ForceNew:     true,
+	ValidateFunc: validation.StringIsNotEmpty,
+},`, arg.SchemaName))
+		}
+	}
+
+	if v.Def.LocationSDKType != "" {
+		lines = append(lines, `"location": commonschema.Location(),`)
+	}
+
+	for _, prop := range v.Def.Properties {
+		lines = append(lines, v.schemaForProperty(prop))
+	}
+
+	if v.Def.TagsSDKType != "" {
+		if v.TagsUpdatable() {
+			lines = append(lines, `"tags": commonschema.Tags(),`)
+		} else {
+			lines = append(lines, `"tags": commonschema.TagsForceNew(),`)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (v resourceView) schemaForProperty(prop property) string {
+	fields := []string{fmt.Sprintf("Type: %s,", prop.SchemaType)}
+	if prop.Required {
+		fields = append(fields, "Required: true,")
+	} else {
+		fields = append(fields, "Optional: true,")
+	}
+	if prop.ForceNew {
+		fields = append(fields, "ForceNew: true,")
+	}
+
+	switch {
+	case prop.EnumType != "":
+		fields = append(fields, fmt.Sprintf("ValidateFunc: validation.StringInSlice(%s.PossibleValuesFor%s(), false),", v.Pkg, prop.EnumType))
+	case prop.SchemaType == "pluginsdk.TypeString":
+		fields = append(fields, "ValidateFunc: validation.StringIsNotEmpty,")
+	case prop.SchemaType == "pluginsdk.TypeList":
+		fields = append(fields, fmt.Sprintf(`Elem: &pluginsdk.Schema{
+	Type:         %s,
+	ValidateFunc: validation.StringIsNotEmpty,
+},`, prop.ElemType))
+	case prop.SchemaType == "pluginsdk.TypeMap":
+		fields = append(fields, fmt.Sprintf(`Elem: &pluginsdk.Schema{
+	Type: %s,
+},`, prop.ElemType))
+	}
+
+	return fmt.Sprintf("%q: {\n%s\n},", prop.SchemaName, strings.Join(fields, "\n"))
+}
+
+func (v resourceView) Expand(sdkType, value string) string {
+	return expandExpression(v.Pkg, sdkType, value)
+}
+
+func (v resourceView) Flatten(sdkType, value string) string {
+	return flattenExpression(sdkType, value)
+}
+
+func (v resourceView) ExpandLocation() string {
+	if strings.HasPrefix(v.Def.LocationSDKType, "*") {
+		return "pointer.To(location.Normalize(input.Location))"
+	}
+	return "location.Normalize(input.Location)"
+}
+
+func (v resourceView) FlattenLocation() string {
+	if strings.HasPrefix(v.Def.LocationSDKType, "*") {
+		return "location.NormalizeNilable(input.Location)"
+	}
+	return "location.Normalize(input.Location)"
+}
+
+const resourceTemplate = `package {{ .Def.ServicePackage }}
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	{{ range .Imports }}"{{ . }}"
+	{{ end }}
+)
+
+var _ sdk.Resource = {{ .Struct }}Resource{}
+{{- if .HasUpdate }}
+var _ sdk.ResourceWithUpdate = {{ .Struct }}Resource{}
+{{- end }}
+
+type {{ .Struct }}Resource struct{}
+
+type {{ .Struct }}ResourceModel struct {
+{{- range .ModelFields }}
+	{{ index . 0 }} {{ index . 1 }} ` + "`" + `tfschema:"{{ index . 2 }}"` + "`" + `
+{{- end }}
+}
+{{- if .Def.Unsupported }}
+
+// TODO: the following fields within the SDK model aren't supported by the generator and need to be added by hand:
+{{- range .Def.Unsupported }}
+// * {{ . }}
+{{- end }}
+{{- end }}
+
+func (r {{ .Struct }}Resource) ModelObject() interface{} {
+	return &{{ .Struct }}ResourceModel{}
+}
+
+func (r {{ .Struct }}Resource) ResourceType() string {
+	return "azurerm_{{ .Def.Name }}"
+}
+
+func (r {{ .Struct }}Resource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return {{ .Pkg }}.Validate{{ .IDBase }}ID
+}
+
+func (r {{ .Struct }}Resource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+{{ .SchemaForArguments }}
+	}
+}
+
+func (r {{ .Struct }}Resource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r {{ .Struct }}Resource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := {{ .Client }}
+
+			var config {{ .Struct }}ResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+{{ if .ParentModelField }}
+			parentId, err := {{ .Pkg }}.Parse{{ .ParentBase }}ID(config.{{ .ParentModelField }})
+			if err != nil {
+				return err
+			}
+{{ end }}
+{{- if .UsesSubscriptionId }}
+			subscriptionId := metadata.Client.Account.SubscriptionId
+{{ end }}
+			id := {{ .Pkg }}.New{{ .IDBase }}ID({{ .NewIDArgs }})
+
+			existing, err := client.Get(ctx, id{{ .OptionsArg .Def.GetOptions }})
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := expand{{ .Struct }}(config)
+
+			if {{ .Call .Def.CreateMethod "id, payload" }}; err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r {{ .Struct }}Resource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := {{ .Client }}
+
+			id, err := {{ .Pkg }}.Parse{{ .IDBase }}ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id{{ .OptionsArg .Def.GetOptions }})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := {{ .Struct }}ResourceModel{
+{{- range .IDState }}
+				{{ index . 0 }}: {{ index . 1 }},
+{{- end }}
+			}
+
+			if model := resp.Model; model != nil {
+				flatten{{ .Struct }}(*model, &state)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+{{ if .HasUpdate }}
+func (r {{ .Struct }}Resource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := {{ .Client }}
+
+			id, err := {{ .Pkg }}.Parse{{ .IDBase }}ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config {{ .Struct }}ResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+{{ if .Def.UpdateModel }}
+			payload := {{ .Pkg }}.{{ .Def.UpdateModel }}{}
+{{- range .UpdatableProperties false }}
+
+			if metadata.ResourceData.HasChange("{{ .SchemaName }}") {
+				payload.{{ .SDKField }} = {{ $.Expand .UpdateSDKType (printf "config.%s" .ModelField) }}
+			}
+{{- end }}
+{{- if .TagsUpdatable }}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = {{ .Expand .UpdateTagsSDKType "config.Tags" }}
+			}
+{{- end }}
+{{- if .UpdatableProperties true }}
+
+			payload.Properties = &{{ .Pkg }}.{{ .Def.UpdatePropertiesModel }}{}
+{{- range .UpdatableProperties true }}
+
+			if metadata.ResourceData.HasChange("{{ .SchemaName }}") {
+				payload.Properties.{{ .SDKField }} = {{ $.Expand .UpdateSDKType (printf "config.%s" .ModelField) }}
+			}
+{{- end }}
+{{- end }}
+{{ else }}
+			payload := expand{{ .Struct }}(config)
+{{ end }}
+			if {{ .Call .Def.UpdateMethod "*id, payload" }}; err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+{{ end }}
+func (r {{ .Struct }}Resource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := {{ .Client }}
+
+			id, err := {{ .Pkg }}.Parse{{ .IDBase }}ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if {{ .Call .Def.DeleteMethod (printf "*id%s" (.OptionsArg .Def.DeleteOptions)) }}; err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expand{{ .Struct }}(input {{ .Struct }}ResourceModel) {{ .Pkg }}.{{ .Def.Model }} {
+	output := {{ .Pkg }}.{{ .Def.Model }}{
+{{- if .Def.LocationSDKType }}
+		Location: {{ .ExpandLocation }},
+{{- end }}
+{{- range .Properties false }}
+		{{ .SDKField }}: {{ $.Expand .SDKType (printf "input.%s" .ModelField) }},
+{{- end }}
+{{- if .Def.TagsSDKType }}
+		Tags: {{ .Expand .Def.TagsSDKType "input.Tags" }},
+{{- end }}
+	}
+{{- if .Def.PropertiesModel }}
+
+	output.Properties = {{ if .PropertiesIsPointer }}&{{ end }}{{ .Pkg }}.{{ .Def.PropertiesModel }}{
+{{- range .Properties true }}
+		{{ .SDKField }}: {{ $.Expand .SDKType (printf "input.%s" .ModelField) }},
+{{- end }}
+	}
+{{- end }}
+
+	return output
+}
+
+func flatten{{ .Struct }}(input {{ .Pkg }}.{{ .Def.Model }}, output *{{ .Struct }}ResourceModel) {
+{{- if .Def.LocationSDKType }}
+	output.Location = {{ .FlattenLocation }}
+{{- end }}
+{{- range .Properties false }}
+	output.{{ .ModelField }} = {{ $.Flatten .SDKType (printf "input.%s" .SDKField) }}
+{{- end }}
+{{- if .Def.TagsSDKType }}
+	output.Tags = {{ .Flatten .Def.TagsSDKType "input.Tags" }}
+{{- end }}
+{{- if .Def.PropertiesModel }}
+{{ if .PropertiesIsPointer }}
+	if props := input.Properties; props != nil {
+{{- range .Properties true }}
+		output.{{ .ModelField }} = {{ $.Flatten .SDKType (printf "props.%s" .SDKField) }}
+{{- end }}
+	}
+{{- else }}
+	props := input.Properties
+{{- range .Properties true }}
+	output.{{ .ModelField }} = {{ $.Flatten .SDKType (printf "props.%s" .SDKField) }}
+{{- end }}
+{{- end }}
+{{- end }}
+}
+`
+
+// renderResource renders the Typed Resource, determining the imports required once the body has been rendered
+func renderResource(def *resourceDefinition) (string, error) {
+	view := newResourceView(def)
+	body, err := renderTemplate("resource", resourceTemplate, view)
+	if err != nil {
+		return "", err
+	}
+
+	view.Imports = []string{
+		def.SDK.ImportPath(),
+		"github.com/hashicorp/go-azure-helpers/lang/response",
+		"github.com/hashicorp/terraform-provider-azurerm/internal/sdk",
+		"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk",
+	}
+	optionalImports := map[string]string{
+		"commonschema.": "github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema",
+		"location.":     "github.com/hashicorp/go-azure-helpers/resourcemanager/location",
+		"pointer.":      "github.com/hashicorp/go-azure-helpers/lang/pointer",
+		"validation.":   "github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation",
+	}
+	for usage, path := range optionalImports {
+		if strings.Contains(body, usage) {
+			view.Imports = append(view.Imports, path)
+		}
+	}
+	sort.Strings(view.Imports)
+
+	out, err := renderTemplate("resource", resourceTemplate, view)
+	if err != nil {
+		return "", err
+	}
+	return formatGoCode(out)
+}
+
+// renderTemplate renders the template using the specified view
+func renderTemplate(name, tmpl string, view interface{}) (string, error) {
+	t, err := template.New(name).Funcs(template.FuncMap{
+		"hcl": hclAttributes,
+	}).Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("parsing the %s template: %+v", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, view); err != nil {
+		return "", fmt.Errorf("rendering the %s template: %+v", name, err)
+	}
+
+	return buf.String(), nil
+}
+
+// formatGoCode formats the rendered Go code
+func formatGoCode(input string) (string, error) {
+	formatted, err := format.Source([]byte(input))
+	if err != nil {
+		return "", fmt.Errorf("formatting the generated code: %+v\n\n%s", err, input)
+	}
+	return string(formatted), nil
+}
@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type serviceClientVersion struct {
+	// Service is the name of the Service within the go-azure-sdk, for example `devcenter`
+	Service string
+
+	// APIVersion is the API Version, for example `2023-04-01`
+	APIVersion string
+}
+
+func (v serviceClientVersion) Field() string {
+	return versionFieldName(v.APIVersion)
+}
+
+func (v serviceClientVersion) Alias() string {
+	return v.Service + v.Field()
+}
+
+func (v serviceClientVersion) Variable() string {
+	return strings.ToLower(v.Field()[:1]) + v.Field()[1:] + "Client"
+}
+
+func (v serviceClientVersion) ImportPath() string {
+	return fmt.Sprintf("%s/%s/%s", sdkImportPathPrefix, v.Service, v.APIVersion)
+}
+
+var serviceClientImport = regexp.MustCompile(`(?m)^\s*\w+ "` + regexp.QuoteMeta(sdkImportPathPrefix) + `/([^/"]+)/([^/"]+)"$`)
+
+// serviceClientVersionsFromFile returns the API Versions used within an existing `client/client_gen.go` file
+func serviceClientVersionsFromFile(contents string) []serviceClientVersion {
+	out := make([]serviceClientVersion, 0)
+	for _, match := range serviceClientImport.FindAllStringSubmatch(contents, -1) {
+		out = append(out, serviceClientVersion{
+			Service:    match[1],
+			APIVersion: match[2],
+		})
+	}
+	return out
+}
+
+const serviceClientTemplate = `package client
+
+import (
+	"fmt"
+
+{{ range .Versions }}	{{ .Alias }} "{{ .ImportPath }}"
+{{ end }}	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type AutoClient struct {
+{{- range .Versions }}
+	{{ .Field }} {{ .Alias }}.Client
+{{- end }}
+}
+
+func NewClient(o *common.ClientOptions) (*AutoClient, error) {
+{{ range .Versions }}
+	{{ .Variable }}, err := {{ .Alias }}.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+		o.Configure(c, o.Authorizers.ResourceManager)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building client for {{ .Service }} {{ .Field }}: %+v", err)
+	}
+{{ end }}
+	return &AutoClient{
+{{- range .Versions }}
+		{{ .Field }}: *{{ .Variable }},
+{{- end }}
+	}, nil
+}
+`
+
+// renderServiceClient renders the `client/client_gen.go` file for a Service, containing a Client for each API Version
+func renderServiceClient(versions []serviceClientVersion) (string, error) {
+	unique := map[string]serviceClientVersion{}
+	for _, v := range versions {
+		unique[v.ImportPath()] = v
+	}
+	sorted := make([]serviceClientVersion, 0)
+	for _, v := range unique {
+		sorted = append(sorted, v)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ImportPath() < sorted[j].ImportPath()
+	})
+
+	out, err := renderTemplate("service client", serviceClientTemplate, struct {
+		Versions []serviceClientVersion
+	}{sorted})
+	if err != nil {
+		return "", err
+	}
+	return formatGoCode(out)
+}
+
+var (
+	generatedResourceType   = regexp.MustCompile(`(?m)^type (\w+Resource) struct\{\}$`)
+	generatedDataSourceType = regexp.MustCompile(`(?m)^type (\w+DataSource) struct\{\}$`)
+	websiteCategoriesBlock  = regexp.MustCompile(`(?s)WebsiteCategories\(\) \[\]string \{\s*return \[\]string\{(.*?)\}`)
+	quotedString            = regexp.MustCompile(`"([^"]+)"`)
+)
+
+type registration struct {
+	ServicePackage    string
+	Name              string
+	DataSources       []string
+	Resources         []string
+	WebsiteCategories []string
+}
+
+// typesFromGeneratedFiles returns the Resources and Data Sources defined within the generated files for a Service
+func typesFromGeneratedFiles(files map[string]string) (resources []string, dataSources []string) {
+	for name, contents := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		for _, match := range generatedResourceType.FindAllStringSubmatch(contents, -1) {
+			resources = append(resources, match[1])
+		}
+		for _, match := range generatedDataSourceType.FindAllStringSubmatch(contents, -1) {
+			dataSources = append(dataSources, match[1])
+		}
+	}
+	sort.Strings(resources)
+	sort.Strings(dataSources)
+	return
+}
+
+// websiteCategoriesFromFile returns the Website Categories defined within an existing `registration_gen.go` file
+func websiteCategoriesFromFile(contents string) []string {
+	out := make([]string, 0)
+	match := websiteCategoriesBlock.FindStringSubmatch(contents)
+	if match == nil {
+		return out
+	}
+	for _, v := range quotedString.FindAllStringSubmatch(match[1], -1) {
+		out = append(out, v[1])
+	}
+	return out
+}
+
+const autoRegistrationTemplate = `package {{ .ServicePackage }}
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import "github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+
+var _ sdk.TypedServiceRegistration = autoRegistration{}
+
+type autoRegistration struct {
+}
+
+func (autoRegistration) Name() string {
+	return "{{ .Name }}"
+}
+
+func (autoRegistration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+{{- range .DataSources }}
+		{{ . }}{},
+{{- end }}
+	}
+}
+
+func (autoRegistration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+{{- range .Resources }}
+		{{ . }}{},
+{{- end }}
+	}
+}
+
+func (autoRegistration) WebsiteCategories() []string {
+	return []string{
+{{- range .WebsiteCategories }}
+		"{{ . }}",
+{{- end }}
+	}
+}
+`
+
+// renderAutoRegistration renders the `registration_gen.go` file for a Service
+func renderAutoRegistration(input registration) (string, error) {
+	out, err := renderTemplate("auto registration", autoRegistrationTemplate, input)
+	if err != nil {
+		return "", err
+	}
+	return formatGoCode(out)
+}
+
+const registrationTemplate = `// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package {{ .ServicePackage }}
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+type Registration struct {
+	autoRegistration
+}
+
+func (r Registration) Name() string {
+	return "{{ index .WebsiteCategories 0 }}"
+}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/{{ .GitHubLabel }}"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return r.autoRegistration.WebsiteCategories()
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return r.autoRegistration.DataSources()
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return r.autoRegistration.Resources()
+}
+`
+
+// renderRegistration renders the `registration.go` file for a new Service, which embeds the generated registration
+func renderRegistration(input registration) (string, error) {
+	if len(input.WebsiteCategories) == 0 {
+		return "", fmt.Errorf("a Website Category must be specified for a new Service")
+	}
+
+	out, err := renderTemplate("registration", registrationTemplate, struct {
+		registration
+		GitHubLabel string
+	}{
+		registration: input,
+		GitHubLabel:  strings.ReplaceAll(strings.ToLower(input.WebsiteCategories[0]), " ", "-"),
+	})
+	if err != nil {
+		return "", err
+	}
+	return formatGoCode(out)
+}
+
+// autoClientEntry is a Service which uses an AutoClient within `internal/clients/client_gen.go`
+type autoClientEntry struct {
+	// Field is the name of the field within the Client, for example `DevCenter`
+	Field string
+
+	// ServicePackage is the name of the Service Package, for example `devcenter`
+	ServicePackage string
+}
+
+var (
+	autoClientImport = regexp.MustCompile(`(?m)^\s*(\w+) "github.com/hashicorp/terraform-provider-azurerm/internal/services/(\w+)/client"$`)
+	autoClientField  = regexp.MustCompile(`(?m)^\s*(\w+)\s+\*(\w+)\.AutoClient$`)
+)
+
+// autoClientEntriesFromFile returns the Services defined within an existing `internal/clients/client_gen.go` file
+func autoClientEntriesFromFile(contents string) []autoClientEntry {
+	aliases := map[string]string{}
+	for _, match := range autoClientImport.FindAllStringSubmatch(contents, -1) {
+		aliases[match[1]] = match[2]
+	}
+
+	out := make([]autoClientEntry, 0)
+	for _, match := range autoClientField.FindAllStringSubmatch(contents, -1) {
+		servicePackage, ok := aliases[match[2]]
+		if !ok {
+			continue
+		}
+		out = append(out, autoClientEntry{
+			Field:          match[1],
+			ServicePackage: servicePackage,
+		})
+	}
+	return out
+}
+
+const autoClientsTemplate = `package clients
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+{{- range .Entries }}
+	{{ .ServicePackage }} "github.com/hashicorp/terraform-provider-azurerm/internal/services/{{ .ServicePackage }}/client"
+{{- end }}
+)
+
+type autoClient struct {
+{{- range .Entries }}
+	{{ .Field }} *{{ .ServicePackage }}.AutoClient
+{{- end }}
+}
+
+func buildAutoClients(client *autoClient, o *common.ClientOptions) (err error) {
+{{ range .Entries }}
+	if client.{{ .Field }}, err = {{ .ServicePackage }}.NewClient(o); err != nil {
+		return fmt.Errorf("building client for {{ .Field }}: %+v", err)
+	}
+{{ end }}
+	return nil
+}
+`
+
+// renderAutoClients renders the `internal/clients/client_gen.go` file
+func renderAutoClients(entries []autoClientEntry) (string, error) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ServicePackage < entries[j].ServicePackage
+	})
+
+	out, err := renderTemplate("auto clients", autoClientsTemplate, struct {
+		Entries []autoClientEntry
+	}{entries})
+	if err != nil {
+		return "", err
+	}
+	return formatGoCode(out)
+}
+
+var autoRegisteredService = regexp.MustCompile(`(?m)^\s*(\w+)\.Registration\{\},$`)
+
+// autoRegisteredServicesFromFile returns the Service Packages defined within an existing
+// `internal/provider/services_gen.go` file
+func autoRegisteredServicesFromFile(contents string) []string {
+	out := make([]string, 0)
+	for _, match := range autoRegisteredService.FindAllStringSubmatch(contents, -1) {
+		out = append(out, match[1])
+	}
+	return out
+}
+
+const autoRegisteredServicesTemplate = `package provider
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+{{- range .Services }}
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/{{ . }}"
+{{- end }}
+)
+
+func autoRegisteredTypedServices() []sdk.TypedServiceRegistration {
+	return []sdk.TypedServiceRegistration{
+{{- range .Services }}
+		{{ . }}.Registration{},
+{{- end }}
+	}
+}
+`
+
+// renderAutoRegisteredServices renders the `internal/provider/services_gen.go` file
+func renderAutoRegisteredServices(services []string) (string, error) {
+	sort.Strings(services)
+	out, err := renderTemplate("auto registered services", autoRegisteredServicesTemplate, struct {
+		Services []string
+	}{services})
+	if err != nil {
+		return "", err
+	}
+	return formatGoCode(out)
+}
@@ -0,0 +1,437 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const sdkImportPathPrefix = "github.com/hashicorp/go-azure-sdk/resource-manager"
+
+// sdkPackage describes a single resource-manager package within the go-azure-sdk, for example
+// `resource-manager/devcenter/2023-04-01/projects`
+type sdkPackage struct {
+	// Service is the name of the Service within the go-azure-sdk, for example `devcenter`
+	Service string
+
+	// APIVersion is the API Version, for example `2023-04-01`
+	APIVersion string
+
+	// Name is the name of the package, for example `projects`
+	Name string
+
+	// ClientTypeName is the name of the Client for this package, for example `ProjectsClient`
+	ClientTypeName string
+
+	// ClientFieldName is the name of the field for this Client within the API Version Client, for example `Projects`
+	ClientFieldName string
+
+	ID sdkResourceID
+
+	// ResourceIDs is a map of the name of each Resource ID type within this package to its fields
+	ResourceIDs map[string][]string
+
+	// Constants is a map of the name of each constant type to its possible values
+	Constants map[string][]string
+
+	// Models is a map of the name of each model to its fields
+	Models map[string][]sdkField
+
+	// Methods is a map of the name of each method on the Client to its payload type (which is empty for methods
+	// without a payload)
+	Methods map[string]string
+}
+
+type sdkResourceID struct {
+	// TypeName is the name of the Resource ID type, for example `ProjectId`
+	TypeName string
+
+	// Fields are the names of the fields within the Resource ID, in the order they're passed to the constructor
+	Fields []string
+
+	// Format is the format of the Resource ID, with each user specified segment replaced with `{segmentName}`
+	Format string
+
+	// Segments are the user specified segments within the Resource ID
+	Segments []sdkResourceIDSegment
+}
+
+type sdkResourceIDSegment struct {
+	Name         string
+	ExampleValue string
+}
+
+type sdkField struct {
+	Name     string
+	Type     string
+	JSONName string
+	Optional bool
+}
+
+// ImportPath returns the Go import path for this package
+func (p sdkPackage) ImportPath() string {
+	return fmt.Sprintf("%s/%s/%s/%s", sdkImportPathPrefix, p.Service, p.APIVersion, p.Name)
+}
+
+// VersionImportPath returns the Go import path for the API Version containing this package
+func (p sdkPackage) VersionImportPath() string {
+	return fmt.Sprintf("%s/%s/%s", sdkImportPathPrefix, p.Service, p.APIVersion)
+}
+
+// VersionFieldName returns the name used for this API Version within the Service Client, for example `V20230401`
+func (p sdkPackage) VersionFieldName() string {
+	return versionFieldName(p.APIVersion)
+}
+
+// versionFieldName returns the name used for an API Version within the Service Client, for example `2022-09-02-preview`
+// becomes `V20220902Preview`
+func versionFieldName(apiVersion string) string {
+	out := "V"
+	for _, segment := range strings.Split(apiVersion, "-") {
+		if segment == "" {
+			continue
+		}
+		out += strings.ToUpper(segment[:1]) + segment[1:]
+	}
+	return out
+}
+
+// ParentIDTypeName returns the name of the Resource ID type within this package which is the parent of the Resource
+// ID for this package (for example `DevCenterId` for `ProjectId`), or an empty string if one isn't present.
+func (p sdkPackage) ParentIDTypeName() string {
+	parentFields := p.ID.Fields[:len(p.ID.Fields)-1]
+	for name, fields := range p.ResourceIDs {
+		if name == p.ID.TypeName || len(fields) != len(parentFields) {
+			continue
+		}
+
+		matches := true
+		for i := range fields {
+			if fields[i] != parentFields[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return name
+		}
+	}
+	return ""
+}
+
+// loadSdkPackage parses the specified go-azure-sdk package from the vendor directory
+func loadSdkPackage(vendorPath, service, apiVersion, name string) (*sdkPackage, error) {
+	versionPath := filepath.Join(vendorPath, filepath.FromSlash(sdkImportPathPrefix), service, apiVersion)
+	packagePath := filepath.Join(versionPath, name)
+	if _, err := os.Stat(packagePath); err != nil {
+		return nil, fmt.Errorf("the package %q was not found - ensure it's been vendored using `go mod vendor`: %+v", packagePath, err)
+	}
+
+	pkg := sdkPackage{
+		Service:     service,
+		APIVersion:  apiVersion,
+		Name:        name,
+		ResourceIDs: map[string][]string{},
+		Constants:   map[string][]string{},
+		Models:      map[string][]sdkField{},
+		Methods:     map[string]string{},
+	}
+
+	files, err := parseGoFiles(packagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	idFormats := map[string]string{}
+	idSegments := map[string][]sdkResourceIDSegment{}
+	constantValues := map[string][]string{}
+	stringTypes := map[string]struct{}{}
+	methodParams := map[string][]ast.Expr{}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch v := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range v.Specs {
+					switch s := spec.(type) {
+					case *ast.TypeSpec:
+						switch t := s.Type.(type) {
+						case *ast.StructType:
+							if isClientType(t) {
+								pkg.ClientTypeName = s.Name.Name
+								continue
+							}
+							pkg.Models[s.Name.Name] = fieldsForStruct(t)
+						case *ast.Ident:
+							if t.Name == "string" {
+								stringTypes[s.Name.Name] = struct{}{}
+							}
+						}
+
+					case *ast.ValueSpec:
+						if v.Tok != token.CONST || s.Type == nil {
+							continue
+						}
+						typeName := types.ExprString(s.Type)
+						for _, value := range s.Values {
+							lit, ok := value.(*ast.BasicLit)
+							if !ok || lit.Kind != token.STRING {
+								continue
+							}
+							unquoted, err := strconv.Unquote(lit.Value)
+							if err != nil {
+								continue
+							}
+							constantValues[typeName] = append(constantValues[typeName], unquoted)
+						}
+					}
+				}
+
+			case *ast.FuncDecl:
+				if v.Recv == nil || len(v.Recv.List) != 1 {
+					continue
+				}
+				receiver := strings.TrimPrefix(types.ExprString(v.Recv.List[0].Type), "*")
+
+				switch {
+				case v.Name.Name == "ID" && strings.HasSuffix(receiver, "Id"):
+					idFormats[receiver] = idFormatFromFunc(v)
+				case v.Name.Name == "Segments" && strings.HasSuffix(receiver, "Id"):
+					idSegments[receiver] = idSegmentsFromFunc(v)
+				default:
+					params := make([]ast.Expr, 0)
+					for _, field := range v.Type.Params.List {
+						for range field.Names {
+							params = append(params, field.Type)
+						}
+					}
+					methodParams[receiver+"."+v.Name.Name] = params
+				}
+			}
+		}
+	}
+
+	if pkg.ClientTypeName == "" {
+		return nil, fmt.Errorf("no Client was found within the package %q", packagePath)
+	}
+
+	for k, fields := range pkg.Models {
+		if !strings.HasSuffix(k, "Id") {
+			continue
+		}
+		if _, ok := idFormats[k]; !ok {
+			continue
+		}
+		names := make([]string, 0)
+		for _, field := range fields {
+			names = append(names, field.Name)
+		}
+		pkg.ResourceIDs[k] = names
+		delete(pkg.Models, k)
+	}
+
+	for k, v := range constantValues {
+		if _, ok := stringTypes[k]; ok {
+			sort.Strings(v)
+			pkg.Constants[k] = v
+		}
+	}
+
+	for k, params := range methodParams {
+		receiver, method, _ := strings.Cut(k, ".")
+		if receiver != pkg.ClientTypeName {
+			continue
+		}
+
+		payload := ""
+		// methods are in the form `(ctx context.Context, id ResourceId, input Payload)`
+		if len(params) >= 3 {
+			payload = types.ExprString(params[2])
+		}
+		pkg.Methods[method] = payload
+
+		if method == "Get" && len(params) >= 2 {
+			pkg.ID.TypeName = types.ExprString(params[1])
+		}
+	}
+
+	if pkg.ID.TypeName == "" {
+		return nil, fmt.Errorf("the Client %q doesn't have a `Get` method taking a Resource ID", pkg.ClientTypeName)
+	}
+	fields, ok := pkg.ResourceIDs[pkg.ID.TypeName]
+	if !ok {
+		return nil, fmt.Errorf("the Resource ID %q was not found within the package %q", pkg.ID.TypeName, packagePath)
+	}
+	pkg.ID.Fields = fields
+	pkg.ID.Segments = idSegments[pkg.ID.TypeName]
+	pkg.ID.Format = idFormats[pkg.ID.TypeName]
+	for _, segment := range pkg.ID.Segments {
+		pkg.ID.Format = strings.Replace(pkg.ID.Format, "%s", fmt.Sprintf("{%s}", segment.Name), 1)
+	}
+
+	clientFieldName, err := clientFieldNameFromVersion(versionPath, name, pkg.ClientTypeName)
+	if err != nil {
+		return nil, err
+	}
+	pkg.ClientFieldName = clientFieldName
+
+	return &pkg, nil
+}
+
+func parseGoFiles(path string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	fileSet := token.NewFileSet()
+	files := make([]*ast.File, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, filepath.Join(path, entry.Name()), nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", entry.Name(), err)
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// isClientType returns whether this struct is the Client for the package, which contains a single field `Client`
+func isClientType(input *ast.StructType) bool {
+	if input.Fields == nil || len(input.Fields.List) != 1 {
+		return false
+	}
+	field := input.Fields.List[0]
+	return len(field.Names) == 1 && field.Names[0].Name == "Client" && types.ExprString(field.Type) == "*resourcemanager.Client"
+}
+
+func fieldsForStruct(input *ast.StructType) []sdkField {
+	fields := make([]sdkField, 0)
+	for _, field := range input.Fields.List {
+		jsonName := ""
+		optional := false
+		if field.Tag != nil {
+			tag, _ := strconv.Unquote(field.Tag.Value)
+			if v, ok := lookupStructTag(tag, "json"); ok {
+				jsonName, _, _ = strings.Cut(v, ",")
+				optional = strings.Contains(v, ",omitempty")
+			}
+		}
+
+		for _, name := range field.Names {
+			fields = append(fields, sdkField{
+				Name:     name.Name,
+				Type:     types.ExprString(field.Type),
+				JSONName: jsonName,
+				Optional: optional,
+			})
+		}
+	}
+	return fields
+}
+
+func lookupStructTag(tag, key string) (string, bool) {
+	for _, item := range strings.Fields(tag) {
+		k, v, ok := strings.Cut(item, ":")
+		if !ok || k != key {
+			continue
+		}
+		unquoted, err := strconv.Unquote(v)
+		if err != nil {
+			return "", false
+		}
+		return unquoted, true
+	}
+	return "", false
+}
+
+// idFormatFromFunc returns the format string used within the `ID()` function of a Resource ID
+func idFormatFromFunc(input *ast.FuncDecl) (out string) {
+	ast.Inspect(input.Body, func(node ast.Node) bool {
+		lit, ok := node.(*ast.BasicLit)
+		if ok && lit.Kind == token.STRING && out == "" {
+			out, _ = strconv.Unquote(lit.Value)
+		}
+		return out == ""
+	})
+	return out
+}
+
+// idSegmentsFromFunc returns the user specified segments from the `Segments()` function of a Resource ID
+func idSegmentsFromFunc(input *ast.FuncDecl) []sdkResourceIDSegment {
+	segments := make([]sdkResourceIDSegment, 0)
+	ast.Inspect(input.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		switch types.ExprString(call.Fun) {
+		case "resourceids.SubscriptionIdSegment", "resourceids.ResourceGroupSegment", "resourceids.UserSpecifiedSegment":
+			if len(call.Args) != 2 {
+				return false
+			}
+			name, _ := call.Args[0].(*ast.BasicLit)
+			example, _ := call.Args[1].(*ast.BasicLit)
+			if name == nil || example == nil {
+				return false
+			}
+			segment := sdkResourceIDSegment{}
+			segment.Name, _ = strconv.Unquote(name.Value)
+			segment.ExampleValue, _ = strconv.Unquote(example.Value)
+			segments = append(segments, segment)
+		}
+		return false
+	})
+	return segments
+}
+
+// clientFieldNameFromVersion returns the name of the field within the API Version Client for the Client of this package
+func clientFieldNameFromVersion(versionPath, packageName, clientTypeName string) (string, error) {
+	files, err := parseGoFiles(versionPath)
+	if err != nil {
+		return "", err
+	}
+
+	expected := fmt.Sprintf("*%s.%s", packageName, clientTypeName)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || typeSpec.Name.Name != "Client" {
+					continue
+				}
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				for _, field := range structType.Fields.List {
+					if types.ExprString(field.Type) == expected && len(field.Names) == 1 {
+						return field.Names[0].Name, nil
+					}
+				}
+			}
+		}
+	}
+
+	return "", fmt.Errorf("the Client %q was not found within the API Version Client in %q", expected, versionPath)
+}
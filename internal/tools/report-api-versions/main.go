@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	f := flag.NewFlagSet("report-api-versions", flag.ExitOnError)
+
+	rootDirectory := f.String("root-dir", ".", "the path to the root of the repository")
+	outputFormat := f.String("output-format", OutputFormatMarkdown, fmt.Sprintf("the format used to output the report, one of %q. Defaults to `markdown`", PossibleValuesForOutputFormat()))
+	outputFile := f.String("output", "", "the path/filename to write the report to. Defaults to stdout")
+	services := f.String("services", "", "a comma-separated list of Service Packages to limit the report to. Defaults to all Service Packages")
+	flaggedOnly := f.Bool("flagged-only", false, "only include API Versions which are Preview or Outdated in the report")
+	errorOnFlagged := f.Bool("error-on-flagged", false, "should the tool exit with a non-zero exit code when Preview or Outdated API Versions are found. Defaults to `false`")
+
+	if err := f.Parse(os.Args[1:]); err != nil {
+		log.Fatalf("parsing arguments: %+v", err)
+	}
+
+	report, err := run(*rootDirectory, *services, *flaggedOnly)
+	if err != nil {
+		log.Fatalf("building the report: %+v", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *outputFile != "" {
+		file, err := os.Create(*outputFile)
+		if err != nil {
+			log.Fatalf("creating %q: %+v", *outputFile, err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if err := WriteReport(w, *outputFormat, *report); err != nil {
+		log.Fatalf("writing the report: %+v", err)
+	}
+
+	if *errorOnFlagged && (report.Summary.Preview > 0 || report.Summary.Outdated > 0) {
+		log.Printf("found %d Preview and %d Outdated API Versions", report.Summary.Preview, report.Summary.Outdated)
+		os.Exit(1)
+	}
+}
+
+func run(rootDirectory, services string, flaggedOnly bool) (*Report, error) {
+	vendored, err := loadVendoredVersions(rootDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	references, err := loadServiceReferences(rootDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	if services != "" {
+		filtered := map[string][]sdkReference{}
+		for _, service := range strings.Split(services, ",") {
+			service = strings.TrimSpace(service)
+			v, ok := references[service]
+			if !ok {
+				return nil, fmt.Errorf("the Service Package %q doesn't exist or doesn't use the go-azure-sdk", service)
+			}
+			filtered[service] = v
+		}
+		references = filtered
+	}
+
+	report := buildReport(references, vendored, flaggedOnly)
+	return &report, nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	OutputFormatJSON     = "json"
+	OutputFormatMarkdown = "markdown"
+)
+
+func PossibleValuesForOutputFormat() []string {
+	return []string{
+		OutputFormatJSON,
+		OutputFormatMarkdown,
+	}
+}
+
+type Report struct {
+	Summary  ReportSummary   `json:"summary"`
+	Services []ServiceReport `json:"services"`
+}
+
+type ReportSummary struct {
+	// Services is the number of Service Packages which use the go-azure-sdk
+	Services int `json:"services"`
+
+	// APIVersions is the number of API Versions used across all Service Packages
+	APIVersions int `json:"apiVersions"`
+
+	// Preview is the number of API Versions used which are Preview API Versions
+	Preview int `json:"preview"`
+
+	// Outdated is the number of API Versions used where a newer stable API Version is vendored
+	Outdated int `json:"outdated"`
+}
+
+type ServiceReport struct {
+	// Name is the name of the Service Package within the Provider, for example `compute`
+	Name string `json:"name"`
+
+	APIVersions []APIVersionUsage `json:"apiVersions"`
+}
+
+type APIVersionUsage struct {
+	// SDKService is the name of the Service within the go-azure-sdk, for example `compute`
+	SDKService string `json:"sdkService"`
+
+	// APIVersion is the API Version used, for example `2023-04-02`
+	APIVersion string `json:"apiVersion"`
+
+	// Packages are the packages used within this API Version - this is empty when only the API Version (Meta)
+	// Client is used
+	Packages []string `json:"packages"`
+
+	// Preview specifies whether this is a Preview API Version
+	Preview bool `json:"preview"`
+
+	// NewestStableVersion is the newest stable API Version of the Service within the vendor directory which contains
+	// each of the Packages
+	NewestStableVersion string `json:"newestStableVersion,omitempty"`
+
+	// Outdated specifies whether a newer stable API Version containing each of the Packages is vendored
+	Outdated bool `json:"outdated"`
+}
+
+func (u APIVersionUsage) Flagged() bool {
+	return u.Preview || u.Outdated
+}
+
+// buildReport builds the report for the go-azure-sdk references used within each Service Package, optionally
+// only including the API Versions which are Preview or Outdated
+func buildReport(references map[string][]sdkReference, vendored vendoredVersions, flaggedOnly bool) Report {
+	out := Report{
+		Services: make([]ServiceReport, 0),
+	}
+
+	for serviceName, serviceReferences := range references {
+		service := ServiceReport{
+			Name:        serviceName,
+			APIVersions: make([]APIVersionUsage, 0),
+		}
+
+		packages := map[[2]string][]string{}
+		for _, ref := range serviceReferences {
+			key := [2]string{ref.SDKService, ref.APIVersion}
+			if _, ok := packages[key]; !ok {
+				packages[key] = make([]string, 0)
+			}
+			if ref.Package != "" {
+				packages[key] = append(packages[key], ref.Package)
+			}
+		}
+
+		for key, pkgs := range packages {
+			sort.Strings(pkgs)
+			usage := APIVersionUsage{
+				SDKService:          key[0],
+				APIVersion:          key[1],
+				Packages:            pkgs,
+				Preview:             isPreviewVersion(key[1]),
+				NewestStableVersion: vendored.newestStableVersion(key[0], pkgs),
+			}
+			usage.Outdated = usage.NewestStableVersion != "" && isNewerVersion(usage.NewestStableVersion, usage.APIVersion)
+
+			out.Summary.APIVersions++
+			if usage.Preview {
+				out.Summary.Preview++
+			}
+			if usage.Outdated {
+				out.Summary.Outdated++
+			}
+
+			if flaggedOnly && !usage.Flagged() {
+				continue
+			}
+			service.APIVersions = append(service.APIVersions, usage)
+		}
+
+		out.Summary.Services++
+		if len(service.APIVersions) == 0 {
+			continue
+		}
+
+		sort.Slice(service.APIVersions, func(i, j int) bool {
+			if service.APIVersions[i].SDKService != service.APIVersions[j].SDKService {
+				return service.APIVersions[i].SDKService < service.APIVersions[j].SDKService
+			}
+			return service.APIVersions[i].APIVersion < service.APIVersions[j].APIVersion
+		})
+		out.Services = append(out.Services, service)
+	}
+
+	sort.Slice(out.Services, func(i, j int) bool {
+		return out.Services[i].Name < out.Services[j].Name
+	})
+
+	return out
+}
+
+// WriteReport writes the report to w in the specified format
+func WriteReport(w io.Writer, format string, report Report) error {
+	switch format {
+	case OutputFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+
+	case OutputFormatMarkdown:
+		_, err := io.WriteString(w, markdownForReport(report))
+		return err
+	}
+
+	return fmt.Errorf("unsupported output format %q, expected one of %q", format, PossibleValuesForOutputFormat())
+}
+
+func markdownForReport(report Report) string {
+	lines := []string{
+		"# API Version Report",
+		"",
+		fmt.Sprintf("* Services using the `go-azure-sdk`: %d", report.Summary.Services),
+		fmt.Sprintf("* API Versions in use: %d", report.Summary.APIVersions),
+		fmt.Sprintf("* Preview API Versions in use: %d", report.Summary.Preview),
+		fmt.Sprintf("* Outdated API Versions in use: %d", report.Summary.Outdated),
+		"",
+		"An API Version is Outdated when a newer stable API Version containing each of the packages used is vendored.",
+	}
+
+	for _, service := range report.Services {
+		lines = append(lines,
+			"",
+			fmt.Sprintf("## %s", service.Name),
+			"",
+			"| SDK Service | API Version | Packages | Newest Stable Version | Status |",
+			"| --- | --- | --- | --- | --- |",
+		)
+
+		for _, usage := range service.APIVersions {
+			packages := "_(API Version Client)_"
+			if len(usage.Packages) > 0 {
+				packages = fmt.Sprintf("`%s`", strings.Join(usage.Packages, "`, `"))
+			}
+
+			newestStableVersion := "-"
+			if usage.NewestStableVersion != "" {
+				newestStableVersion = fmt.Sprintf("`%s`", usage.NewestStableVersion)
+			}
+
+			status := make([]string, 0)
+			if usage.Preview {
+				status = append(status, "Preview")
+			}
+			if usage.Outdated {
+				status = append(status, "Outdated")
+			}
+			if len(status) == 0 {
+				status = append(status, "Up to date")
+			}
+
+			lines = append(lines, fmt.Sprintf("| `%s` | `%s` | %s | %s | %s |", usage.SDKService, usage.APIVersion, packages, newestStableVersion, strings.Join(status, ", ")))
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
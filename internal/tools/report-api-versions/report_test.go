@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testVendoredVersions = vendoredVersions{
+	"compute": {
+		"2022-03-01": {
+			"virtualmachines": {},
+			"skus":            {},
+		},
+		"2023-04-02": {
+			"virtualmachines": {},
+		},
+	},
+	"containerservice": {
+		"2023-06-02-preview": {
+			"managedclusters": {},
+		},
+	},
+}
+
+var testReferences = map[string][]sdkReference{
+	"compute": {
+		{SDKService: "compute", APIVersion: "2022-03-01", Package: "skus"},
+		{SDKService: "compute", APIVersion: "2022-03-01", Package: "virtualmachines"},
+	},
+	"containers": {
+		{SDKService: "compute", APIVersion: "2023-04-02", Package: "virtualmachines"},
+		{SDKService: "containerservice", APIVersion: "2023-06-02-preview"},
+		{SDKService: "containerservice", APIVersion: "2023-06-02-preview", Package: "managedclusters"},
+	},
+}
+
+func TestBuildReport(t *testing.T) {
+	actual := buildReport(testReferences, testVendoredVersions, false)
+	expected := Report{
+		Summary: ReportSummary{
+			Services:    2,
+			APIVersions: 3,
+			Preview:     1,
+			Outdated:    0,
+		},
+		Services: []ServiceReport{
+			{
+				Name: "compute",
+				APIVersions: []APIVersionUsage{
+					{
+						SDKService:          "compute",
+						APIVersion:          "2022-03-01",
+						Packages:            []string{"skus", "virtualmachines"},
+						NewestStableVersion: "2022-03-01",
+					},
+				},
+			},
+			{
+				Name: "containers",
+				APIVersions: []APIVersionUsage{
+					{
+						SDKService:          "compute",
+						APIVersion:          "2023-04-02",
+						Packages:            []string{"virtualmachines"},
+						NewestStableVersion: "2023-04-02",
+					},
+					{
+						SDKService: "containerservice",
+						APIVersion: "2023-06-02-preview",
+						Packages:   []string{"managedclusters"},
+						Preview:    true,
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestBuildReportOutdated(t *testing.T) {
+	references := map[string][]sdkReference{
+		"containers": {
+			{SDKService: "compute", APIVersion: "2022-03-01", Package: "virtualmachines"},
+			{SDKService: "compute", APIVersion: "2023-04-02", Package: "virtualmachines"},
+		},
+	}
+
+	actual := buildReport(references, testVendoredVersions, true)
+	if actual.Summary.APIVersions != 2 || actual.Summary.Outdated != 1 {
+		t.Fatalf("expected 2 API Versions with 1 Outdated but got %+v", actual.Summary)
+	}
+	if len(actual.Services) != 1 || len(actual.Services[0].APIVersions) != 1 {
+		t.Fatalf("expected a single flagged API Version but got %+v", actual.Services)
+	}
+
+	usage := actual.Services[0].APIVersions[0]
+	if usage.APIVersion != "2022-03-01" || !usage.Outdated || usage.NewestStableVersion != "2023-04-02" {
+		t.Fatalf("expected `2022-03-01` to be Outdated by `2023-04-02` but got %+v", usage)
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	report := buildReport(testReferences, testVendoredVersions, false)
+
+	t.Logf("[DEBUG] Testing %q", OutputFormatJSON)
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, OutputFormatJSON, report); err != nil {
+		t.Fatalf("writing the report: %+v", err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("decoding the report: %+v", err)
+	}
+	if !reflect.DeepEqual(decoded, report) {
+		t.Fatalf("expected %+v but got %+v", report, decoded)
+	}
+
+	t.Logf("[DEBUG] Testing %q", OutputFormatMarkdown)
+	buf.Reset()
+	if err := WriteReport(&buf, OutputFormatMarkdown, report); err != nil {
+		t.Fatalf("writing the report: %+v", err)
+	}
+	for _, expected := range []string{
+		"* Preview API Versions in use: 1",
+		"## containers",
+		"| `compute` | `2022-03-01` | `skus`, `virtualmachines` | `2022-03-01` | Up to date |",
+		"| `containerservice` | `2023-06-02-preview` | `managedclusters` | - | Preview |",
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Fatalf("expected the report to contain %q but got:\n%s", expected, buf.String())
+		}
+	}
+
+	t.Logf("[DEBUG] Testing an unsupported format")
+	if err := WriteReport(&buf, "xml", report); err == nil {
+		t.Fatalf("expected an error for an unsupported format but didn't get one")
+	}
+}
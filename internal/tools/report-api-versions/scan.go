@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const resourceManagerImportPrefix = "github.com/hashicorp/go-azure-sdk/resource-manager/"
+
+// apiVersionRegex matches an API Version within the go-azure-sdk, for example `2023-04-01` or `2022-09-02-preview`
+var apiVersionRegex = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})(-[a-zA-Z0-9-]+)?$`)
+
+// sdkReference is a reference to an API Version within the go-azure-sdk
+type sdkReference struct {
+	// SDKService is the name of the Service within the go-azure-sdk, for example `compute`
+	SDKService string
+
+	// APIVersion is the API Version, for example `2023-04-01`
+	APIVersion string
+
+	// Package is the name of the package within the API Version, for example `virtualmachines` - or an empty string
+	// when the API Version (Meta) Client is referenced
+	Package string
+}
+
+// parseSdkImport parses an import path referencing a resource-manager package within the go-azure-sdk
+func parseSdkImport(importPath string) (*sdkReference, bool) {
+	if !strings.HasPrefix(importPath, resourceManagerImportPrefix) {
+		return nil, false
+	}
+
+	segments := strings.Split(strings.TrimPrefix(importPath, resourceManagerImportPrefix), "/")
+	if len(segments) < 2 || !apiVersionRegex.MatchString(segments[1]) {
+		return nil, false
+	}
+
+	ref := sdkReference{
+		SDKService: segments[0],
+		APIVersion: segments[1],
+	}
+	if len(segments) > 2 {
+		ref.Package = segments[2]
+	}
+	return &ref, true
+}
+
+// isPreviewVersion returns whether the API Version is a Preview (or otherwise non-stable) API Version
+func isPreviewVersion(apiVersion string) bool {
+	match := apiVersionRegex.FindStringSubmatch(apiVersion)
+	return match == nil || match[2] != ""
+}
+
+// isNewerVersion returns whether the API Version `candidate` is newer than `current` - a stable API Version
+// is considered newer than a Preview API Version released on the same date
+func isNewerVersion(candidate, current string) bool {
+	candidateDate, currentDate := candidate, current
+	if match := apiVersionRegex.FindStringSubmatch(candidate); match != nil {
+		candidateDate = match[1]
+	}
+	if match := apiVersionRegex.FindStringSubmatch(current); match != nil {
+		currentDate = match[1]
+	}
+
+	if candidateDate != currentDate {
+		return candidateDate > currentDate
+	}
+	return !isPreviewVersion(candidate) && isPreviewVersion(current)
+}
+
+// vendoredVersions contains the packages available within each API Version of each Service in the vendor directory
+type vendoredVersions map[string]map[string]map[string]struct{}
+
+// loadVendoredVersions returns the API Versions (and the packages within them) for each Service within the vendored
+// copy of the go-azure-sdk - since only packages used within the Provider are vendored, these are the API Versions
+// which can be switched to without updating the go-azure-sdk
+func loadVendoredVersions(rootDirectory string) (vendoredVersions, error) {
+	out := vendoredVersions{}
+
+	path := filepath.Join(rootDirectory, "vendor", filepath.FromSlash(resourceManagerImportPrefix))
+	services, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading the vendored go-azure-sdk at %q: %+v", path, err)
+	}
+
+	for _, service := range services {
+		if !service.IsDir() {
+			continue
+		}
+
+		versions, err := os.ReadDir(filepath.Join(path, service.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading the vendored API Versions for %q: %+v", service.Name(), err)
+		}
+
+		for _, version := range versions {
+			if !version.IsDir() || !apiVersionRegex.MatchString(version.Name()) {
+				continue
+			}
+
+			packages, err := os.ReadDir(filepath.Join(path, service.Name(), version.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("reading the vendored packages for %s/%s: %+v", service.Name(), version.Name(), err)
+			}
+
+			if _, ok := out[service.Name()]; !ok {
+				out[service.Name()] = map[string]map[string]struct{}{}
+			}
+			out[service.Name()][version.Name()] = map[string]struct{}{}
+			for _, pkg := range packages {
+				if pkg.IsDir() {
+					out[service.Name()][version.Name()][pkg.Name()] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// newestStableVersion returns the newest vendored stable API Version of the Service which contains each of the
+// specified packages, or an empty string if there isn't one
+func (v vendoredVersions) newestStableVersion(sdkService string, packages []string) string {
+	out := ""
+	for version, available := range v[sdkService] {
+		if isPreviewVersion(version) {
+			continue
+		}
+
+		containsPackages := true
+		for _, pkg := range packages {
+			if _, ok := available[pkg]; !ok {
+				containsPackages = false
+				break
+			}
+		}
+
+		if containsPackages && (out == "" || isNewerVersion(version, out)) {
+			out = version
+		}
+	}
+	return out
+}
+
+// loadServiceReferences returns the go-azure-sdk references imported by each Service Package within the Provider
+func loadServiceReferences(rootDirectory string) (map[string][]sdkReference, error) {
+	out := map[string][]sdkReference{}
+
+	servicesDirectory := filepath.Join(rootDirectory, "internal", "services")
+	services, err := os.ReadDir(servicesDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("reading the Services at %q: %+v", servicesDirectory, err)
+	}
+
+	for _, service := range services {
+		if !service.IsDir() {
+			continue
+		}
+
+		references, err := loadReferencesWithinDirectory(filepath.Join(servicesDirectory, service.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("loading the references for the Service %q: %+v", service.Name(), err)
+		}
+		if len(references) > 0 {
+			out[service.Name()] = references
+		}
+	}
+
+	return out, nil
+}
+
+// loadReferencesWithinDirectory returns the unique go-azure-sdk references imported within the (non-test) Go files
+// within the directory and any nested directories
+func loadReferencesWithinDirectory(directory string) ([]sdkReference, error) {
+	unique := map[sdkReference]struct{}{}
+	fileSet := token.NewFileSet()
+
+	err := filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fileSet, path, nil, parser.ImportsOnly)
+		if err != nil {
+			return fmt.Errorf("parsing %q: %+v", path, err)
+		}
+
+		for _, item := range file.Imports {
+			importPath, err := strconv.Unquote(item.Path.Value)
+			if err != nil {
+				continue
+			}
+			if ref, ok := parseSdkImport(importPath); ok {
+				unique[*ref] = struct{}{}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]sdkReference, 0)
+	for ref := range unique {
+		out = append(out, ref)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].SDKService != out[j].SDKService {
+			return out[i].SDKService < out[j].SDKService
+		}
+		if out[i].APIVersion != out[j].APIVersion {
+			return out[i].APIVersion < out[j].APIVersion
+		}
+		return out[i].Package < out[j].Package
+	})
+	return out, nil
+}
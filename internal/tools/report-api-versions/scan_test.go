@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+)
+
+func TestParseSdkImport(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *sdkReference
+	}{
+		{
+			Input: "github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/virtualmachines",
+			Expected: &sdkReference{
+				SDKService: "compute",
+				APIVersion: "2023-04-02",
+				Package:    "virtualmachines",
+			},
+		},
+		{
+			Input: "github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-06-02-preview",
+			Expected: &sdkReference{
+				SDKService: "containerservice",
+				APIVersion: "2023-06-02-preview",
+			},
+		},
+		{
+			Input: "github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/virtualmachines/nested",
+			Expected: &sdkReference{
+				SDKService: "compute",
+				APIVersion: "2023-04-02",
+				Package:    "virtualmachines",
+			},
+		},
+		{
+			Input:    "github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager",
+			Expected: nil,
+		},
+		{
+			Input:    "github.com/hashicorp/go-azure-sdk/resource-manager/common-types",
+			Expected: nil,
+		},
+		{
+			Input:    "github.com/tombuildsstuff/kermit/sdk/synapse/2021-06-01-preview/synapse",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, ok := parseSdkImport(v.Input)
+		if v.Expected == nil {
+			if ok {
+				t.Fatalf("expected no reference but got %+v", *actual)
+			}
+			continue
+		}
+
+		if !ok {
+			t.Fatalf("expected %+v but got no reference", *v.Expected)
+		}
+		if *actual != *v.Expected {
+			t.Fatalf("expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestIsNewerVersion(t *testing.T) {
+	testData := []struct {
+		Candidate string
+		Current   string
+		Expected  bool
+	}{
+		{
+			Candidate: "2023-04-02",
+			Current:   "2022-03-01",
+			Expected:  true,
+		},
+		{
+			Candidate: "2022-03-01",
+			Current:   "2023-04-02",
+			Expected:  false,
+		},
+		{
+			Candidate: "2023-04-02",
+			Current:   "2023-04-02",
+			Expected:  false,
+		},
+		{
+			Candidate: "2023-04-02",
+			Current:   "2023-04-02-preview",
+			Expected:  true,
+		},
+		{
+			Candidate: "2023-04-02-preview",
+			Current:   "2023-04-02",
+			Expected:  false,
+		},
+		{
+			Candidate: "2023-04-02-preview",
+			Current:   "2022-03-01",
+			Expected:  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q against %q", v.Candidate, v.Current)
+
+		if actual := isNewerVersion(v.Candidate, v.Current); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestNewestStableVersion(t *testing.T) {
+	vendored := vendoredVersions{
+		"compute": {
+			"2021-07-01": {
+				"skus": {},
+			},
+			"2022-03-01": {
+				"virtualmachines": {},
+				"skus":            {},
+			},
+			"2023-04-02": {
+				"virtualmachines": {},
+			},
+			"2023-10-01-preview": {
+				"virtualmachines": {},
+				"skus":            {},
+			},
+		},
+	}
+
+	testData := []struct {
+		Name       string
+		SDKService string
+		Packages   []string
+		Expected   string
+	}{
+		{
+			Name:       "API Version Client",
+			SDKService: "compute",
+			Expected:   "2023-04-02",
+		},
+		{
+			Name:       "single package",
+			SDKService: "compute",
+			Packages:   []string{"skus"},
+			Expected:   "2022-03-01",
+		},
+		{
+			Name:       "multiple packages",
+			SDKService: "compute",
+			Packages:   []string{"skus", "virtualmachines"},
+			Expected:   "2022-03-01",
+		},
+		{
+			Name:       "package not vendored in a stable API Version",
+			SDKService: "compute",
+			Packages:   []string{"galleries"},
+			Expected:   "",
+		},
+		{
+			Name:       "Service not vendored",
+			SDKService: "network",
+			Expected:   "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := vendored.newestStableVersion(v.SDKService, v.Packages); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -5,6 +5,7 @@ package differ
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
@@ -15,92 +16,127 @@ type Differ struct {
 	current *providerjson.ProviderWrapper
 }
 
-func (d *Differ) Diff(fileName string, providerName string) []string {
+func (d *Differ) Diff(fileName string, providerName string) []Violation {
 	if err := d.loadFromProvider(providerjson.LoadData(), providerName); err != nil {
-		return []string{err.Error()}
+		return []Violation{errorViolation(err.Error())}
 	}
 
 	if err := d.loadFromFile(fileName); err != nil {
-		return []string{err.Error()}
+		return []Violation{errorViolation(err.Error())}
 	}
 
 	if d.base.ProviderName != d.current.ProviderName {
-		return []string{fmt.Sprintf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)}
+		return []Violation{errorViolation(fmt.Sprintf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName))}
 	}
 
-	violations := make([]string, 0)
+	return d.compare()
+}
+
+func (d *Differ) compare() []Violation {
+	violations := make([]Violation, 0)
 
 	for resource, rs := range d.current.ProviderSchema.ResourcesMap {
-		_, ok := d.base.ProviderSchema.ResourcesMap[resource]
+		base, ok := d.base.ProviderSchema.ResourcesMap[resource]
 		if !ok {
 			// New resource, no breaking changes to worry about
 			continue
 		}
+
+		for _, v := range schema_rules.ResourceBreakingChangeRules {
+			if err := v.Check(base, rs, resource); err != nil {
+				violations = append(violations, Violation{
+					Rule:         v.Name(),
+					Kind:         KindResource,
+					ResourceName: resource,
+					Message:      *err,
+				})
+			}
+		}
+
 		for propertyName, propertySchema := range rs.Schema {
 			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.ResourcesMap[resource].Schema[propertyName]
+			baseItem, ok := base.Schema[propertyName]
 			if !ok {
 				// New property, could be breaking - Required etc
 				baseItem = providerjson.SchemaJSON{}
 			}
-			if errs := compareNodeResource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+			violations = append(violations, compareNode(schema_rules.BreakingChangeRules, KindResource, resource, baseItem, propertySchema, propertyName, propertyName)...)
+		}
+	}
+
+	for resource := range d.base.ProviderSchema.ResourcesMap {
+		if _, ok := d.current.ProviderSchema.ResourcesMap[resource]; !ok {
+			violations = append(violations, Violation{
+				Rule:         RuleResourceRemoved,
+				Kind:         KindResource,
+				ResourceName: resource,
+				Message:      fmt.Sprintf("Cannot remove the resource %q", resource),
+			})
 		}
 	}
 
 	for dataSource, ds := range d.current.ProviderSchema.DataSourcesMap {
-		_, ok := d.base.ProviderSchema.DataSourcesMap[dataSource]
+		base, ok := d.base.ProviderSchema.DataSourcesMap[dataSource]
 		if !ok {
 			// New data source, no breaking changes to worry about
 			continue
 		}
 		for propertyName, propertySchema := range ds.Schema {
 			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.DataSourcesMap[dataSource].Schema[propertyName]
+			baseItem, ok := base.Schema[propertyName]
 			if !ok {
 				// New property, could be breaking - Required etc
 				baseItem = providerjson.SchemaJSON{}
 			}
-			if errs := compareNodeDataSource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+			violations = append(violations, compareNode(schema_rules.BreakingChangeRulesDataSource, KindDataSource, dataSource, baseItem, propertySchema, propertyName, propertyName)...)
 		}
 	}
 
-	return violations
-}
-
-func compareNodeResource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeResource(newBase, newCurrent[k], k)...)
+	for dataSource := range d.base.ProviderSchema.DataSourcesMap {
+		if _, ok := d.current.ProviderSchema.DataSourcesMap[dataSource]; !ok {
+			violations = append(violations, Violation{
+				Rule:         RuleDataSourceRemoved,
+				Kind:         KindDataSource,
+				ResourceName: dataSource,
+				Message:      fmt.Sprintf("Cannot remove the data source %q", dataSource),
+			})
 		}
 	}
 
-	for _, v := range schema_rules.BreakingChangeRules {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+	sort.Slice(violations, func(i, j int) bool {
+		if violations[i].ResourceName != violations[j].ResourceName {
+			return violations[i].ResourceName < violations[j].ResourceName
 		}
-	}
+		if violations[i].Property != violations[j].Property {
+			return violations[i].Property < violations[j].Property
+		}
+		return violations[i].Rule < violations[j].Rule
+	})
 
-	return
+	return violations
 }
 
-func compareNodeDataSource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
+func compareNode(rules []schema_rules.BreakingChangeRule, kind, resourceName string, base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName, path string) (violations []Violation) {
 	if nodeIsBlock(base) {
 		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
+		newCurrent := map[string]providerjson.SchemaJSON{}
+		if v, ok := current.Elem.(*providerjson.ResourceJSON); ok && v != nil {
+			newCurrent = v.Schema
+		}
 		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeDataSource(newBase, newCurrent[k], k)...)
+			violations = append(violations, compareNode(rules, kind, resourceName, newBase, newCurrent[k], k, fmt.Sprintf("%s.%s", path, k))...)
 		}
 	}
 
-	for _, v := range schema_rules.BreakingChangeRulesDataSource {
+	for _, v := range rules {
 		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+			violations = append(violations, Violation{
+				Rule:         v.Name(),
+				Kind:         kind,
+				ResourceName: resourceName,
+				Property:     path,
+				Message:      *err,
+			})
 		}
 	}
 
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+func TestDifferCompare(t *testing.T) {
+	base := &providerjson.ProviderWrapper{
+		ProviderName: "azurerm",
+		ProviderSchema: &providerjson.ProviderSchemaJSON{
+			ResourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: map[string]providerjson.SchemaJSON{
+						"sku": {
+							Type:          providerjson.SchemaTypeString,
+							Required:      true,
+							AllowedValues: []string{"Basic", "Standard"},
+						},
+						"network": {
+							Type:     providerjson.SchemaTypeList,
+							Optional: true,
+							Elem: providerjson.ResourceJSON{
+								Schema: map[string]providerjson.SchemaJSON{
+									"subnet_id": {
+										Type:     providerjson.SchemaTypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+					ImportIDFormat: "/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Example/examples/{}",
+				},
+				"azurerm_removed": {},
+			},
+			DataSourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_removed": {},
+			},
+		},
+	}
+
+	current := &providerjson.ProviderWrapper{
+		ProviderName: "azurerm",
+		ProviderSchema: &providerjson.ProviderSchemaJSON{
+			ResourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: map[string]providerjson.SchemaJSON{
+						"sku": {
+							Type:          providerjson.SchemaTypeString,
+							Required:      true,
+							AllowedValues: []string{"Standard"},
+						},
+						"network": {
+							Type:     providerjson.SchemaTypeList,
+							Optional: true,
+							Elem: &providerjson.ResourceJSON{
+								Schema: map[string]providerjson.SchemaJSON{
+									"subnet_id": {
+										Type:     providerjson.SchemaTypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+					ImportIDFormat: "/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Example/examples/{}/children/{}",
+				},
+			},
+			DataSourcesMap: map[string]providerjson.ResourceJSON{},
+		},
+	}
+
+	d := Differ{
+		base:    base,
+		current: current,
+	}
+
+	expected := []Violation{
+		{
+			Rule:         "importIdFormatChanged",
+			Kind:         KindResource,
+			ResourceName: "azurerm_example",
+		},
+		{
+			Rule:         "becomeForceNew",
+			Kind:         KindResource,
+			ResourceName: "azurerm_example",
+			Property:     "network.subnet_id",
+		},
+		{
+			Rule:         "allowedValuesRemoved",
+			Kind:         KindResource,
+			ResourceName: "azurerm_example",
+			Property:     "sku",
+		},
+		{
+			Rule:         RuleDataSourceRemoved,
+			Kind:         KindDataSource,
+			ResourceName: "azurerm_removed",
+		},
+		{
+			Rule:         RuleResourceRemoved,
+			Kind:         KindResource,
+			ResourceName: "azurerm_removed",
+		},
+	}
+
+	actual := d.compare()
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d violations but got %d: %+v", len(expected), len(actual), actual)
+	}
+
+	for i, v := range expected {
+		if actual[i].Rule != v.Rule || actual[i].Kind != v.Kind || actual[i].ResourceName != v.ResourceName || actual[i].Property != v.Property {
+			t.Fatalf("expected violation %d to be %+v but got %+v", i, v, actual[i])
+		}
+	}
+}
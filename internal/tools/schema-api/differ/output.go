@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+const (
+	OutputFormatJSON  = "json"
+	OutputFormatSARIF = "sarif"
+	OutputFormatText  = "text"
+)
+
+func PossibleValuesForOutputFormat() []string {
+	return []string{
+		OutputFormatJSON,
+		OutputFormatSARIF,
+		OutputFormatText,
+	}
+}
+
+// WriteViolations writes the violations to w in the specified format
+func WriteViolations(w io.Writer, format string, violations []Violation) error {
+	switch format {
+	case OutputFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(violations)
+
+	case OutputFormatSARIF:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(sarifLogFromViolations(violations))
+
+	case OutputFormatText:
+		for _, v := range violations {
+			if _, err := fmt.Fprintln(w, v.String()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unsupported output format %q, expected one of %q", format, PossibleValuesForOutputFormat())
+}
+
+// the following types are the subset of the SARIF 2.1.0 format used to output violations
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+func sarifLogFromViolations(violations []Violation) sarifLog {
+	ruleIds := make(map[string]struct{})
+	results := make([]sarifResult, 0)
+	for _, v := range violations {
+		ruleIds[v.Rule] = struct{}{}
+
+		result := sarifResult{
+			RuleID: v.Rule,
+			Level:  "error",
+			Message: sarifMessage{
+				Text: v.Message,
+			},
+		}
+
+		if v.ResourceName != "" {
+			name := v.ResourceName
+			kind := "type"
+			if v.Property != "" {
+				name = fmt.Sprintf("%s.%s", v.ResourceName, v.Property)
+				kind = "member"
+			}
+			if v.Kind == KindDataSource {
+				name = fmt.Sprintf("data.%s", name)
+			}
+
+			result.Locations = []sarifLocation{
+				{
+					LogicalLocations: []sarifLogicalLocation{
+						{
+							FullyQualifiedName: name,
+							Kind:               kind,
+						},
+					},
+				},
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	rules := make([]sarifRule, 0)
+	for k := range ruleIds {
+		rules = append(rules, sarifRule{
+			ID: k,
+		})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].ID < rules[j].ID
+	})
+
+	return sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           "schema-api",
+						InformationURI: "https://github.com/hashicorp/terraform-provider-azurerm",
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
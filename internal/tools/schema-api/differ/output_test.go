@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+var outputTestViolations = []Violation{
+	{
+		Rule:         "becomeForceNew",
+		Kind:         KindResource,
+		ResourceName: "azurerm_example",
+		Property:     "network.subnet_id",
+		Message:      `Cannot change property "subnet_id" to ForceNew`,
+	},
+	{
+		Rule:         RuleDataSourceRemoved,
+		Kind:         KindDataSource,
+		ResourceName: "azurerm_removed",
+		Message:      `Cannot remove the data source "azurerm_removed"`,
+	},
+}
+
+func TestWriteViolationsText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteViolations(&buf, OutputFormatText, outputTestViolations); err != nil {
+		t.Fatalf("writing violations: %+v", err)
+	}
+
+	expected := "azurerm_example (network.subnet_id): Cannot change property \"subnet_id\" to ForceNew\nazurerm_removed: Cannot remove the data source \"azurerm_removed\"\n"
+	if actual := buf.String(); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
+
+func TestWriteViolationsJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteViolations(&buf, OutputFormatJSON, outputTestViolations); err != nil {
+		t.Fatalf("writing violations: %+v", err)
+	}
+
+	var actual []Violation
+	if err := json.Unmarshal(buf.Bytes(), &actual); err != nil {
+		t.Fatalf("unmarshaling violations: %+v", err)
+	}
+	if len(actual) != 2 || actual[0] != outputTestViolations[0] || actual[1] != outputTestViolations[1] {
+		t.Fatalf("expected %+v but got %+v", outputTestViolations, actual)
+	}
+}
+
+func TestWriteViolationsSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteViolations(&buf, OutputFormatSARIF, outputTestViolations); err != nil {
+		t.Fatalf("writing violations: %+v", err)
+	}
+
+	var actual sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &actual); err != nil {
+		t.Fatalf("unmarshaling SARIF log: %+v", err)
+	}
+
+	if actual.Version != "2.1.0" || len(actual.Runs) != 1 {
+		t.Fatalf("expected a single SARIF 2.1.0 run but got %+v", actual)
+	}
+
+	run := actual.Runs[0]
+	if len(run.Tool.Driver.Rules) != 2 || run.Tool.Driver.Rules[0].ID != "becomeForceNew" || run.Tool.Driver.Rules[1].ID != RuleDataSourceRemoved {
+		t.Fatalf("expected the rules `becomeForceNew` and `dataSourceRemoved` but got %+v", run.Tool.Driver.Rules)
+	}
+
+	if len(run.Results) != 2 {
+		t.Fatalf("expected 2 results but got %d", len(run.Results))
+	}
+	if name := run.Results[0].Locations[0].LogicalLocations[0].FullyQualifiedName; name != "azurerm_example.network.subnet_id" {
+		t.Fatalf("expected the first result to be located at `azurerm_example.network.subnet_id` but got %q", name)
+	}
+	if name := run.Results[1].Locations[0].LogicalLocations[0].FullyQualifiedName; name != "data.azurerm_removed" {
+		t.Fatalf("expected the second result to be located at `data.azurerm_removed` but got %q", name)
+	}
+}
+
+func TestWriteViolationsUnsupportedFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteViolations(&buf, "xml", outputTestViolations); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
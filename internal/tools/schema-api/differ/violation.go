@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import "fmt"
+
+const (
+	KindDataSource = "dataSource"
+	KindResource   = "resource"
+
+	RuleDataSourceRemoved = "dataSourceRemoved"
+	RuleError             = "error"
+	RuleResourceRemoved   = "resourceRemoved"
+)
+
+// Violation is a breaking change detected between the base (released) schema and the current schema
+type Violation struct {
+	// Rule is the name of the rule which detected this breaking change
+	Rule string `json:"rule"`
+
+	// Kind is either `resource` or `dataSource`, and is empty for errors which aren't specific to either
+	Kind string `json:"kind,omitempty"`
+
+	// ResourceName is the name of the Resource or Data Source, for example `azurerm_resource_group`
+	ResourceName string `json:"resourceName,omitempty"`
+
+	// Property is the path to the property within the Resource or Data Source, for example `identity.type`
+	Property string `json:"property,omitempty"`
+
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	if v.ResourceName == "" {
+		return v.Message
+	}
+
+	if v.Property == "" {
+		return fmt.Sprintf("%s: %s", v.ResourceName, v.Message)
+	}
+
+	return fmt.Sprintf("%s (%s): %s", v.ResourceName, v.Property, v.Message)
+}
+
+func errorViolation(message string) Violation {
+	return Violation{
+		Rule:    RuleError,
+		Message: message,
+	}
+}
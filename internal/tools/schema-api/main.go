@@ -26,12 +26,19 @@ func main() {
 	exportSchema := f.String("export", "", "export the schema to the given path/filename. Intended for use in the release process")
 	detectBreakingChanges := f.String("detect", "", "compare current schema to named dump.")
 	errorOnBreakingChange := f.Bool("error-on-violation", false, "should the detect mode exit with a non-zero error code. Defaults to `false`")
+	outputFormat := f.String("output-format", differ.OutputFormatText, fmt.Sprintf("the format used to output violations found in detect mode, one of %q. Defaults to `text`", differ.PossibleValuesForOutputFormat()))
+	outputFile := f.String("output", "", "the path/filename to write the violations found in detect mode to. Defaults to stdout for the `json` and `sarif` formats and the log for `text`")
+	websitePath := f.String("website-path", providerjson.WebsiteResourceDocsPath, "the path to the Resource documentation, used to determine the format of the Resource IDs used to import each Resource")
 
 	if err := f.Parse(os.Args[1:]); err != nil {
 		fmt.Printf("error parsing args: %+v", err)
 		os.Exit(1)
 	}
 
+	providerjson.WebsiteResourceDocsPath = *websitePath
+	if _, err := os.Stat(*websitePath); err != nil {
+		log.Printf("[WARN] unable to find the Resource documentation at %q, the import ID format will be omitted for Resources which don't use a Resource ID parser: %+v", *websitePath, err)
+	}
 	data := providerjson.LoadData()
 
 	switch {
@@ -53,13 +60,13 @@ func main() {
 	case pointer.From(detectBreakingChanges) != "":
 		{
 			d := differ.Differ{}
-			if violations := d.Diff(*detectBreakingChanges, *providerName); violations != nil {
-				for _, v := range violations {
-					log.Println(v)
-				}
-				if pointer.From(errorOnBreakingChange) {
-					os.Exit(1)
-				}
+			violations := d.Diff(*detectBreakingChanges, *providerName)
+			if err := writeViolations(*outputFormat, *outputFile, violations); err != nil {
+				log.Fatalf("error writing violations: %+v", err)
+			}
+
+			if len(violations) > 0 && pointer.From(errorOnBreakingChange) {
+				os.Exit(1)
 			}
 
 			os.Exit(0)
@@ -107,3 +114,24 @@ func main() {
 	log.Printf("starting api service on localhost:%d", *apiPort)
 	log.Println(http.ListenAndServe(fmt.Sprintf(":%d", *apiPort), mux))
 }
+
+func writeViolations(format string, fileName string, violations []differ.Violation) error {
+	if fileName == "" {
+		if format == differ.OutputFormatText {
+			for _, v := range violations {
+				log.Println(v)
+			}
+			return nil
+		}
+
+		return differ.WriteViolations(os.Stdout, format, violations)
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("creating %q: %+v", fileName, err)
+	}
+	defer f.Close()
+
+	return differ.WriteViolations(f, format, violations)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerjson
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// allowedValuesProbe is a value which shouldn't be accepted by any ValidateFunc restricting a field to a set of values
+const allowedValuesProbe = "\x00schema-api-probe"
+
+var (
+	allowedValuesMessage = regexp.MustCompile(`to be one of \[(.*)\], got`)
+	quotedValue          = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+)
+
+// allowedValuesFromValidateFunc determines the values accepted by the ValidateFunc of a String property, by calling it
+// with a value that shouldn't be valid and parsing the error returned by `validation.StringInSlice`.
+func allowedValuesFromValidateFunc(input *schema.Schema) (out []string) {
+	if input == nil || input.Type != schema.TypeString || input.ValidateFunc == nil {
+		return nil
+	}
+
+	defer func() {
+		// a ValidateFunc may not expect this value, in which case the allowed values can't be determined
+		if r := recover(); r != nil {
+			out = nil
+		}
+	}()
+
+	_, errs := input.ValidateFunc(allowedValuesProbe, "probe")
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if values := parseAllowedValues(err.Error()); values != nil {
+			return values
+		}
+	}
+
+	return nil
+}
+
+// parseAllowedValues parses the allowed values from an error message in the format output by `validation.StringInSlice`
+func parseAllowedValues(input string) []string {
+	match := allowedValuesMessage.FindStringSubmatch(input)
+	if len(match) != 2 {
+		return nil
+	}
+
+	values := make([]string, 0)
+	for _, raw := range quotedValue.FindAllString(match[1], -1) {
+		v, err := strconv.Unquote(raw)
+		if err != nil {
+			return nil
+		}
+		values = append(values, v)
+	}
+
+	return values
+}
+
+func decodeAllowedValues(input interface{}) []string {
+	raw, ok := input.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	values := make([]string, 0)
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+
+	return values
+}
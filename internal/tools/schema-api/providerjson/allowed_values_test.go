@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerjson
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func TestAllowedValuesFromValidateFunc(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    *schema.Schema
+		Expected []string
+	}{
+		{
+			Name: "No ValidateFunc",
+			Input: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Expected: nil,
+		},
+		{
+			Name: "String In Slice",
+			Input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard", "Premium"}, false),
+			},
+			Expected: []string{"Basic", "Standard", "Premium"},
+		},
+		{
+			Name: "String In Slice Including An Empty Value",
+			Input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"", "Enabled"}, false),
+			},
+			Expected: []string{"", "Enabled"},
+		},
+		{
+			Name: "Other Validation",
+			Input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			Expected: nil,
+		},
+		{
+			Name: "Not A String",
+			Input: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := allowedValuesFromValidateFunc(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerjson
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WebsiteResourceDocsPath is the path to the documentation for Resources, which is used to determine the format of
+// the Resource ID used to import a Resource when this can't be determined from the Resource's ID parser
+var WebsiteResourceDocsPath = filepath.Join("website", "docs", "r")
+
+var importCommand = regexp.MustCompile(`terraform import (\S+)\.\S+ ['"]?([^'"\s]+)['"]?`)
+
+// expectedResourceId matches the example Resource ID included in the error returned by the Resource ID parsers when
+// the Resource ID doesn't match the expected format
+var expectedResourceId = regexp.MustCompile(`Expected a .+ ID that matched \(containing (\d+) segments\):\s+> (\S+)`)
+
+// expectedConstantSegment matches the Segments described as Constants in the error returned by the Resource ID parsers,
+// where the example Resource ID contains just one of the possible values (e.g. the type of a DNS Record)
+var expectedConstantSegment = regexp.MustCompile(`(?m)^\* Segment (\d+) - this should be a Constant`)
+
+// invalidImportID is the Resource ID passed to the importer to retrieve the expected format from the ID parser
+const invalidImportID = "/"
+
+// ImportIDFormat returns the normalized format of the Resource ID used to import the specified Resource. This is
+// derived from the ID parser used by the Resource's importer (or the Resource's IDValidationFunc for typed Resources),
+// falling back to the `terraform import` example within the documentation when the Resource doesn't use a Resource ID
+// parser - and is an empty string when neither is available
+func ImportIDFormat(resourceType string, resource *schema.Resource) string {
+	if v := ImportIDFormatFromImporter(resource); v != "" {
+		return v
+	}
+
+	return ImportIDFormatFromDocs(resourceType)
+}
+
+// ImportIDFormatFromImporter returns the normalized format of the Resource ID expected by the importer for the
+// specified Resource, taken from the example Resource ID in the error returned when importing an invalid Resource ID
+// - or an empty string if the importer doesn't validate the Resource ID using a Resource ID parser
+func ImportIDFormatFromImporter(resource *schema.Resource) string {
+	if resource == nil || resource.Importer == nil || resource.Importer.StateContext == nil {
+		return ""
+	}
+
+	// Resource IDs made up of multiple Resource IDs (e.g. `{networkInterfaceId}|{applicationSecurityGroupId}`) are
+	// parsed one at a time, so the example for each part is retrieved by importing the examples found so far
+	examples := make([]string, 0)
+	formats := make([]string, 0)
+	for {
+		example, format := expectedImportID(resource, strings.Join(append(examples, invalidImportID), "|"))
+		if example == "" || (len(examples) > 0 && example == examples[len(examples)-1]) {
+			break
+		}
+		examples = append(examples, example)
+		formats = append(formats, format)
+	}
+
+	return strings.Join(formats, "|")
+}
+
+// expectedImportID imports the specified Resource ID and returns the example Resource ID and normalized format from
+// the error returned by the Resource ID parser, or empty strings if the import doesn't fail with a Resource ID parser error
+func expectedImportID(resource *schema.Resource, id string) (example string, format string) {
+	// importers which don't validate the Resource ID may look up the resource using the (nil) provider meta
+	defer func() {
+		if r := recover(); r != nil {
+			example = ""
+			format = ""
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	d := resource.TestResourceData()
+	d.SetId(id)
+	_, err := resource.Importer.StateContext(ctx, d, nil)
+	if err == nil {
+		return "", ""
+	}
+
+	match := expectedResourceId.FindStringSubmatch(err.Error())
+	if len(match) != 3 {
+		return "", ""
+	}
+
+	// the Segments can only be mapped to the example Resource ID when it doesn't contain a Scope
+	segments := strings.Split(strings.TrimPrefix(match[2], "/"), "/")
+	if strconv.Itoa(len(segments)) == match[1] {
+		for _, constant := range expectedConstantSegment.FindAllStringSubmatch(err.Error(), -1) {
+			if i, err := strconv.Atoi(constant[1]); err == nil && i < len(segments) {
+				segments[i] = "{}"
+			}
+		}
+	}
+
+	return match[2], NormalizeImportID("/" + strings.Join(segments, "/"))
+}
+
+// ImportIDFormatFromDocs returns the normalized format of the Resource ID used in the `terraform import` example
+// within the documentation for the specified Resource - or an empty string if this can't be determined
+func ImportIDFormatFromDocs(resourceType string) string {
+	fileName := filepath.Join(WebsiteResourceDocsPath, fmt.Sprintf("%s.html.markdown", strings.TrimPrefix(resourceType, "azurerm_")))
+	contents, err := os.ReadFile(fileName)
+	if err != nil {
+		return ""
+	}
+
+	for _, match := range importCommand.FindAllStringSubmatch(string(contents), -1) {
+		if match[1] != resourceType {
+			continue
+		}
+		return NormalizeImportID(match[2])
+	}
+
+	return ""
+}
+
+// NormalizeImportID replaces the user-specified values within a Resource ID with `{}`, retaining the segment names and
+// Resource Provider namespaces - for example `/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Compute/virtualMachines/{}`.
+// Resource IDs which aren't Azure Resource Manager IDs (such as Data Plane URIs) are returned as `{}`.
+func NormalizeImportID(input string) string {
+	parts := strings.Split(input, "|")
+	for i, part := range parts {
+		parts[i] = normalizeResourceManagerID(part)
+	}
+	return strings.Join(parts, "|")
+}
+
+func normalizeResourceManagerID(input string) string {
+	if !strings.HasPrefix(input, "/") {
+		return "{}"
+	}
+
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	for i := 1; i < len(segments); i += 2 {
+		if strings.EqualFold(segments[i-1], "providers") {
+			// the Resource Provider namespace is part of the format
+			continue
+		}
+		segments[i] = "{}"
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
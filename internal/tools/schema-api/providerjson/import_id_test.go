@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerjson
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestNormalizeImportID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected: "/subscriptions/{}/resourceGroups/{}",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Compute/virtualMachines/machine1",
+			Expected: "/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Compute/virtualMachines/{}",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: "/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Network/virtualNetworks/{}/subnets/{}",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1|/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+			Expected: "/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Network/networkInterfaces/{}|/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Network/networkSecurityGroups/{}",
+		},
+		{
+			Input:    "https://example.blob.core.windows.net/container1",
+			Expected: "{}",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := NormalizeImportID(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestImportIDFormatFromImporter(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Resource *schema.Resource
+		Expected string
+	}{
+		{
+			Name:     "no importer",
+			Resource: &schema.Resource{},
+			Expected: "",
+		},
+		{
+			Name: "resource id parser",
+			Resource: &schema.Resource{
+				Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+					_, err := commonids.ParseResourceGroupID(id)
+					return err
+				}),
+			},
+			Expected: "/subscriptions/{}/resourceGroups/{}",
+		},
+		{
+			Name: "resource id parser with a constant segment",
+			Resource: &schema.Resource{
+				Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+					_, err := recordsets.ParseRecordTypeID(id)
+					return err
+				}),
+			},
+			Expected: "/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Network/dnsZones/{}/{}/{}",
+		},
+		{
+			Name: "multiple resource id parsers",
+			Resource: &schema.Resource{
+				Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+					splitId := strings.Split(id, "|")
+					if _, err := commonids.ParseResourceGroupID(splitId[0]); err != nil {
+						return err
+					}
+					_, err := recordsets.ParseRecordTypeID(splitId[1])
+					return err
+				}),
+			},
+			Expected: "/subscriptions/{}/resourceGroups/{}|/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Network/dnsZones/{}/{}/{}",
+		},
+		{
+			Name: "validation without a resource id parser",
+			Resource: &schema.Resource{
+				Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+					return fmt.Errorf("parsing %q: invalid id", id)
+				}),
+			},
+			Expected: "",
+		},
+		{
+			Name: "importer using the provider meta",
+			Resource: &schema.Resource{
+				Importer: &schema.ResourceImporter{
+					StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+						_ = meta.(fmt.Stringer).String()
+						return []*schema.ResourceData{d}, nil
+					},
+				},
+			},
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := ImportIDFormatFromImporter(v.Resource)
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -33,6 +33,9 @@ type SchemaJSON struct {
 	Elem        interface{} `json:"elem,omitempty"`
 	MaxItems    int         `json:"maxItems,omitempty"`
 	MinItems    int         `json:"minItems,omitempty"`
+
+	// AllowedValues contains the values accepted by the ValidateFunc, when this can be determined
+	AllowedValues []string `json:"allowedValues,omitempty"`
 }
 
 func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
@@ -52,8 +55,9 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 		b.MaxItems = int(max)
 	}
 	if min, ok := m["minItems"].(float64); ok {
-		b.MaxItems = int(min)
+		b.MinItems = int(min)
 	}
+	b.AllowedValues = decodeAllowedValues(m["allowedValues"])
 
 	if def, ok := m["default"]; ok && def != nil {
 		switch def.(type) {
@@ -86,6 +90,9 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 type ResourceJSON struct {
 	Schema   map[string]SchemaJSON `json:"schema"`
 	Timeouts *ResourceTimeoutJSON  `json:"timeouts,omitempty"`
+
+	// ImportIDFormat is the normalized format of the Resource ID used to import this resource
+	ImportIDFormat string `json:"importIdFormat,omitempty"`
 }
 
 type ResourceTimeoutJSON struct {
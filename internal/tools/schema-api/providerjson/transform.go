@@ -57,6 +57,8 @@ func schemaFromRaw(input *schema.Schema) SchemaJSON {
 		Elem:        decodeElem(input.Elem),
 		MaxItems:    input.MaxItems,
 		MinItems:    input.MinItems,
+
+		AllowedValues: allowedValuesFromValidateFunc(input),
 	}
 }
 
@@ -110,6 +112,8 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 		result.MaxItems = int(t.(float64))
 	}
 
+	result.AllowedValues = decodeAllowedValues(input["allowedValues"])
+
 	return result
 }
 
@@ -174,6 +178,7 @@ func ProviderFromRaw(input *ProviderJSON) (*ProviderSchemaJSON, error) {
 		if err != nil {
 			return nil, err
 		}
+		resource.ImportIDFormat = ImportIDFormat(k, v)
 		resourceSchemas[k] = *resource
 	}
 
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+type allowedValuesRemoved struct{}
+
+var _ BreakingChangeRule = allowedValuesRemoved{}
+
+func (allowedValuesRemoved) Name() string {
+	return "allowedValuesRemoved"
+}
+
+// Check - Checks that values accepted by the validation of a property aren't removed. Removing the validation entirely is allowed.
+func (allowedValuesRemoved) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if len(base.AllowedValues) == 0 || len(current.AllowedValues) == 0 {
+		return nil
+	}
+
+	currentValues := make(map[string]struct{}, len(current.AllowedValues))
+	for _, v := range current.AllowedValues {
+		currentValues[v] = struct{}{}
+	}
+
+	removed := make([]string, 0)
+	for _, v := range base.AllowedValues {
+		if _, ok := currentValues[v]; !ok {
+			removed = append(removed, fmt.Sprintf("%q", v))
+		}
+	}
+
+	if len(removed) > 0 {
+		return pointer.To(fmt.Sprintf("Cannot remove the allowed value(s) %s from property %q", strings.Join(removed, ", "), propertyName))
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var allowedValuesRemovedBaseNode = providerjson.SchemaJSON{
+	Type:          providerjson.SchemaTypeString,
+	Optional:      true,
+	AllowedValues: []string{"Basic", "Standard"},
+}
+
+var allowedValuesRemovedPassesValueAdded = providerjson.SchemaJSON{
+	Type:          providerjson.SchemaTypeString,
+	Optional:      true,
+	AllowedValues: []string{"Basic", "Standard", "Premium"},
+}
+
+var allowedValuesRemovedPassesValidationRemoved = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+}
+
+var allowedValuesRemovedViolates = providerjson.SchemaJSON{
+	Type:          providerjson.SchemaTypeString,
+	Optional:      true,
+	AllowedValues: []string{"Standard", "Premium"}, // violation
+}
+
+func TestAllowedValuesRemoved_Check(t *testing.T) {
+	data := allowedValuesRemoved{}
+	if res := data.Check(allowedValuesRemovedBaseNode, allowedValuesRemovedPassesValueAdded, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(allowedValuesRemovedBaseNode, allowedValuesRemovedPassesValidationRemoved, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(allowedValuesRemovedBaseNode, allowedValuesRemovedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
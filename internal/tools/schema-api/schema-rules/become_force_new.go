@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+type becomeForceNew struct{}
+
+var _ BreakingChangeRule = becomeForceNew{}
+
+func (becomeForceNew) Name() string {
+	return "becomeForceNew"
+}
+
+// Check - Checks that an existing property is not updated to become ForceNew, since changes to this property would then replace the resource
+func (becomeForceNew) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type != "" && !base.ForceNew && current.ForceNew {
+		return pointer.To(fmt.Sprintf("Cannot change property %q to ForceNew", propertyName))
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+type computedDefaultAdded struct{}
+
+var _ BreakingChangeRule = computedDefaultAdded{}
+
+func (computedDefaultAdded) Name() string {
+	return "computedDefaultAdded"
+}
+
+// Check - Checks that a Default isn't added to a Computed property, since the value returned by the API would then be replaced by the Default
+func (computedDefaultAdded) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Computed && base.Default == nil && current.Default != nil {
+		return pointer.To(fmt.Sprintf("Cannot add a Default (%+v) to the Computed property %q", current.Default, propertyName))
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var computedDefaultAddedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	Computed: true,
+	Default:  nil,
+}
+
+var computedDefaultAddedPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	Computed: true,
+	Default:  nil,
+}
+
+var computedDefaultAddedViolates = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	Computed: false,
+	Default:  "Standard", // violation
+}
+
+func TestComputedDefaultAdded_Check(t *testing.T) {
+	data := computedDefaultAdded{}
+	if res := data.Check(computedDefaultAddedBaseNode, computedDefaultAddedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(computedDefaultAddedBaseNode, computedDefaultAddedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+type importIdFormatChanged struct{}
+
+var _ ResourceBreakingChangeRule = importIdFormatChanged{}
+
+func (importIdFormatChanged) Name() string {
+	return "importIdFormatChanged"
+}
+
+// Check - Checks that the format of the Resource ID used to import a Resource hasn't changed
+func (importIdFormatChanged) Check(base providerjson.ResourceJSON, current providerjson.ResourceJSON, resourceName string) *string {
+	if base.ImportIDFormat == "" || current.ImportIDFormat == "" {
+		return nil
+	}
+
+	if !strings.EqualFold(base.ImportIDFormat, current.ImportIDFormat) {
+		return pointer.To(fmt.Sprintf("the ID format for %q has changed (%s to %s)", resourceName, base.ImportIDFormat, current.ImportIDFormat))
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var importIdFormatChangedBase = providerjson.ResourceJSON{
+	ImportIDFormat: "/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Example/widgets/{}",
+}
+
+var importIdFormatChangedPasses = providerjson.ResourceJSON{
+	ImportIDFormat: "/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Example/Widgets/{}",
+}
+
+var importIdFormatChangedViolates = providerjson.ResourceJSON{
+	ImportIDFormat: "/subscriptions/{}/resourceGroups/{}/providers/Microsoft.Example/widgets/{}/gadgets/{}", // violation
+}
+
+func TestImportIdFormatChanged_Check(t *testing.T) {
+	data := importIdFormatChanged{}
+	if res := data.Check(importIdFormatChangedBase, importIdFormatChangedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(providerjson.ResourceJSON{}, importIdFormatChangedViolates, ""); res != nil {
+		t.Errorf("expected no violation when the base format is unknown, got %+v", res)
+	}
+	if res := data.Check(importIdFormatChangedBase, importIdFormatChangedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+type itemsLimitsTightened struct{}
+
+var _ BreakingChangeRule = itemsLimitsTightened{}
+
+func (itemsLimitsTightened) Name() string {
+	return "itemsLimitsTightened"
+}
+
+// Check - Checks that the MaxItems of a property isn't reduced (or added) and that the MinItems isn't increased
+func (itemsLimitsTightened) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type == "" || (!current.Optional && !current.Required) {
+		return nil
+	}
+
+	if current.MaxItems > 0 && (base.MaxItems == 0 || current.MaxItems < base.MaxItems) {
+		return pointer.To(fmt.Sprintf("Cannot reduce MaxItems for property %q (%d to %d)", propertyName, base.MaxItems, current.MaxItems))
+	}
+
+	if current.MinItems > base.MinItems {
+		return pointer.To(fmt.Sprintf("Cannot increase MinItems for property %q (%d to %d)", propertyName, base.MinItems, current.MinItems))
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var itemsLimitsTightenedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 5,
+	MinItems: 1,
+}
+
+var itemsLimitsTightenedPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 10,
+	MinItems: 0,
+}
+
+var itemsLimitsTightenedViolatesMaxItems = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 1, // violation
+	MinItems: 1,
+}
+
+var itemsLimitsTightenedViolatesMinItems = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 5,
+	MinItems: 2, // violation
+}
+
+func TestItemsLimitsTightened_Check(t *testing.T) {
+	data := itemsLimitsTightened{}
+	if res := data.Check(itemsLimitsTightenedBaseNode, itemsLimitsTightenedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(itemsLimitsTightenedBaseNode, itemsLimitsTightenedViolatesMaxItems, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+	if res := data.Check(itemsLimitsTightenedBaseNode, itemsLimitsTightenedViolatesMinItems, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
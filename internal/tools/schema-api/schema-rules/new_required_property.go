@@ -16,6 +16,10 @@ var _ BreakingChangeRule = newRequiredPropertyExistingResource{}
 
 type newRequiredPropertyExistingResource struct{}
 
+func (newRequiredPropertyExistingResource) Name() string {
+	return "newRequiredPropertyExistingResource"
+}
+
 // Check - Checks that a newly introduced property is not marked as Required since this will not be in users configurations.
 func (newRequiredPropertyExistingResource) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if base.Type == "" && current.Required {
@@ -6,11 +6,22 @@ package schema_rules
 import "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 
 type BreakingChangeRule interface {
+	Name() string
 	Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string
 }
 
+// ResourceBreakingChangeRule is a BreakingChangeRule which applies to a Resource or Data Source as a whole, rather than to a property
+type ResourceBreakingChangeRule interface {
+	Name() string
+	Check(base providerjson.ResourceJSON, current providerjson.ResourceJSON, resourceName string) *string
+}
+
 var BreakingChangeRules = []BreakingChangeRule{
+	allowedValuesRemoved{},
 	becomeComputedOnly{},
+	becomeForceNew{},
+	computedDefaultAdded{},
+	itemsLimitsTightened{},
 	newRequiredPropertyExistingResource{},
 	optionalRemoveComputed{},
 	optionalToRequired{},
@@ -18,5 +29,10 @@ var BreakingChangeRules = []BreakingChangeRule{
 }
 
 var BreakingChangeRulesDataSource = []BreakingChangeRule{
+	allowedValuesRemoved{},
 	propertyType{},
 }
+
+var ResourceBreakingChangeRules = []ResourceBreakingChangeRule{
+	importIdFormatChanged{},
+}